@@ -10,6 +10,7 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/batcher/core"
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	"github.com/Ethernal-Tech/apex-bridge/health"
 	"github.com/Ethernal-Tech/apex-bridge/telemetry"
 	"github.com/Ethernal-Tech/apex-bridge/validatorobserver"
 	"github.com/hashicorp/go-hclog"
@@ -46,6 +47,8 @@ func NewBatcher(
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater,
 	logger hclog.Logger,
 ) *BatcherImpl {
+	health.RegisterComponent(health.ComponentName("batcher", config.Chain.ChainID))
+
 	return &BatcherImpl{
 		config:                      config,
 		operations:                  operations,
@@ -85,30 +88,43 @@ func (b *BatcherImpl) Start(ctx context.Context) {
 		case <-time.After(waitTime):
 		}
 
+		healthComponentName := health.ComponentName("batcher", b.config.Chain.ChainID)
+
 		isSync, err := b.operations.IsSynchronized(ctx, b.bridgeSmartContract, b.config.Chain.ChainID)
 		if err != nil {
 			b.logger.Error("is synchronized check failed", "err", err)
+			health.ReportError(healthComponentName, err)
 
 			continue
-		} else if !isSync {
+		}
+
+		health.ReportChainSync(b.config.Chain.ChainID, isSync)
+
+		if !isSync {
 			b.logger.Info("batcher is not synchronized - creating batch skipped")
+			health.ReportTick(healthComponentName)
 
 			continue
 		}
 
 		batchID, err := b.execute(ctx)
-		if err != nil {
-			if errors.Is(err, errNonActiveBatchPeriod) {
-				b.logger.Info("execution skipped", "reason", err)
-			} else {
-				// update telemetry only if batchID is specified
-				if batchID != 0 {
-					telemetry.UpdateBatcherBatchSubmitFailed(b.config.Chain.ChainID, batchID)
-				}
-
-				b.logger.Error("execution failed", "err", err)
+		if err != nil && !errors.Is(err, errNonActiveBatchPeriod) {
+			// update telemetry only if batchID is specified
+			if batchID != 0 {
+				telemetry.UpdateBatcherBatchSubmitFailed(b.config.Chain.ChainID, batchID)
 			}
+
+			b.logger.Error("execution failed", "err", err)
+			health.ReportError(healthComponentName, err)
+
+			continue
 		}
+
+		if err != nil {
+			b.logger.Info("execution skipped", "reason", err)
+		}
+
+		health.ReportTick(healthComponentName)
 	}
 }
 
@@ -160,15 +176,21 @@ func (b *BatcherImpl) execute(ctx context.Context) (uint64, error) {
 		}
 
 		if len(confirmedTransactions) == 0 {
-			return batchID, fmt.Errorf("batch should not be created for zero number of confirmed transactions. chainID: %s",
-				b.config.Chain.ChainID)
+			// the batch id of an idle chain is used for a maintenance batch of its utxos
+			generatedBatchData, err = b.operations.GenerateMaintenanceTransaction(
+				ctx, b.bridgeSmartContract, b.config.Chain.ChainID, batchID)
+			if err == nil && generatedBatchData == nil {
+				return batchID, fmt.Errorf(
+					"batch should not be created for zero number of confirmed transactions. chainID: %s",
+					b.config.Chain.ChainID)
+			}
+		} else {
+			b.logger.Debug("Successfully queried smart contract for confirmed transactions",
+				"batchID", batchID, "txs", eth.ConfirmedTransactionsWrapper{Txs: confirmedTransactions})
+			// Generate batch transaction
+			generatedBatchData, err = b.operations.GenerateBatchTransaction(
+				ctx, b.bridgeSmartContract, b.config.Chain.ChainID, confirmedTransactions, batchID)
 		}
-
-		b.logger.Debug("Successfully queried smart contract for confirmed transactions",
-			"batchID", batchID, "txs", eth.ConfirmedTransactionsWrapper{Txs: confirmedTransactions})
-		// Generate batch transaction
-		generatedBatchData, err = b.operations.GenerateBatchTransaction(
-			ctx, b.bridgeSmartContract, b.config.Chain.ChainID, confirmedTransactions, batchID)
 	}
 
 	if err != nil {
@@ -176,6 +198,15 @@ func (b *BatcherImpl) execute(ctx context.Context) (uint64, error) {
 			b.config.Chain.ChainID, err)
 	}
 
+	// lastTxNonceId of a partial batch is the nonce of its last included tx. The bridge contract accepts it
+	// and keeps the rest of the confirmed transactions for the next batch
+	if generatedBatchData.IncludedTxs != nil {
+		b.logger.Info("Batch includes only a part of the confirmed transactions", "batchID", batchID,
+			"included", len(generatedBatchData.IncludedTxs), "confirmed", len(confirmedTransactions))
+
+		confirmedTransactions = generatedBatchData.IncludedTxs
+	}
+
 	if generatedBatchData.TxHash == b.lastBatch.txHash {
 		// there is nothing different to submit
 		b.logger.Debug("generated batch is the same as the previous one",
@@ -265,7 +296,7 @@ func (b *BatcherImpl) updateBatchTxsStates(
 
 	txsInBatch := getBridgingRequestStateKeys(txs, signedBatch.FirstTxNonceId, signedBatch.LastTxNonceId)
 
-	if err := b.bridgingRequestStateUpdater.IncludedInBatch(txsInBatch, b.config.Chain.ChainID); err != nil {
+	if err := b.bridgingRequestStateUpdater.IncludedInBatch(txsInBatch, b.config.Chain.ChainID, batchID); err != nil {
 		b.logger.Error("failed to update batch txs states", "batchID", batchID, "err", err)
 	}
 
@@ -32,7 +32,6 @@ func TestBatcherExecute(t *testing.T) {
 			ChainSpecific: json.RawMessage([]byte(`{
 				"socketPath": "./socket",
 				"testnetMagic": 2,
-				"potentialFee": 300000,
 				}`)),
 		},
 		PullTimeMilis: 2500,
@@ -89,6 +88,54 @@ func TestBatcherExecute(t *testing.T) {
 		require.Equal(t, batchNonceID, batchID)
 	})
 
+	t.Run("execute no confirmed transactions and no maintenance", func(t *testing.T) {
+		bridgeSmartContractMock := &eth.BridgeSmartContractMock{}
+		operationsMock := &cardanoChainOperationsMock{}
+
+		bridgeSmartContractMock.On("GetNextBatchID", ctx, common.ChainIDStrPrime).Return(batchNonceID, nil)
+		bridgeSmartContractMock.On("GetConfirmedTransactions", ctx, common.ChainIDStrPrime).
+			Return([]eth.ConfirmedTransaction{}, nil)
+		operationsMock.On("GenerateMaintenanceTransaction", ctx, bridgeSmartContractMock, common.ChainIDStrPrime,
+			batchNonceID).Return((*core.GeneratedBatchTxData)(nil), nil)
+
+		b := NewBatcher(config, operationsMock,
+			bridgeSmartContractMock, &common.BridgingRequestStateUpdaterMock{ReturnNil: true}, hclog.NewNullLogger())
+		batchID, err := b.execute(ctx)
+
+		require.ErrorContains(t, err, "batch should not be created for zero number of confirmed transactions")
+		require.Equal(t, batchNonceID, batchID)
+		operationsMock.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("execute pass maintenance batch", func(t *testing.T) {
+		bridgeSmartContractMock := &eth.BridgeSmartContractMock{}
+		operationsMock := &cardanoChainOperationsMock{}
+		batchData := &core.GeneratedBatchTxData{
+			BatchType: uint8(Consolidation),
+			TxRaw:     []byte{0},
+			TxHash:    "txHash",
+		}
+
+		bridgeSmartContractMock.On("GetNextBatchID", ctx, common.ChainIDStrPrime).Return(batchNonceID, nil)
+		bridgeSmartContractMock.On("GetConfirmedTransactions", ctx, common.ChainIDStrPrime).
+			Return([]eth.ConfirmedTransaction{}, nil)
+		operationsMock.On("GenerateMaintenanceTransaction", ctx, bridgeSmartContractMock, common.ChainIDStrPrime,
+			batchNonceID).Return(batchData, nil)
+		operationsMock.On("SignBatchTransaction", batchData).Return([]byte{1}, []byte{2}, nil)
+		operationsMock.On("Submit", ctx, bridgeSmartContractMock, mock.MatchedBy(func(batch eth.SignedBatch) bool {
+			return batch.Id == batchNonceID && batch.BatchType == uint8(Consolidation) &&
+				batch.FirstTxNonceId == 0 && batch.LastTxNonceId == 0
+		})).Return(error(nil))
+
+		b := NewBatcher(config, operationsMock,
+			bridgeSmartContractMock, &common.BridgingRequestStateUpdaterMock{ReturnNil: true}, hclog.NewNullLogger())
+		batchID, err := b.execute(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, batchNonceID, batchID)
+		operationsMock.AssertExpectations(t)
+	})
+
 	getConfirmedTransactionsRet := []eth.ConfirmedTransaction{
 		{
 			Nonce:                   5,
@@ -220,6 +267,74 @@ func TestBatcherExecute(t *testing.T) {
 		require.Equal(t, batchNonceID, batchID)
 	})
 
+	t.Run("execute pass partial batch", func(t *testing.T) {
+		bridgeSmartContractMock := &eth.BridgeSmartContractMock{}
+		operationsMock := &cardanoChainOperationsMock{}
+		bridgingRequestStateUpdater := &common.BridgingRequestStateUpdaterMock{}
+		confirmedTxs := []eth.ConfirmedTransaction{
+			getConfirmedTransactionsRet[0],
+			{
+				Nonce:                   6,
+				ObservedTransactionHash: common.NewHashFromHexString("0x6675"),
+				SourceChainId:           common.ToNumChainID(common.ChainIDStrVector),
+			},
+			{
+				Nonce:                   7,
+				ObservedTransactionHash: common.NewHashFromHexString("0x6676"),
+				SourceChainId:           common.ToNumChainID(common.ChainIDStrVector),
+			},
+		}
+		batchData := &core.GeneratedBatchTxData{
+			TxRaw:       []byte{0},
+			TxHash:      "txHash",
+			IncludedTxs: confirmedTxs[:2],
+		}
+		nextBatchData := &core.GeneratedBatchTxData{
+			TxRaw:  []byte{1},
+			TxHash: "txHash2",
+		}
+
+		// the bridge contract accepts the batch with lastTxNonceId lower than the nonce of the last confirmed tx
+		// and returns the remaining confirmed transactions for the next batch
+		bridgeSmartContractMock.On("GetNextBatchID", ctx, common.ChainIDStrPrime).Return(batchNonceID, nil).Once()
+		bridgeSmartContractMock.On("GetNextBatchID", ctx, common.ChainIDStrPrime).Return(batchNonceID+1, nil).Once()
+		bridgeSmartContractMock.On("GetConfirmedTransactions", ctx, common.ChainIDStrPrime).Return(confirmedTxs, nil).Once()
+		bridgeSmartContractMock.On("GetConfirmedTransactions", ctx, common.ChainIDStrPrime).Return(confirmedTxs[2:], nil).Once()
+		operationsMock.On("GenerateBatchTransaction", ctx, bridgeSmartContractMock, common.ChainIDStrPrime, confirmedTxs, batchNonceID).
+			Return(batchData, nil)
+		operationsMock.On("GenerateBatchTransaction", ctx, bridgeSmartContractMock, common.ChainIDStrPrime, confirmedTxs[2:], batchNonceID+1).
+			Return(nextBatchData, nil)
+		operationsMock.On("SignBatchTransaction", batchData).Return([]byte{}, []byte{}, nil)
+		operationsMock.On("SignBatchTransaction", nextBatchData).Return([]byte{}, []byte{}, nil)
+		operationsMock.On("Submit", ctx, bridgeSmartContractMock, mock.MatchedBy(func(batch eth.SignedBatch) bool {
+			return batch.Id == batchNonceID && batch.FirstTxNonceId == 5 && batch.LastTxNonceId == 6
+		})).Return(error(nil)).Once()
+		operationsMock.On("Submit", ctx, bridgeSmartContractMock, mock.MatchedBy(func(batch eth.SignedBatch) bool {
+			return batch.Id == batchNonceID+1 && batch.FirstTxNonceId == 7 && batch.LastTxNonceId == 7
+		})).Return(error(nil)).Once()
+		bridgingRequestStateUpdater.On("IncludedInBatch", []common.BridgingRequestStateKey{
+			common.NewBridgingRequestStateKey(common.ChainIDStrPrime, confirmedTxs[0].ObservedTransactionHash, false),
+			common.NewBridgingRequestStateKey(common.ChainIDStrVector, confirmedTxs[1].ObservedTransactionHash, false),
+		}, common.ChainIDStrPrime, batchNonceID).Return(nil).Once()
+		bridgingRequestStateUpdater.On("IncludedInBatch", []common.BridgingRequestStateKey{
+			common.NewBridgingRequestStateKey(common.ChainIDStrVector, confirmedTxs[2].ObservedTransactionHash, false),
+		}, common.ChainIDStrPrime, batchNonceID+1).Return(nil).Once()
+
+		b := NewBatcher(config, operationsMock,
+			bridgeSmartContractMock, bridgingRequestStateUpdater, hclog.NewNullLogger())
+		batchID, err := b.execute(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, batchNonceID, batchID)
+
+		batchID, err = b.execute(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, batchNonceID+1, batchID)
+		operationsMock.AssertExpectations(t)
+		bridgingRequestStateUpdater.AssertExpectations(t)
+	})
+
 	t.Run("Test Validator set change - five multisig one fee", func(t *testing.T) {
 		bridgeSmartContractMock := &eth.BridgeSmartContractMock{}
 
@@ -447,6 +562,16 @@ func (c *cardanoChainOperationsMock) GenerateBatchTransaction(
 	return args.Get(0).(*core.GeneratedBatchTxData), args.Error(1)
 }
 
+// GenerateMaintenanceTransaction implements core.ChainOperations.
+func (c *cardanoChainOperationsMock) GenerateMaintenanceTransaction(
+	ctx context.Context, bridgeSmartContract eth.IBridgeSmartContract,
+	destinationChain string, batchNonceID uint64,
+) (*core.GeneratedBatchTxData, error) {
+	args := c.Called(ctx, bridgeSmartContract, destinationChain, batchNonceID)
+
+	return args.Get(0).(*core.GeneratedBatchTxData), args.Error(1)
+}
+
 // SignBatchTransaction implements core.ChainOperations.
 func (c *cardanoChainOperationsMock) SignBatchTransaction(generatedBatchData *core.GeneratedBatchTxData) ([]byte, []byte, error) {
 	args := c.Called(generatedBatchData)
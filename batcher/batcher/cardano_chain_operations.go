@@ -1,11 +1,15 @@
 package batcher
 
 import (
+	"cmp"
 	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -14,6 +18,7 @@ import (
 	cardano "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	"github.com/Ethernal-Tech/apex-bridge/telemetry"
 	"github.com/Ethernal-Tech/apex-bridge/validatorobserver"
 	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
 	"github.com/Ethernal-Tech/cardano-infrastructure/secrets"
@@ -26,12 +31,6 @@ var (
 
 	errUTXOsLimitReached   = errors.New("utxos limit reached, consolidation is required")
 	errUTXOsCouldNotSelect = errors.New("couldn't select UTXOs")
-	errTxSizeTooBig        = errors.New("batch tx size too big")
-)
-
-// Get real tx size from protocolParams/config
-const (
-	maxTxSize = 16000
 )
 
 type batchInitialData struct {
@@ -45,9 +44,11 @@ type batchInitialData struct {
 }
 
 type CardanoChainOperations struct {
+	chainID          string
 	config           *cardano.CardanoChainConfig
 	wallet           *cardano.ApexCardanoWallet
 	txProvider       cardanowallet.ITxDataRetriever
+	utxoSelector     UtxoSelector
 	db               indexer.Database
 	indxUpdater      core.IndexerUpdater
 	gasLimiter       eth.GasLimitHolder
@@ -71,6 +72,20 @@ func NewCardanoChainOperations(
 		return nil, err
 	}
 
+	utxoSelector, err := NewUtxoSelector(cardanoConfig.UtxoSelectionStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cardanoConfig.TxBuilderBackend.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := cardanoConfig.UtxoMaintenance.Validate(
+		cardanoConfig.UtxoMinAmount, cardanoConfig.MaxFeeUtxoCount); err != nil {
+		return nil, fmt.Errorf("invalid utxo maintenance config: %w", err)
+	}
+
 	txProvider, err := cardanoConfig.CreateTxProvider()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tx provider: %w", err)
@@ -82,9 +97,11 @@ func NewCardanoChainOperations(
 	}
 
 	return &CardanoChainOperations{
+		chainID:          chainID,
 		wallet:           cardanoWallet,
 		config:           cardanoConfig,
 		txProvider:       txProvider,
+		utxoSelector:     utxoSelector,
 		cardanoCliBinary: cardanowallet.ResolveCardanoCliBinary(cardanoConfig.NetworkID),
 		gasLimiter:       eth.NewGasLimitHolder(submitBatchMinGasLimit, submitBatchMaxGasLimit, submitBatchStepsGasLimit),
 		db:               db,
@@ -109,6 +126,21 @@ func (cco *CardanoChainOperations) GenerateBatchTransaction(
 
 	txData, err := cco.generateBatchTransaction(data, confirmedTransactions)
 
+	if cco.shouldConsolidate(err) && len(confirmedTransactions) > 1 {
+		cco.logger.Warn("batch with all confirmed transactions can not be created", "err", err)
+
+		txData, err = generatePartialBatchTransaction(
+			confirmedTransactions,
+			func(txs []eth.ConfirmedTransaction) (*core.GeneratedBatchTxData, error) {
+				return cco.generateBatchTransaction(data, txs)
+			},
+			cco.shouldConsolidate)
+		if err == nil {
+			cco.logger.Info("partial batch created",
+				"included", len(txData.IncludedTxs), "confirmed", len(confirmedTransactions))
+		}
+	}
+
 	if cco.shouldConsolidate(err) {
 		cco.logger.Warn("consolidation batch generation started", "err", err)
 
@@ -121,6 +153,86 @@ func (cco *CardanoChainOperations) GenerateBatchTransaction(
 	return txData, err
 }
 
+// GenerateMaintenanceTransaction implements core.ChainOperations.
+func (cco *CardanoChainOperations) GenerateMaintenanceTransaction(
+	ctx context.Context,
+	bridgeSmartContract eth.IBridgeSmartContract,
+	chainID string,
+	batchNonceID uint64,
+) (*core.GeneratedBatchTxData, error) {
+	data, err := cco.createBatchInitialData(ctx, bridgeSmartContract, chainID, batchNonceID)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigUtxos, err := cco.db.GetAllTxOutputs(data.MultisigAddr, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tx outputs for multisig address: %w", err)
+	}
+
+	allFeeUtxos, err := cco.db.GetAllTxOutputs(data.FeeAddr, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tx outputs for fee address: %w", err)
+	}
+
+	multisigUtxos = filterOutTokenUtxos(multisigUtxos)
+	allFeeUtxos = filterOutTokenUtxos(allFeeUtxos)
+
+	if len(allFeeUtxos) == 0 {
+		return nil, fmt.Errorf("fee multisig does not have any utxo: %s", data.FeeAddr)
+	}
+
+	feeUtxos := getFeeUtxos(allFeeUtxos, cco.config.UtxoMaintenance, int(cco.config.MaxFeeUtxoCount)) //nolint:gosec
+
+	multisigUtxos, outputs := getMaintenanceTxData(
+		data.MultisigAddr, multisigUtxos, len(allFeeUtxos),
+		cco.config.UtxoMaintenance, getMaxUtxoCount(cco.config, len(feeUtxos)))
+	if len(multisigUtxos) == 0 {
+		return nil, nil
+	}
+
+	slotNumber, err := cco.getSlotNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	cco.logger.Info("Creating maintenance tx", "batchID", data.BatchNonceID,
+		"magic", cco.config.NetworkMagic, "binary", cco.cardanoCliBinary,
+		"slot", slotNumber, "multisig", len(multisigUtxos), "fee", len(feeUtxos), "outputs", len(outputs))
+
+	// Create Tx
+	txRaw, txHash, err := cardano.CreateTxWithBackend(
+		cco.config.TxBuilderBackend,
+		cco.cardanoCliBinary,
+		uint(cco.config.NetworkMagic),
+		data.ProtocolParams,
+		slotNumber+cco.config.TTLSlotNumberInc,
+		data.Metadata,
+		cardano.TxInputInfos{
+			MultiSig: &cardano.TxInputInfo{
+				PolicyScript: data.MultisigPolicyScript,
+				Address:      data.MultisigAddr,
+				TxInputs:     convertUTXOsToTxInputs(multisigUtxos),
+			},
+			MultiSigFee: &cardano.TxInputInfo{
+				PolicyScript: data.FeePolicyScript,
+				Address:      data.FeeAddr,
+				TxInputs:     convertUTXOsToTxInputs(feeUtxos),
+			},
+		},
+		outputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.GeneratedBatchTxData{
+		BatchType: uint8(Consolidation),
+		TxRaw:     txRaw,
+		TxHash:    txHash,
+	}, nil
+}
+
 // SignBatchTransaction implements core.ChainOperations.
 func (cco *CardanoChainOperations) SignBatchTransaction(
 	generatedBatchData *core.GeneratedBatchTxData) ([]byte, []byte, error) {
@@ -128,7 +240,7 @@ func (cco *CardanoChainOperations) SignBatchTransaction(
 		return []byte{}, []byte{}, nil
 	}
 
-	txBuilder, err := cardanowallet.NewTxBuilder(cco.cardanoCliBinary)
+	txBuilder, err := cardano.NewTxBuilder(cco.config.TxBuilderBackend, cco.cardanoCliBinary)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,14 +305,26 @@ func (cco *CardanoChainOperations) generateBatchTransaction(
 		cco.config.MinFeeForBridging,
 		cco.logger)
 
+	minChangeAmount, err := cco.getMinChangeAmount(data.ProtocolParams, data.MultisigAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	multisigUtxos, feeUtxos, err := cco.getUTXOs(
 		data.MultisigAddr, data.FeeAddr,
 		common.FlattenMatrix(refundUtxosPerConfirmedTx),
-		txOutputs.Sum[cardanowallet.AdaTokenName])
+		txOutputs.Sum, minChangeAmount)
 	if err != nil {
 		return nil, err
 	}
 
+	fanOutOutputs, err := cco.getFanOutOutputs(data.MultisigAddr, multisigUtxos, txOutputs.Sum)
+	if err != nil {
+		return nil, err
+	}
+
+	txOutputs.Outputs = append(txOutputs.Outputs, fanOutOutputs...)
+
 	slotNumber, err := cco.getSlotNumber()
 	if err != nil {
 		return nil, err
@@ -211,7 +335,8 @@ func (cco *CardanoChainOperations) generateBatchTransaction(
 		"slot", slotNumber, "multisig", len(multisigUtxos), "fee", len(feeUtxos), "outputs", len(txOutputs.Outputs))
 
 	// Create Tx
-	txRaw, txHash, err := cardano.CreateTx(
+	txRaw, txHash, err := cardano.CreateTxWithBackend(
+		cco.config.TxBuilderBackend,
 		cco.cardanoCliBinary,
 		uint(cco.config.NetworkMagic),
 		data.ProtocolParams,
@@ -235,19 +360,69 @@ func (cco *CardanoChainOperations) generateBatchTransaction(
 		return nil, err
 	}
 
-	if len(txRaw) > maxTxSize {
-		return nil, fmt.Errorf("%w: (size, max) = (%d, %d)",
-			errTxSizeTooBig, len(txRaw), maxTxSize)
-	}
-
 	return &core.GeneratedBatchTxData{
 		TxRaw:  txRaw,
 		TxHash: txHash,
 	}, nil
 }
 
+// generatePartialBatchTransaction generates the batch with a prefix of the confirmed transactions sorted by nonce
+// which does not break the limits. The prefix is found by a binary search, so only a logarithmic number of txs
+// is created. The limits are not strictly monotonic in the number of transactions, because utxos are selected again
+// for every prefix, so the prefix is not always the largest one, but every batcher includes the same transactions.
+// The signed batch of a prefix has lastTxNonceId lower than the nonce of the last confirmed transaction.
+// The bridge contract accepts such a batch and marks only the transactions up to its lastTxNonceId as batched,
+// so the remaining transactions stay confirmed and are returned for one of the next batches
+func generatePartialBatchTransaction(
+	confirmedTransactions []eth.ConfirmedTransaction,
+	generate func(txs []eth.ConfirmedTransaction) (*core.GeneratedBatchTxData, error),
+	isLimitErr func(err error) bool,
+) (*core.GeneratedBatchTxData, error) {
+	if len(confirmedTransactions) < 2 {
+		return nil, errors.New("partial batch requires at least two confirmed transactions")
+	}
+
+	sortedTxs := slices.Clone(confirmedTransactions)
+
+	slices.SortFunc(sortedTxs, func(a, b eth.ConfirmedTransaction) int {
+		return cmp.Compare(a.Nonce, b.Nonce)
+	})
+
+	var (
+		result   *core.GeneratedBatchTxData
+		limitErr error
+	)
+
+	// the batch with all the transactions already breaks the limits
+	for low, high := 1, len(sortedTxs)-1; low <= high; {
+		cnt := (low + high + 1) / 2
+
+		txData, err := generate(sortedTxs[:cnt])
+		if err == nil {
+			txData.IncludedTxs = sortedTxs[:cnt]
+			result = txData
+			low = cnt + 1
+
+			continue
+		}
+
+		if !isLimitErr(err) {
+			return nil, err
+		}
+
+		limitErr = err
+		high = cnt - 1
+	}
+
+	if result == nil {
+		return nil, limitErr
+	}
+
+	return result, nil
+}
+
 func (cco *CardanoChainOperations) shouldConsolidate(err error) bool {
-	return errors.Is(err, errUTXOsLimitReached) || errors.Is(err, errTxSizeTooBig)
+	return errors.Is(err, errUTXOsLimitReached) || errors.Is(err, cardano.ErrTxSizeTooBig)
 }
 
 func (cco *CardanoChainOperations) generateConsolidationTransaction(
@@ -273,7 +448,8 @@ func (cco *CardanoChainOperations) generateConsolidationTransaction(
 		"slot", slotNumber, "multisig", len(multisigUtxos), "fee", len(feeUtxos))
 
 	// Create Tx
-	txRaw, txHash, err := cardano.CreateTx(
+	txRaw, txHash, err := cardano.CreateTxWithBackend(
+		cco.config.TxBuilderBackend,
 		cco.cardanoCliBinary,
 		uint(cco.config.NetworkMagic),
 		data.ProtocolParams,
@@ -299,10 +475,6 @@ func (cco *CardanoChainOperations) generateConsolidationTransaction(
 		return nil, err
 	}
 
-	if len(txRaw) > maxTxSize {
-		return nil, fmt.Errorf("%w: (size, max) = (%d, %d)", errTxSizeTooBig, len(txRaw), maxTxSize)
-	}
-
 	return &core.GeneratedBatchTxData{
 		BatchType: uint8(Consolidation),
 		TxRaw:     txRaw,
@@ -334,7 +506,7 @@ func (cco *CardanoChainOperations) getUTXOsForConsolidation(
 		"multisig", multisigAddress, "utxos", multisigUtxos, "fee", multisigFeeAddress, "utxos", feeUtxos)
 
 	// do not take more than maxFeeUtxoCount
-	feeUtxos = feeUtxos[:min(int(cco.config.MaxFeeUtxoCount), len(feeUtxos))] //nolint:gosec
+	feeUtxos = getFeeUtxos(feeUtxos, cco.config.UtxoMaintenance, int(cco.config.MaxFeeUtxoCount)) //nolint:gosec
 	// do not take more than maxUtxoCount - length of chosen fee utxos
 	maxUtxosCnt := min(getMaxUtxoCount(cco.config, len(feeUtxos)), len(multisigUtxos))
 	multisigUtxos = multisigUtxos[:maxUtxosCnt]
@@ -347,7 +519,8 @@ func (cco *CardanoChainOperations) getUTXOsForConsolidation(
 func (cco *CardanoChainOperations) getUTXOs(
 	multisigAddress, multisigFeeAddress string,
 	refundUtxos []*indexer.TxInputOutput,
-	desiredSum uint64,
+	desiredSums map[string]uint64,
+	minChangeAmount uint64,
 ) (multisigUtxos []*indexer.TxInputOutput, feeUtxos []*indexer.TxInputOutput, err error) {
 	multisigUtxos, err = cco.db.GetAllTxOutputs(multisigAddress, true)
 	if err != nil {
@@ -359,34 +532,76 @@ func (cco *CardanoChainOperations) getUTXOs(
 		return nil, nil, fmt.Errorf("failed to retrieve tx outputs for fee address: %w", err)
 	}
 
-	multisigUtxos = filterOutTokenUtxos(multisigUtxos)
+	telemetry.UpdateBatcherUtxoCount(cco.chainID, "multisig", len(multisigUtxos))
+	telemetry.UpdateBatcherUtxoCount(cco.chainID, "fee", len(feeUtxos))
+	telemetry.UpdateBatcherUtxoAmounts(cco.chainID, "multisig", getUtxoAmounts(multisigUtxos))
+	telemetry.UpdateBatcherUtxoAmounts(cco.chainID, "fee", getUtxoAmounts(feeUtxos))
+
+	multisigUtxos, multisigTokenUtxos := splitTokenUtxos(multisigUtxos, cco.config.NativeTokens)
 	feeUtxos = filterOutTokenUtxos(feeUtxos)
+	allMultisigUtxos := multisigUtxos
 
 	if len(feeUtxos) == 0 {
 		return nil, nil, fmt.Errorf("fee multisig does not have any utxo: %s", multisigFeeAddress)
 	}
 
 	cco.logger.Debug("UTXOs retrieved",
-		"multisig", multisigAddress, "utxos", multisigUtxos, "fee", multisigFeeAddress, "utxos", feeUtxos)
+		"multisig", multisigAddress, "utxos", multisigUtxos, "tokens", multisigTokenUtxos,
+		"fee", multisigFeeAddress, "utxos", feeUtxos)
+
+	// do not take more than MaxFeeUtxoCount
+	feeUtxos = getFeeUtxos(feeUtxos, cco.config.UtxoMaintenance, int(cco.config.MaxFeeUtxoCount)) //nolint:gosec
 
-	feeUtxos = feeUtxos[:min(cco.config.MaxFeeUtxoCount, uint(len(feeUtxos)))] // do not take more than MaxFeeUtxoCount
+	// desired sums should be reduced by amounts of refund utxos
+	desiredSums = maps.Clone(desiredSums)
 
-	// desiredSum should be reduced by amount of refund utxos
 	for _, utxo := range refundUtxos {
-		desiredSum -= utxo.Output.Amount
+		desiredSums[cardanowallet.AdaTokenName] -= utxo.Output.Amount
+
+		for _, token := range utxo.Output.Tokens {
+			tokenName := token.TokenName()
+			desiredSums[tokenName] -= min(desiredSums[tokenName], token.Amount)
+		}
 	}
 
-	multisigUtxos, err = getNeededUtxos(
+	tokenUtxos, err := getNeededTokenUtxos(
+		multisigTokenUtxos,
+		desiredSums,
+		getMaxUtxoCount(cco.config, len(feeUtxos)+len(refundUtxos)),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// lovelace of the chosen token utxos is spent too
+	desiredSum := desiredSums[cardanowallet.AdaTokenName]
+	for _, utxo := range tokenUtxos {
+		desiredSum -= min(desiredSum, utxo.Output.Amount)
+	}
+
+	multisigUtxos, err = cco.utxoSelector.SelectUtxos(
 		multisigUtxos,
 		desiredSum,
-		cco.config.UtxoMinAmount,
-		getMaxUtxoCount(cco.config, len(feeUtxos)+len(refundUtxos)),
+		minChangeAmount,
+		getMaxUtxoCount(cco.config, len(feeUtxos)+len(refundUtxos)+len(tokenUtxos)),
 		int(cco.config.TakeAtLeastUtxoCount), //nolint:gosec
 	)
 	if err != nil {
 		return
 	}
 
+	consolidationUtxos := getConsolidationUtxos(
+		allMultisigUtxos, multisigUtxos, cco.config.UtxoMaintenance,
+		getMaxUtxoCount(cco.config, len(feeUtxos)+len(refundUtxos)+len(tokenUtxos)+len(multisigUtxos)))
+	if len(consolidationUtxos) > 0 {
+		cco.logger.Info("Consolidating multisig UTXOs within batch",
+			"utxos", len(allMultisigUtxos), "threshold", cco.config.UtxoMaintenance.ConsolidationThreshold,
+			"consolidated", len(consolidationUtxos))
+
+		multisigUtxos = append(multisigUtxos, consolidationUtxos...)
+	}
+
+	multisigUtxos = append(multisigUtxos, tokenUtxos...)  // add token UTXOs to multisig UTXOs
 	multisigUtxos = append(multisigUtxos, refundUtxos...) // add refund UTXOs to multisig UTXOs
 
 	cco.logger.Debug("UTXOs chosen", "multisig", multisigUtxos, "fee", feeUtxos, "refund count", len(refundUtxos))
@@ -394,6 +609,66 @@ func (cco *CardanoChainOperations) getUTXOs(
 	return multisigUtxos, feeUtxos, nil
 }
 
+// getMinChangeAmount returns the minimal lovelace amount of the multisig change which could hold all the native tokens
+func (cco *CardanoChainOperations) getMinChangeAmount(protocolParams []byte, multisigAddress string) (uint64, error) {
+	txLimits, err := cardano.NewTxLimits(protocolParams)
+	if err != nil {
+		return 0, err
+	}
+
+	tokens := make([]cardanowallet.TokenAmount, len(cco.config.NativeTokens))
+
+	for i, tokenName := range cco.config.NativeTokens {
+		token, err := cardanowallet.NewTokenWithFullName(tokenName, true)
+		if err != nil {
+			return 0, err
+		}
+
+		tokens[i] = cardanowallet.NewTokenAmount(token, math.MaxUint64)
+	}
+
+	minUtxo, err := txLimits.GetMinUtxo(cardanowallet.NewTxOutput(multisigAddress, math.MaxUint64, tokens...))
+	if err != nil {
+		return 0, err
+	}
+
+	return max(cco.config.UtxoMinAmount, minUtxo), nil
+}
+
+// getFanOutOutputs returns additional multisig outputs which split the change of the batch into utxo buckets
+func (cco *CardanoChainOperations) getFanOutOutputs(
+	multisigAddress string, chosenUtxos []*indexer.TxInputOutput, outputsSums map[string]uint64,
+) ([]cardanowallet.TxOutput, error) {
+	maintenanceConfig := cco.config.UtxoMaintenance
+	if len(maintenanceConfig.Buckets) == 0 {
+		return nil, nil
+	}
+
+	allUtxos, err := cco.db.GetAllTxOutputs(multisigAddress, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tx outputs for multisig address: %w", err)
+	}
+
+	remainingUtxos := getNotChosenUtxos(filterOutTokenUtxos(allUtxos), chosenUtxos)
+
+	chosenSum := uint64(0)
+	for _, utxo := range chosenUtxos {
+		chosenSum += utxo.Output.Amount
+	}
+
+	outputsSum := outputsSums[cardanowallet.AdaTokenName]
+	if chosenSum <= outputsSum {
+		return nil, nil
+	}
+
+	outputs := getFanOutOutputs(multisigAddress, remainingUtxos, maintenanceConfig, chosenSum-outputsSum)
+
+	cco.logger.Debug("UTXO buckets", "remaining", getUtxoStats(remainingUtxos, maintenanceConfig),
+		"change", chosenSum-outputsSum, "fan out", len(outputs))
+
+	return outputs, nil
+}
+
 func (cco *CardanoChainOperations) getSlotNumber() (uint64, error) {
 	data, err := cco.db.GetLatestBlockPoint()
 	if err != nil {
@@ -445,8 +720,9 @@ func (cco *CardanoChainOperations) createBatchInitialData(
 	}
 
 	metadata, err := common.MarshalMetadata(common.MetadataEncodingTypeJSON, common.BatchExecutedMetadata{
-		BridgingTxType: common.BridgingTxTypeBatchExecution,
-		BatchNonceID:   batchNonceID,
+		BridgingTxType:   common.BridgingTxTypeBatchExecution,
+		BatchNonceID:     batchNonceID,
+		TxBuilderBackend: cco.config.TxBuilderBackend.GetBatchMetadataValue(),
 	})
 	if err != nil {
 		return nil, err
@@ -535,6 +811,91 @@ func filterOutTokenUtxos(utxos []*indexer.TxInputOutput) []*indexer.TxInputOutpu
 	return result
 }
 
+// splitTokenUtxos separates utxos without tokens from utxos which hold only the known native tokens.
+// Utxos with any other token are left out
+func splitTokenUtxos(
+	utxos []*indexer.TxInputOutput, knownTokens []string,
+) (result []*indexer.TxInputOutput, tokenUtxos []*indexer.TxInputOutput) {
+	result = make([]*indexer.TxInputOutput, 0, len(utxos))
+
+	for _, utxo := range utxos {
+		if len(utxo.Output.Tokens) == 0 {
+			result = append(result, utxo)
+
+			continue
+		}
+
+		hasOnlyKnownTokens := true
+
+		for _, token := range utxo.Output.Tokens {
+			if !slices.Contains(knownTokens, token.TokenName()) {
+				hasOnlyKnownTokens = false
+
+				break
+			}
+		}
+
+		if hasOnlyKnownTokens {
+			tokenUtxos = append(tokenUtxos, utxo)
+		}
+	}
+
+	return result, tokenUtxos
+}
+
+// getNeededTokenUtxos returns utxos which hold the desired amounts of native tokens
+// Utxos are taken from first to last and only if they hold some of the still missing tokens
+func getNeededTokenUtxos(
+	inputUTXOs []*indexer.TxInputOutput,
+	desiredSums map[string]uint64,
+	maxUtxoCount int,
+) (chosenUTXOs []*indexer.TxInputOutput, err error) {
+	missingSums := make(map[string]uint64, len(desiredSums))
+
+	for tokenName, amount := range desiredSums {
+		if tokenName != cardanowallet.AdaTokenName && amount > 0 {
+			missingSums[tokenName] = amount
+		}
+	}
+
+	for _, utxo := range inputUTXOs {
+		if len(missingSums) == 0 {
+			break
+		}
+
+		isNeeded := slices.ContainsFunc(utxo.Output.Tokens, func(token indexer.TokenAmount) bool {
+			return missingSums[token.TokenName()] > 0
+		})
+		if !isNeeded {
+			continue
+		}
+
+		if len(chosenUTXOs) >= maxUtxoCount {
+			return nil, fmt.Errorf("%w: native tokens %v are missing", errUTXOsLimitReached, missingSums)
+		}
+
+		chosenUTXOs = append(chosenUTXOs, utxo)
+
+		for _, token := range utxo.Output.Tokens {
+			tokenName := token.TokenName()
+
+			if amount, exists := missingSums[tokenName]; exists {
+				if token.Amount >= amount {
+					delete(missingSums, tokenName)
+				} else {
+					missingSums[tokenName] = amount - token.Amount
+				}
+			}
+		}
+	}
+
+	if len(missingSums) > 0 {
+		return nil, fmt.Errorf("%w: native tokens %v are missing", errUTXOsCouldNotSelect, missingSums)
+	}
+
+	return chosenUTXOs, nil
+}
+
 func convertUTXOsToTxInputs(utxos []*indexer.TxInputOutput) (result cardanowallet.TxInputs) {
 	// For now we are taking all available UTXOs as fee (should always be 1-2 of them)
 	result.Inputs = make([]cardanowallet.TxInput, len(utxos))
@@ -674,6 +1035,11 @@ func getOutputs(
 		} else {
 			for _, receiver := range tx.Receivers {
 				updateMap(receiver.DestinationAddress, cardanowallet.AdaTokenName, receiver.Amount.Uint64())
+
+				// token of the receiver is already the full name of the native token on this chain
+				if receiver.TokenAmount != nil && receiver.TokenAmount.Sign() > 0 {
+					updateMap(receiver.DestinationAddress, receiver.Token, receiver.TokenAmount.Uint64())
+				}
 			}
 		}
 	}
@@ -841,8 +1207,9 @@ func (cco *CardanoChainOperations) CreateValidatorSetChangeTx(ctx context.Contex
 	}
 
 	metadataStruct := common.BatchExecutedMetadata{
-		BridgingTxType: common.BridgingTxTypeBatchExecution,
-		BatchNonceID:   nextBatchID,
+		BridgingTxType:   common.BridgingTxTypeBatchExecution,
+		BatchNonceID:     nextBatchID,
+		TxBuilderBackend: cco.config.TxBuilderBackend.GetBatchMetadataValue(),
 	}
 	if isFeeOnly {
 		metadataStruct.IsFeeOnlyTx = 1
@@ -877,7 +1244,8 @@ func (cco *CardanoChainOperations) CreateValidatorSetChangeTx(ctx context.Contex
 			"slot", slotNumber, "validator set cutoff slot number", validatorsData.SlotNumber,
 			"multisig", len(multisigUtxos), "fee", len(feeUtxos), "output", output)
 
-		txRaw, txHash, err = cardano.CreateOnlyFeeTx(
+		txRaw, txHash, err = cardano.CreateOnlyFeeTxWithBackend(
+			cco.config.TxBuilderBackend,
 			cco.cardanoCliBinary,
 			uint(cco.config.NetworkMagic),
 			protocolParams,
@@ -912,7 +1280,8 @@ func (cco *CardanoChainOperations) CreateValidatorSetChangeTx(ctx context.Contex
 			"slot", slotNumber, "validator set cutoff slot number", validatorsData.SlotNumber,
 			"multisig", len(multisigUtxos), "fee", len(feeUtxos), "outputs", outputs)
 
-		txRaw, txHash, err = cardano.CreateTx(
+		txRaw, txHash, err = cardano.CreateTxWithBackend(
+			cco.config.TxBuilderBackend,
 			cco.cardanoCliBinary,
 			uint(cco.config.NetworkMagic),
 			protocolParams,
@@ -990,6 +990,54 @@ func Test_getOutputs(t *testing.T) {
 		}, res.Outputs)
 	})
 
+	t.Run("getOutputs with native tokens pass", func(t *testing.T) {
+		token := cardanowallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route3")
+		txs := []eth.ConfirmedTransaction{
+			{
+				Receivers: []eth.BridgeReceiver{
+					{
+						DestinationAddress: "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+						Amount:             big.NewInt(50),
+						TokenAmount:        big.NewInt(7),
+						Token:              token.String(),
+					},
+					{
+						DestinationAddress: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+						Amount:             big.NewInt(900),
+						TokenAmount:        big.NewInt(0),
+					},
+				},
+			},
+			{
+				Receivers: []eth.BridgeReceiver{
+					{
+						DestinationAddress: "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+						Amount:             big.NewInt(10),
+						TokenAmount:        big.NewInt(3),
+						Token:              token.String(),
+					},
+				},
+			},
+		}
+
+		res := getOutputs(txs, cardanowallet.MainNetNetwork,
+			[][]*indexer.TxInputOutput{}, "", 100, hclog.NewNullLogger())
+
+		assert.Equal(t, uint64(960), res.Sum[cardanowallet.AdaTokenName])
+		assert.Equal(t, uint64(10), res.Sum[token.String()])
+		assert.Equal(t, []cardanowallet.TxOutput{
+			{
+				Addr:   "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+				Amount: 900,
+			},
+			{
+				Addr:   "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+				Amount: 60,
+				Tokens: []cardanowallet.TokenAmount{cardanowallet.NewTokenAmount(token, 10)},
+			},
+		}, res.Outputs)
+	})
+
 	t.Run("getOutputs with refund pass", func(t *testing.T) {
 		refundTxAmount := uint64(300)
 		txs := append(slices.Clone(txs), eth.ConfirmedTransaction{
@@ -1123,7 +1171,8 @@ func Test_getUTXOs(t *testing.T) {
 	feeAddr := "0x002"
 	testErr := errors.New("test err")
 	ops := &CardanoChainOperations{
-		db: dbMock,
+		db:           dbMock,
+		utxoSelector: &oldestFirstUtxoSelector{},
 		config: &cardano.CardanoChainConfig{
 			NoBatchPeriodPercent: 0.1,
 			MaxFeeUtxoCount:      4,
@@ -1135,7 +1184,8 @@ func Test_getUTXOs(t *testing.T) {
 	t.Run("GetAllTxOutputs multisig error", func(t *testing.T) {
 		dbMock.On("GetAllTxOutputs", multisigAddr, true).Return(([]*indexer.TxInputOutput)(nil), testErr).Once()
 
-		_, _, err := ops.getUTXOs(multisigAddr, feeAddr, nil, 2_000_000)
+		_, _, err := ops.getUTXOs(
+			multisigAddr, feeAddr, nil, map[string]uint64{cardanowallet.AdaTokenName: 2_000_000}, 0)
 		require.Error(t, err)
 	})
 
@@ -1143,7 +1193,8 @@ func Test_getUTXOs(t *testing.T) {
 		dbMock.On("GetAllTxOutputs", multisigAddr, true).Return([]*indexer.TxInputOutput{}, error(nil)).Once()
 		dbMock.On("GetAllTxOutputs", feeAddr, true).Return(([]*indexer.TxInputOutput)(nil), testErr).Once()
 
-		_, _, err := ops.getUTXOs(multisigAddr, feeAddr, nil, 2_000_000)
+		_, _, err := ops.getUTXOs(
+			multisigAddr, feeAddr, nil, map[string]uint64{cardanowallet.AdaTokenName: 2_000_000}, 0)
 		require.Error(t, err)
 	})
 
@@ -1168,7 +1219,8 @@ func Test_getUTXOs(t *testing.T) {
 		dbMock.On("GetAllTxOutputs", multisigAddr, true).Return(allMultisigUtxos, error(nil)).Once()
 		dbMock.On("GetAllTxOutputs", feeAddr, true).Return(allFeeUtxos, error(nil)).Once()
 
-		multisigUtxos, feeUtxos, err := ops.getUTXOs(multisigAddr, feeAddr, nil, 2_000_000)
+		multisigUtxos, feeUtxos, err := ops.getUTXOs(
+			multisigAddr, feeAddr, nil, map[string]uint64{cardanowallet.AdaTokenName: 2_000_000}, 0)
 
 		require.NoError(t, err)
 		require.Equal(t, expectedUtxos[0:2], multisigUtxos)
@@ -1202,12 +1254,219 @@ func Test_getUTXOs(t *testing.T) {
 		dbMock.On("GetAllTxOutputs", multisigAddr, true).Return(allMultisigUtxos, error(nil)).Once()
 		dbMock.On("GetAllTxOutputs", feeAddr, true).Return(allFeeUtxos, error(nil)).Once()
 
-		multisigUtxos, feeUtxos, err := ops.getUTXOs(multisigAddr, feeAddr, refundUtxos, 2_000_000)
+		multisigUtxos, feeUtxos, err := ops.getUTXOs(
+			multisigAddr, feeAddr, refundUtxos, map[string]uint64{cardanowallet.AdaTokenName: 2_000_000}, 0)
 
 		require.NoError(t, err)
 		require.Equal(t, expectedUtxos[2:], feeUtxos)
 		require.Equal(t, append(expectedUtxos[0:2], refundUtxos...), multisigUtxos)
 	})
+
+	t.Run("pass with consolidation", func(t *testing.T) {
+		allMultisigUtxos := []*indexer.TxInputOutput{
+			{
+				Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x01"), Index: 2},
+				Output: indexer.TxOutput{Amount: 3_000_000, Slot: 80},
+			},
+			{
+				Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x01"), Index: 3},
+				Output: indexer.TxOutput{Amount: 1_500_000, Slot: 1900},
+			},
+			{
+				Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x02"), Index: 0},
+				Output: indexer.TxOutput{Amount: 1_000_000, Slot: 2000},
+			},
+		}
+		allFeeUtxos := []*indexer.TxInputOutput{
+			{
+				Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0xAA"), Index: 100},
+				Output: indexer.TxOutput{Amount: 10},
+			},
+		}
+
+		ops := &CardanoChainOperations{
+			db:           dbMock,
+			utxoSelector: &oldestFirstUtxoSelector{},
+			config: &cardano.CardanoChainConfig{
+				MaxFeeUtxoCount: 4,
+				MaxUtxoCount:    50,
+				UtxoMaintenance: cardano.UtxoMaintenanceConfig{
+					ConsolidationThreshold: 2,
+					ConsolidationUtxoCount: 5,
+				},
+			},
+			logger: hclog.NewNullLogger(),
+		}
+
+		dbMock.On("GetAllTxOutputs", multisigAddr, true).Return(allMultisigUtxos, error(nil)).Once()
+		dbMock.On("GetAllTxOutputs", feeAddr, true).Return(allFeeUtxos, error(nil)).Once()
+
+		multisigUtxos, feeUtxos, err := ops.getUTXOs(
+			multisigAddr, feeAddr, nil, map[string]uint64{cardanowallet.AdaTokenName: 2_000_000}, 0)
+
+		require.NoError(t, err)
+		require.Equal(t, allFeeUtxos, feeUtxos)
+		require.Equal(t, []*indexer.TxInputOutput{allMultisigUtxos[0], allMultisigUtxos[2]}, multisigUtxos)
+	})
+}
+
+func Test_generatePartialBatchTransaction(t *testing.T) {
+	confirmedTxs := []eth.ConfirmedTransaction{
+		{Nonce: 14}, {Nonce: 11}, {Nonce: 12}, {Nonce: 15}, {Nonce: 13},
+	}
+	isLimitErr := func(err error) bool {
+		return errors.Is(err, errUTXOsLimitReached)
+	}
+	generateUpTo := func(maxTxs int, err error) func([]eth.ConfirmedTransaction) (*core.GeneratedBatchTxData, error) {
+		return func(txs []eth.ConfirmedTransaction) (*core.GeneratedBatchTxData, error) {
+			if len(txs) > maxTxs {
+				return nil, err
+			}
+
+			return &core.GeneratedBatchTxData{TxHash: fmt.Sprintf("hash_%d", len(txs))}, nil
+		}
+	}
+
+	t.Run("largest prefix", func(t *testing.T) {
+		for maxTxs := 1; maxTxs < len(confirmedTxs); maxTxs++ {
+			txData, err := generatePartialBatchTransaction(
+				confirmedTxs, generateUpTo(maxTxs, errUTXOsLimitReached), isLimitErr)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("hash_%d", maxTxs), txData.TxHash)
+			require.Len(t, txData.IncludedTxs, maxTxs)
+
+			for i, tx := range txData.IncludedTxs {
+				require.Equal(t, uint64(11+i), tx.Nonce)
+			}
+		}
+	})
+
+	t.Run("limits are not monotonic", func(t *testing.T) {
+		generated := []int(nil)
+
+		txData, err := generatePartialBatchTransaction(
+			confirmedTxs,
+			func(txs []eth.ConfirmedTransaction) (*core.GeneratedBatchTxData, error) {
+				generated = append(generated, len(txs))
+
+				// utxos chosen for three transactions break the limits while the ones for four do not
+				if len(txs) == 3 || len(txs) > 4 {
+					return nil, errUTXOsLimitReached
+				}
+
+				return &core.GeneratedBatchTxData{TxHash: fmt.Sprintf("hash_%d", len(txs))}, nil
+			},
+			isLimitErr)
+		require.NoError(t, err)
+		// the binary search does not try the larger prefix once a smaller one breaks the limits
+		require.Equal(t, "hash_2", txData.TxHash)
+		require.Equal(t, []int{3, 2}, generated)
+	})
+
+	t.Run("logarithmic number of txs", func(t *testing.T) {
+		manyTxs := make([]eth.ConfirmedTransaction, 1000)
+		for i := range manyTxs {
+			manyTxs[i].Nonce = uint64(i) //nolint:gosec
+		}
+
+		generated := 0
+
+		txData, err := generatePartialBatchTransaction(
+			manyTxs,
+			func(txs []eth.ConfirmedTransaction) (*core.GeneratedBatchTxData, error) {
+				generated++
+
+				return generateUpTo(321, errUTXOsLimitReached)(txs)
+			},
+			isLimitErr)
+		require.NoError(t, err)
+		require.Len(t, txData.IncludedTxs, 321)
+		require.Equal(t, uint64(320), txData.IncludedTxs[320].Nonce)
+		require.LessOrEqual(t, generated, 10)
+	})
+
+	t.Run("single transaction", func(t *testing.T) {
+		_, err := generatePartialBatchTransaction(confirmedTxs[:1], generateUpTo(1, errUTXOsLimitReached), isLimitErr)
+		require.ErrorContains(t, err, "at least two confirmed transactions")
+	})
+
+	t.Run("not even a single transaction", func(t *testing.T) {
+		_, err := generatePartialBatchTransaction(confirmedTxs, generateUpTo(0, errUTXOsLimitReached), isLimitErr)
+		require.ErrorIs(t, err, errUTXOsLimitReached)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		testErr := errors.New("test err")
+
+		_, err := generatePartialBatchTransaction(confirmedTxs, generateUpTo(1, testErr), isLimitErr)
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func Test_getNeededTokenUtxos(t *testing.T) {
+	token1 := cardanowallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route3")
+	token2 := cardanowallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route4")
+	inputs := []*indexer.TxInputOutput{
+		{
+			Input: indexer.TxInput{Hash: indexer.NewHashFromHexString("0x1"), Index: 0},
+			Output: indexer.TxOutput{Amount: 10, Tokens: []indexer.TokenAmount{
+				{PolicyID: token1.PolicyID, Name: token1.Name, Amount: 5},
+			}},
+		},
+		{
+			Input: indexer.TxInput{Hash: indexer.NewHashFromHexString("0x1"), Index: 1},
+			Output: indexer.TxOutput{Amount: 10, Tokens: []indexer.TokenAmount{
+				{PolicyID: token2.PolicyID, Name: token2.Name, Amount: 5},
+			}},
+		},
+		{
+			Input: indexer.TxInput{Hash: indexer.NewHashFromHexString("0x1"), Index: 2},
+			Output: indexer.TxOutput{Amount: 10, Tokens: []indexer.TokenAmount{
+				{PolicyID: token1.PolicyID, Name: token1.Name, Amount: 8},
+			}},
+		},
+	}
+
+	t.Run("no tokens needed", func(t *testing.T) {
+		result, err := getNeededTokenUtxos(inputs, map[string]uint64{cardanowallet.AdaTokenName: 100}, 10)
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("pass", func(t *testing.T) {
+		result, err := getNeededTokenUtxos(inputs, map[string]uint64{token1.String(): 6}, 10)
+		require.NoError(t, err)
+		require.Equal(t, []*indexer.TxInputOutput{inputs[0], inputs[2]}, result)
+
+		result, err = getNeededTokenUtxos(inputs, map[string]uint64{token1.String(): 5, token2.String(): 1}, 10)
+		require.NoError(t, err)
+		require.Equal(t, inputs[:2], result)
+	})
+
+	t.Run("not enough tokens", func(t *testing.T) {
+		_, err := getNeededTokenUtxos(inputs, map[string]uint64{token1.String(): 14}, 10)
+		require.ErrorIs(t, err, errUTXOsCouldNotSelect)
+	})
+
+	t.Run("utxos limit reached", func(t *testing.T) {
+		_, err := getNeededTokenUtxos(inputs, map[string]uint64{token1.String(): 6}, 1)
+		require.ErrorIs(t, err, errUTXOsLimitReached)
+	})
+
+	t.Run("split token utxos", func(t *testing.T) {
+		unknownTokenUtxo := &indexer.TxInputOutput{
+			Output: indexer.TxOutput{Amount: 10, Tokens: []indexer.TokenAmount{
+				{PolicyID: token1.PolicyID, Name: "unknown", Amount: 5},
+			}},
+		}
+		plainUtxo := &indexer.TxInputOutput{Output: indexer.TxOutput{Amount: 10}}
+
+		result, tokenUtxos := splitTokenUtxos(
+			append([]*indexer.TxInputOutput{plainUtxo, unknownTokenUtxo}, inputs...),
+			[]string{token1.String(), token2.String()})
+		require.Equal(t, []*indexer.TxInputOutput{plainUtxo}, result)
+		require.Equal(t, inputs, tokenUtxos)
+	})
 }
 
 func generateSmallUtxoOutputs(value uint64, n uint64) ([]*indexer.TxInputOutput, uint64) {
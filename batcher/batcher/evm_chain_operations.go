@@ -4,18 +4,22 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"sort"
 
 	"github.com/Ethernal-Tech/apex-bridge/batcher/core"
 	cardano "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	"github.com/Ethernal-Tech/apex-bridge/testenv"
 	"github.com/Ethernal-Tech/apex-bridge/validatorobserver"
 	eventTrackerStore "github.com/Ethernal-Tech/blockchain-event-tracker/store"
 	"github.com/Ethernal-Tech/bn256"
 	"github.com/Ethernal-Tech/cardano-infrastructure/secrets"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/jsonrpc"
 	"github.com/hashicorp/go-hclog"
 )
 
@@ -29,14 +33,19 @@ const (
 
 const deadbeef = "0xdeadbeef"
 
+type evmBlockProvider interface {
+	GetBlockByNumber(i ethgo.BlockNumber, full bool) (*ethgo.Block, error)
+}
+
 type EVMChainOperations struct {
-	config       *cardano.BatcherEVMChainConfig
-	privateKey   *bn256.PrivateKey
-	db           eventTrackerStore.EventTrackerStore
-	ttlFormatter testenv.TTLFormatterFunc
-	gasLimiter   eth.GasLimitHolder
-	logger       hclog.Logger
-	bridgeSC     eth.IBridgeSmartContract
+	config        *cardano.BatcherEVMChainConfig
+	privateKey    *bn256.PrivateKey
+	db            eventTrackerStore.EventTrackerStore
+	ttlFormatter  testenv.TTLFormatterFunc
+	gasLimiter    eth.GasLimitHolder
+	logger        hclog.Logger
+	bridgeSC      eth.IBridgeSmartContract
+	blockProvider evmBlockProvider // set only for the safe and finalized confirmation modes
 }
 
 func NewEVMChainOperations(
@@ -57,14 +66,30 @@ func NewEVMChainOperations(
 		return nil, err
 	}
 
+	if err := config.ConfirmationMode.Validate(); err != nil {
+		return nil, err
+	}
+
+	var blockProvider evmBlockProvider
+
+	if config.ConfirmationMode.IsBlockTag() {
+		client, err := jsonrpc.NewClient(config.NodeURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create json rpc client: %w", err)
+		}
+
+		blockProvider = client.Eth()
+	}
+
 	return &EVMChainOperations{
-		config:       config,
-		privateKey:   privateKey,
-		db:           db,
-		ttlFormatter: testenv.GetTTLFormatter(config.TestMode),
-		gasLimiter:   eth.NewGasLimitHolder(submitBatchMinGasLimit, submitBatchMaxGasLimit, submitBatchStepsGasLimit),
-		logger:       logger,
-		bridgeSC:     bridgeSC,
+		config:        config,
+		privateKey:    privateKey,
+		db:            db,
+		blockProvider: blockProvider,
+		ttlFormatter:  testenv.GetTTLFormatter(config.TestMode),
+		gasLimiter:    eth.NewGasLimitHolder(submitBatchMinGasLimit, submitBatchMaxGasLimit, submitBatchStepsGasLimit),
+		logger:        logger,
+		bridgeSC:      bridgeSC,
 	}, nil
 }
 
@@ -119,7 +144,7 @@ func (cco *EVMChainOperations) GenerateBatchTransaction(
 	confirmedTransactions []eth.ConfirmedTransaction,
 	batchNonceID uint64,
 ) (*core.GeneratedBatchTxData, error) {
-	lastProcessedBlock, err := cco.db.GetLastProcessedBlock()
+	lastProcessedBlock, err := cco.getLastConfirmedBlock()
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +161,7 @@ func (cco *EVMChainOperations) GenerateBatchTransaction(
 		confirmedTransactions,
 		common.DfmToWei(new(big.Int).SetUint64(cco.config.MinFeeForBridging)))
 
-	txsBytes, err := txs.Pack()
+	txsBytes, err := txs.Pack(contractbinding.GetContractsABI(cco.config.LegacyContractsABI))
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +185,17 @@ func (cco *EVMChainOperations) GenerateBatchTransaction(
 	}, nil
 }
 
+// GenerateMaintenanceTransaction implements core.ChainOperations.
+// EVM chains have no utxos, so they never need maintenance batches
+func (cco *EVMChainOperations) GenerateMaintenanceTransaction(
+	ctx context.Context,
+	bridgeSmartContract eth.IBridgeSmartContract,
+	chainID string,
+	batchNonceID uint64,
+) (*core.GeneratedBatchTxData, error) {
+	return nil, nil
+}
+
 // SignBatchTransaction implements core.ChainOperations.
 func (cco *EVMChainOperations) SignBatchTransaction(
 	generatedBatchData *core.GeneratedBatchTxData) ([]byte, []byte, error) {
@@ -195,7 +231,7 @@ func (cco *EVMChainOperations) IsSynchronized(
 		return false, err
 	}
 
-	latestBlock, err := cco.db.GetLastProcessedBlock()
+	latestBlock, err := cco.getLastConfirmedBlock()
 	if err != nil {
 		return false, err
 	}
@@ -203,6 +239,22 @@ func (cco *EVMChainOperations) IsSynchronized(
 	return latestBlock >= lastObservedBlockBridge.BlockSlot.Uint64(), nil
 }
 
+// getLastConfirmedBlock returns the last block processed by the oracle. For the safe and finalized confirmation modes
+// it is capped by the tagged block of the node, so batches never rely on blocks the node does not consider confirmed
+func (cco *EVMChainOperations) getLastConfirmedBlock() (uint64, error) {
+	lastProcessedBlock, err := cco.db.GetLastProcessedBlock()
+	if err != nil || cco.blockProvider == nil {
+		return lastProcessedBlock, err
+	}
+
+	block, err := cco.blockProvider.GetBlockByNumber(cco.config.ConfirmationMode.BlockNumber(), false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s block: %w", cco.config.ConfirmationMode, err)
+	}
+
+	return min(lastProcessedBlock, block.Number), nil
+}
+
 func (cco *EVMChainOperations) Submit(
 	ctx context.Context, bridgeSmartContract eth.IBridgeSmartContract, batch eth.SignedBatch,
 ) error {
@@ -219,19 +271,28 @@ func newEVMSmartContractTransaction(
 	confirmedTransactions []eth.ConfirmedTransaction,
 	minFeeForBridging *big.Int,
 ) eth.EVMSmartContractTransaction {
+	// receivers of the native tokens are kept apart from the currency only receivers with the same address
 	sourceAddrTxMap := map[string]eth.EVMSmartContractTransactionReceiver{}
 	feeAmount := big.NewInt(0)
 
-	updateAmount := func(mp map[string]eth.EVMSmartContractTransactionReceiver, addr string, amount *big.Int) {
-		val, exists := mp[addr]
+	updateAmount := func(
+		mp map[string]eth.EVMSmartContractTransactionReceiver, addr string, amount *big.Int,
+		token string, tokenAmount *big.Int,
+	) {
+		key := addr + "_" + token
+
+		val, exists := mp[key]
 		if !exists {
 			val.Amount = amount
 			val.Address = common.HexToAddress(addr)
+			val.Token = common.HexToAddress(token)
+			val.TokenAmount = new(big.Int).Set(tokenAmount)
 		} else {
 			val.Amount.Add(val.Amount, amount)
+			val.TokenAmount.Add(val.TokenAmount, tokenAmount)
 		}
 
-		mp[addr] = val
+		mp[key] = val
 	}
 
 	for _, tx := range confirmedTransactions {
@@ -242,7 +303,8 @@ func newEVMSmartContractTransaction(
 			// if else would be nicer but linter does not think the same way
 			if tx.TransactionType == uint8(common.RefundConfirmedTxType) {
 				feeAmount.Add(feeAmount, minFeeForBridging)
-				updateAmount(sourceAddrTxMap, recv.DestinationAddress, amount.Sub(amount, minFeeForBridging))
+				updateAmount(sourceAddrTxMap, recv.DestinationAddress, amount.Sub(amount, minFeeForBridging),
+					"", big.NewInt(0))
 
 				continue
 			}
@@ -253,7 +315,12 @@ func newEVMSmartContractTransaction(
 				continue
 			}
 
-			updateAmount(sourceAddrTxMap, recv.DestinationAddress, amount)
+			token, tokenAmount := "", big.NewInt(0)
+			if recv.TokenAmount != nil && recv.TokenAmount.Sign() > 0 {
+				token, tokenAmount = recv.Token, recv.TokenAmount
+			}
+
+			updateAmount(sourceAddrTxMap, recv.DestinationAddress, amount, token, tokenAmount)
 		}
 	}
 
@@ -265,7 +332,11 @@ func newEVMSmartContractTransaction(
 
 	// every batcher should have same order
 	sort.Slice(receivers, func(i, j int) bool {
-		return receivers[i].Address.Cmp(receivers[j].Address) < 0
+		if cmp := receivers[i].Address.Cmp(receivers[j].Address); cmp != 0 {
+			return cmp < 0
+		}
+
+		return receivers[i].Token.Cmp(receivers[j].Token) < 0
 	})
 
 	return eth.EVMSmartContractTransaction{
@@ -286,7 +357,7 @@ func (cco *EVMChainOperations) createVSCTxFn(
 	batchID uint64,
 	validatorsChainData []eth.ValidatorChainData,
 ) (*core.GeneratedBatchTxData, error) {
-	lastProcessedBlock, err := cco.db.GetLastProcessedBlock()
+	lastProcessedBlock, err := cco.getLastConfirmedBlock()
 	if err != nil {
 		return nil, err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/batcher/core"
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	"github.com/Ethernal-Tech/apex-bridge/testenv"
 	"github.com/Ethernal-Tech/apex-bridge/validatorobserver"
@@ -90,26 +91,69 @@ func TestEthChain_GenerateBatchTransaction(t *testing.T) {
 
 		require.Equal(t, []eth.EVMSmartContractTransactionReceiver{
 			{
-				Address: common.HexToAddress("0xaa"),
-				Amount:  common.DfmToWei(new(big.Int).SetUint64(1000)),
+				Address:     common.HexToAddress("0xaa"),
+				Amount:      common.DfmToWei(new(big.Int).SetUint64(1000)),
+				TokenAmount: big.NewInt(0),
 			},
 			{
-				Address: common.HexToAddress("0xcc"),
-				Amount:  common.DfmToWei(new(big.Int).SetUint64(10)),
+				Address:     common.HexToAddress("0xcc"),
+				Amount:      common.DfmToWei(new(big.Int).SetUint64(10)),
+				TokenAmount: big.NewInt(0),
 			},
 			{
-				Address: common.HexToAddress("0xff"),
-				Amount:  common.DfmToWei(new(big.Int).SetUint64(100)),
+				Address:     common.HexToAddress("0xff"),
+				Amount:      common.DfmToWei(new(big.Int).SetUint64(100)),
+				TokenAmount: big.NewInt(0),
 			},
 		}, txs.Receivers)
 
-		txsBytes, err := txs.Pack()
+		txsBytes, err := txs.Pack(contractbinding.NativeTokensContractsABI)
+		require.NoError(t, err)
+
+		hash, err := common.Keccak256(txsBytes)
+		require.NoError(t, err)
+
+		require.Equal(t, hex.EncodeToString(hash), dt.TxHash)
+	})
+
+	t.Run("legacy contracts abi", func(t *testing.T) {
+		confirmedTxs := []eth.ConfirmedTransaction{
+			{
+				SourceChainId: 2,
+				Receivers: []eth.BridgeReceiver{
+					{
+						Amount:             new(big.Int).SetUint64(100),
+						DestinationAddress: "0xff",
+					},
+				},
+			},
+		}
+
+		legacyJSONRaw, err := (cardanotx.BatcherEVMChainConfig{
+			TTLBlockNumberInc:      ttlBlockNumberInc,
+			BlockRoundingThreshold: 6,
+			NoBatchPeriodPercent:   0.1,
+			LegacyContractsABI:     true,
+		}).Serialize()
+		require.NoError(t, err)
+
+		ops, err := NewEVMChainOperations(
+			legacyJSONRaw, secretsMngr, dbMock, chainID, hclog.NewNullLogger(), nil)
+		require.NoError(t, err)
+
+		dt, err := ops.GenerateBatchTransaction(ctx, nil, chainID, confirmedTxs, batchNonceID)
+		require.NoError(t, err)
+
+		txs := newEVMSmartContractTransaction(batchNonceID, uint64(6)+ttlBlockNumberInc, confirmedTxs, big.NewInt(0))
+
+		txsBytes, err := txs.Pack(contractbinding.LegacyContractsABI)
 		require.NoError(t, err)
 
 		hash, err := common.Keccak256(txsBytes)
 		require.NoError(t, err)
 
 		require.Equal(t, hex.EncodeToString(hash), dt.TxHash)
+		require.Equal(t, txsBytes, dt.TxRaw)
 	})
 }
 
@@ -190,21 +234,83 @@ func TestEthChain_newEVMSmartContractTransaction(t *testing.T) {
 		FeeAmount:    common.DfmToWei(feeAmount),
 		Receivers: []eth.EVMSmartContractTransactionReceiver{
 			{
-				Address: common.HexToAddress("0xf0"),
-				Amount:  common.DfmToWei(new(big.Int).SetUint64(30)),
+				Address:     common.HexToAddress("0xf0"),
+				Amount:      common.DfmToWei(new(big.Int).SetUint64(30)),
+				TokenAmount: big.NewInt(0),
 			},
 			{
-				Address: common.HexToAddress("0xfa"),
-				Amount:  common.DfmToWei(new(big.Int).SetUint64(200)),
+				Address:     common.HexToAddress("0xfa"),
+				Amount:      common.DfmToWei(new(big.Int).SetUint64(200)),
+				TokenAmount: big.NewInt(0),
 			},
 			{
-				Address: common.HexToAddress("0xff"),
-				Amount:  common.DfmToWei(new(big.Int).SetUint64(121)),
+				Address:     common.HexToAddress("0xff"),
+				Amount:      common.DfmToWei(new(big.Int).SetUint64(121)),
+				TokenAmount: big.NewInt(0),
 			},
 		},
 	}, result)
 }
 
+func TestEthChain_newEVMSmartContractTransactionTokens(t *testing.T) {
+	const token = "0x00000000000000000000000000000000000000ab"
+
+	confirmedTxs := []eth.ConfirmedTransaction{
+		{
+			Receivers: []eth.BridgeReceiver{
+				{
+					Amount:             new(big.Int).SetUint64(100),
+					DestinationAddress: "0xff",
+					TokenAmount:        big.NewInt(0),
+				},
+				{
+					Amount:             new(big.Int).SetUint64(10),
+					DestinationAddress: "0xff",
+					TokenAmount:        big.NewInt(5),
+					Token:              token,
+				},
+			},
+		},
+		{
+			Receivers: []eth.BridgeReceiver{
+				{
+					Amount:             new(big.Int).SetUint64(20),
+					DestinationAddress: "0xff",
+					TokenAmount:        big.NewInt(3),
+					Token:              token,
+				},
+				{
+					Amount:             new(big.Int).SetUint64(30),
+					DestinationAddress: "0xfa",
+					TokenAmount:        big.NewInt(1),
+					Token:              token,
+				},
+			},
+		},
+	}
+
+	result := newEVMSmartContractTransaction(1, 2, confirmedTxs, big.NewInt(0))
+	require.Equal(t, []eth.EVMSmartContractTransactionReceiver{
+		{
+			Address:     common.HexToAddress("0xfa"),
+			Amount:      common.DfmToWei(new(big.Int).SetUint64(30)),
+			Token:       common.HexToAddress(token),
+			TokenAmount: big.NewInt(1),
+		},
+		{
+			Address:     common.HexToAddress("0xff"),
+			Amount:      common.DfmToWei(new(big.Int).SetUint64(100)),
+			TokenAmount: big.NewInt(0),
+		},
+		{
+			Address:     common.HexToAddress("0xff"),
+			Amount:      common.DfmToWei(new(big.Int).SetUint64(30)),
+			Token:       common.HexToAddress(token),
+			TokenAmount: big.NewInt(8),
+		},
+	}, result.Receivers)
+}
+
 func TestEthChain_newEVMSmartContractTransactionRefund(t *testing.T) {
 	batchNonceID := uint64(213)
 	ttl := uint64(39203902)
@@ -269,17 +375,20 @@ func TestEthChain_newEVMSmartContractTransactionRefund(t *testing.T) {
 			{
 				Address: common.HexToAddress("0xf0"),
 				// 30 - 1 * minFeeForBridging due to refund tx
-				Amount: big.NewInt(0).Sub(common.DfmToWei(new(big.Int).SetUint64(30)), minFeeForBridging),
+				Amount:      big.NewInt(0).Sub(common.DfmToWei(new(big.Int).SetUint64(30)), minFeeForBridging),
+				TokenAmount: big.NewInt(0),
 			},
 			{
 				Address: common.HexToAddress("0xfa"),
 				// 200 - 1 * minFeeForBridging due to refund tx
-				Amount: big.NewInt(0).Sub(common.DfmToWei(new(big.Int).SetUint64(200)), minFeeForBridging),
+				Amount:      big.NewInt(0).Sub(common.DfmToWei(new(big.Int).SetUint64(200)), minFeeForBridging),
+				TokenAmount: big.NewInt(0),
 			},
 			{
 				Address: common.HexToAddress("0xff"),
 				// 121 - 2 * minFeeForBridging due to refund txs
-				Amount: big.NewInt(0).Sub(common.DfmToWei(new(big.Int).SetUint64(121)), big.NewInt(0).Mul(minFeeForBridging, big.NewInt(2))),
+				Amount:      big.NewInt(0).Sub(common.DfmToWei(new(big.Int).SetUint64(121)), big.NewInt(0).Mul(minFeeForBridging, big.NewInt(2))),
+				TokenAmount: big.NewInt(0),
 			},
 		},
 	}, result)
@@ -314,6 +423,55 @@ func TestEthChain_IsSynchronized(t *testing.T) {
 	}
 }
 
+func TestEthChain_IsSynchronizedFinalized(t *testing.T) {
+	chainID := "nexus"
+	dbMock := eventTrackerStore.NewTestTrackerStore(t)
+	bridgeSmartContractMock := &eth.BridgeSmartContractMock{}
+	blockProvider := &evmBlockProviderMock{}
+	ctx := context.Background()
+	testErr := errors.New("test error 1")
+
+	bridgeSmartContractMock.On("GetLastObservedBlock", ctx, chainID).
+		Return(eth.CardanoBlock{BlockSlot: big.NewInt(15)}, nil)
+	blockProvider.On("GetBlockByNumber", ethgo.Finalized, false).Return(nil, testErr).Once()
+	blockProvider.On("GetBlockByNumber", ethgo.Finalized, false).Return(&ethgo.Block{Number: 14}, nil).Once()
+	blockProvider.On("GetBlockByNumber", ethgo.Finalized, false).Return(&ethgo.Block{Number: 16}, nil).Once()
+
+	cco := &EVMChainOperations{
+		config:        &cardanotx.BatcherEVMChainConfig{ConfirmationMode: eth.ConfirmationModeFinalized},
+		db:            dbMock,
+		blockProvider: blockProvider,
+		logger:        hclog.NewNullLogger(),
+	}
+
+	require.NoError(t, dbMock.InsertLastProcessedBlock(20))
+
+	_, err := cco.IsSynchronized(ctx, bridgeSmartContractMock, chainID)
+	require.ErrorIs(t, err, testErr)
+
+	// the oracle processed block 20 but the node finalized only block 14
+	val, err := cco.IsSynchronized(ctx, bridgeSmartContractMock, chainID)
+	require.NoError(t, err)
+	require.False(t, val)
+
+	val, err = cco.IsSynchronized(ctx, bridgeSmartContractMock, chainID)
+	require.NoError(t, err)
+	require.True(t, val)
+
+	blockProvider.AssertExpectations(t)
+}
+
+type evmBlockProviderMock struct {
+	mock.Mock
+}
+
+func (m *evmBlockProviderMock) GetBlockByNumber(i ethgo.BlockNumber, full bool) (*ethgo.Block, error) {
+	args := m.Called(i, full)
+	block, _ := args.Get(0).(*ethgo.Block)
+
+	return block, args.Error(1)
+}
+
 type eventTrackerStoreMock struct {
 	mock.Mock
 }
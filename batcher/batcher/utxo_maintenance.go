@@ -0,0 +1,224 @@
+package batcher
+
+import (
+	"bytes"
+	"cmp"
+	"slices"
+
+	cardano "github.com/Ethernal-Tech/apex-bridge/cardano"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+)
+
+// maxFanOutOutputsCount is the maximal number of outputs which refill utxo buckets in a single batch
+const maxFanOutOutputsCount = 10
+
+type utxoStats struct {
+	Count        int
+	Sum          uint64
+	BucketCounts []int
+}
+
+func getUtxoStats(
+	utxos []*indexer.TxInputOutput, config cardano.UtxoMaintenanceConfig,
+) (stats utxoStats) {
+	stats.Count = len(utxos)
+	stats.BucketCounts = make([]int, len(config.Buckets))
+
+	for _, utxo := range utxos {
+		stats.Sum += utxo.Output.Amount
+
+		if idx := config.GetBucketIndex(utxo.Output.Amount); idx >= 0 {
+			stats.BucketCounts[idx]++
+		}
+	}
+
+	return stats
+}
+
+// getConsolidationUtxos returns the smallest not chosen utxos which should be spent by the batch
+// because there are more multisig utxos than the consolidation threshold
+func getConsolidationUtxos(
+	allUtxos, chosenUtxos []*indexer.TxInputOutput, config cardano.UtxoMaintenanceConfig, maxUtxoCount int,
+) []*indexer.TxInputOutput {
+	if config.ConsolidationThreshold == 0 || len(allUtxos) <= int(config.ConsolidationThreshold) { //nolint:gosec
+		return nil
+	}
+
+	cnt := min(
+		len(allUtxos)-int(config.ConsolidationThreshold), //nolint:gosec
+		int(config.ConsolidationUtxoCount),               //nolint:gosec
+		maxUtxoCount)
+	if cnt <= 0 {
+		return nil
+	}
+
+	candidates := getNotChosenUtxos(allUtxos, chosenUtxos)
+
+	sortUtxosByAmount(candidates)
+
+	return candidates[:min(cnt, len(candidates))]
+}
+
+// getFeeUtxos returns the fee utxos which should be spent by the batch. While the fee address has more utxos
+// than the fee consolidation threshold, the largest utxo pays the fee and the smallest ones are consolidated
+func getFeeUtxos(
+	feeUtxos []*indexer.TxInputOutput, config cardano.UtxoMaintenanceConfig, maxUtxoCount int,
+) []*indexer.TxInputOutput {
+	if config.FeeConsolidationThreshold == 0 || len(feeUtxos) <= int(config.FeeConsolidationThreshold) { //nolint:gosec
+		return feeUtxos[:min(maxUtxoCount, len(feeUtxos))]
+	}
+
+	sortedUtxos := slices.Clone(feeUtxos)
+
+	sortUtxosByAmount(sortedUtxos)
+
+	largestIdx := len(sortedUtxos) - 1
+	result := []*indexer.TxInputOutput{sortedUtxos[largestIdx]}
+
+	return append(result, sortedUtxos[:min(maxUtxoCount-1, largestIdx)]...)
+}
+
+// getUtxoAmounts returns the lovelace amounts of the utxos
+func getUtxoAmounts(utxos []*indexer.TxInputOutput) []uint64 {
+	amounts := make([]uint64, len(utxos))
+	for i, utxo := range utxos {
+		amounts[i] = utxo.Output.Amount
+	}
+
+	return amounts
+}
+
+// sortUtxosByAmount sorts the utxos in ascending order of their amounts.
+// The order must be the same for all the batchers
+func sortUtxosByAmount(utxos []*indexer.TxInputOutput) {
+	slices.SortFunc(utxos, func(a, b *indexer.TxInputOutput) int {
+		if c := cmp.Compare(a.Output.Amount, b.Output.Amount); c != 0 {
+			return c
+		}
+
+		if c := bytes.Compare(a.Input.Hash[:], b.Input.Hash[:]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Input.Index, b.Input.Index)
+	})
+}
+
+// getFanOutOutputs splits the change of the batch into multisig outputs which refill the buckets
+// that hold less utxos than targeted. Larger buckets are refilled first.
+// CreateTx merges the rest of the change into one of these outputs
+func getFanOutOutputs(
+	multisigAddr string, remainingUtxos []*indexer.TxInputOutput,
+	config cardano.UtxoMaintenanceConfig, change uint64,
+) (outputs []cardanowallet.TxOutput) {
+	stats := getUtxoStats(remainingUtxos, config)
+
+	for i := len(config.Buckets) - 1; i >= 0; i-- {
+		bucket := config.Buckets[i]
+
+		for cnt := stats.BucketCounts[i]; cnt < int(bucket.TargetCount); cnt++ { //nolint:gosec
+			if len(outputs) == maxFanOutOutputsCount || change < bucket.MinAmount {
+				break
+			}
+
+			outputs = append(outputs, cardanowallet.NewTxOutput(multisigAddr, bucket.MinAmount))
+			change -= bucket.MinAmount
+		}
+	}
+
+	return outputs
+}
+
+func getNotChosenUtxos(allUtxos, chosenUtxos []*indexer.TxInputOutput) []*indexer.TxInputOutput {
+	chosen := make(map[indexer.TxInput]bool, len(chosenUtxos))
+	for _, utxo := range chosenUtxos {
+		chosen[utxo.Input] = true
+	}
+
+	result := make([]*indexer.TxInputOutput, 0, len(allUtxos))
+
+	for _, utxo := range allUtxos {
+		if !chosen[utxo.Input] {
+			result = append(result, utxo)
+		}
+	}
+
+	return result
+}
+
+// getMaintenanceTxData returns the multisig utxos which a maintenance batch of an idle chain spends and the multisig
+// outputs which it creates. Nothing is returned if such a batch would not improve the utxos, so every batcher
+// makes the same decision for the same utxos and the maintenance batches stop once the utxos are in shape
+func getMaintenanceTxData(
+	multisigAddr string, multisigUtxos []*indexer.TxInputOutput, feeUtxoCount int,
+	config cardano.UtxoMaintenanceConfig, maxUtxoCount int,
+) ([]*indexer.TxInputOutput, []cardanowallet.TxOutput) {
+	inputs := getConsolidationUtxos(multisigUtxos, nil, config, maxUtxoCount)
+	if len(inputs) < 2 {
+		inputs = nil
+	}
+
+	remainingUtxos := getNotChosenUtxos(multisigUtxos, inputs)
+	sortUtxosByAmount(remainingUtxos)
+
+	// the largest remaining utxo is split to refill the buckets if that lowers the number of missing utxos
+	if len(config.Buckets) > 0 && len(remainingUtxos) > 0 && len(inputs) < maxUtxoCount {
+		largestUtxo := remainingUtxos[len(remainingUtxos)-1]
+		fanOutInputs := append(slices.Clone(inputs), largestUtxo)
+		fanOutRemainingUtxos := remainingUtxos[:len(remainingUtxos)-1]
+		fanOutSum := getUtxosSum(fanOutInputs)
+
+		outputs := getFanOutOutputs(multisigAddr, fanOutRemainingUtxos, config, fanOutSum)
+		if len(outputs) > 0 {
+			// CreateTx merges the rest of the multisig amount into the first multisig output
+			outputs[0].Amount += fanOutSum - cardanowallet.GetOutputsSum(outputs)[cardanowallet.AdaTokenName]
+
+			if getMissingBucketUtxosCount(fanOutRemainingUtxos, outputs, config) <
+				getMissingBucketUtxosCount(multisigUtxos, nil, config) {
+				return fanOutInputs, outputs
+			}
+		}
+	}
+
+	// fee utxos are consolidated together with the smallest multisig utxo, because every batch spends the multisig
+	if len(inputs) == 0 && len(remainingUtxos) > 0 && config.FeeConsolidationThreshold > 0 &&
+		feeUtxoCount > int(config.FeeConsolidationThreshold) { //nolint:gosec
+		inputs = remainingUtxos[:1]
+	}
+
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	return inputs, []cardanowallet.TxOutput{
+		cardanowallet.NewTxOutput(multisigAddr, getUtxosSum(inputs)),
+	}
+}
+
+// getMissingBucketUtxosCount returns the number of utxos which the buckets lack to reach their target counts
+func getMissingBucketUtxosCount(
+	utxos []*indexer.TxInputOutput, outputs []cardanowallet.TxOutput, config cardano.UtxoMaintenanceConfig,
+) (missing int) {
+	stats := getUtxoStats(utxos, config)
+
+	for _, output := range outputs {
+		if idx := config.GetBucketIndex(output.Amount); idx >= 0 {
+			stats.BucketCounts[idx]++
+		}
+	}
+
+	for i, bucket := range config.Buckets {
+		missing += max(int(bucket.TargetCount)-stats.BucketCounts[i], 0) //nolint:gosec
+	}
+
+	return missing
+}
+
+func getUtxosSum(utxos []*indexer.TxInputOutput) (sum uint64) {
+	for _, utxo := range utxos {
+		sum += utxo.Output.Amount
+	}
+
+	return sum
+}
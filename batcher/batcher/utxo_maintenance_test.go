@@ -0,0 +1,158 @@
+package batcher
+
+import (
+	"testing"
+
+	cardano "github.com/Ethernal-Tech/apex-bridge/cardano"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtxoMaintenance(t *testing.T) {
+	const multisigAddr = "addr_test1wrz24vv4tvfqsywkxn36rv5zagys2d7euafcgt50gmpgqpq4ju9uv"
+
+	utxos := []*indexer.TxInputOutput{
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x01"), Index: 0},
+			Output: indexer.TxOutput{Amount: 1_000_000},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x01"), Index: 1},
+			Output: indexer.TxOutput{Amount: 50_000_000},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x02"), Index: 0},
+			Output: indexer.TxOutput{Amount: 3_000_000},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x03"), Index: 0},
+			Output: indexer.TxOutput{Amount: 1_500_000},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x03"), Index: 1},
+			Output: indexer.TxOutput{Amount: 1_000_000},
+		},
+	}
+
+	config := cardano.UtxoMaintenanceConfig{
+		ConsolidationThreshold: 3,
+		ConsolidationUtxoCount: 5,
+		Buckets: []cardano.UtxoBucket{
+			{MinAmount: 2_000_000, TargetCount: 3},
+			{MinAmount: 10_000_000, TargetCount: 2},
+		},
+	}
+
+	t.Run("getUtxoStats", func(t *testing.T) {
+		require.Equal(t, utxoStats{
+			Count:        5,
+			Sum:          56_500_000,
+			BucketCounts: []int{1, 1},
+		}, getUtxoStats(utxos, config))
+	})
+
+	t.Run("getConsolidationUtxos", func(t *testing.T) {
+		require.Nil(t, getConsolidationUtxos(utxos, utxos[1:2], cardano.UtxoMaintenanceConfig{}, 10))
+		require.Nil(t, getConsolidationUtxos(utxos[:3], utxos[1:2], config, 10))
+		require.Nil(t, getConsolidationUtxos(utxos, utxos[1:2], config, 0))
+
+		// only two utxos are above the threshold
+		require.Equal(t, []*indexer.TxInputOutput{utxos[0], utxos[4]},
+			getConsolidationUtxos(utxos, utxos[1:2], config, 10))
+
+		require.Equal(t, []*indexer.TxInputOutput{utxos[0]},
+			getConsolidationUtxos(utxos, utxos[1:2], config, 1))
+
+		require.Equal(t, []*indexer.TxInputOutput{utxos[4], utxos[3]},
+			getConsolidationUtxos(utxos, utxos[:2], config, 10))
+	})
+
+	t.Run("getFanOutOutputs", func(t *testing.T) {
+		require.Nil(t, getFanOutOutputs(multisigAddr, utxos, cardano.UtxoMaintenanceConfig{}, 100_000_000))
+		require.Nil(t, getFanOutOutputs(multisigAddr, utxos, config, 1_999_999))
+
+		require.Equal(t, []cardanowallet.TxOutput{
+			cardanowallet.NewTxOutput(multisigAddr, 10_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+		}, getFanOutOutputs(multisigAddr, utxos, config, 15_000_000))
+
+		// the change is not large enough for the largest bucket
+		require.Equal(t, []cardanowallet.TxOutput{
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+		}, getFanOutOutputs(multisigAddr, utxos, config, 9_000_000))
+
+		require.Len(t, getFanOutOutputs(multisigAddr, nil, cardano.UtxoMaintenanceConfig{
+			Buckets: []cardano.UtxoBucket{{MinAmount: 1_000_000, TargetCount: 100}},
+		}, 100_000_000), maxFanOutOutputsCount)
+	})
+
+	t.Run("getFeeUtxos", func(t *testing.T) {
+		feeConfig := cardano.UtxoMaintenanceConfig{FeeConsolidationThreshold: 3}
+
+		require.Equal(t, utxos[:2], getFeeUtxos(utxos, cardano.UtxoMaintenanceConfig{}, 2))
+		require.Equal(t, utxos[:3], getFeeUtxos(utxos[:3], feeConfig, 4))
+
+		// the largest utxo pays the fee and the smallest ones are consolidated
+		require.Equal(t, []*indexer.TxInputOutput{utxos[1], utxos[0], utxos[4]}, getFeeUtxos(utxos, feeConfig, 3))
+		require.Equal(t, []*indexer.TxInputOutput{utxos[1], utxos[0], utxos[4], utxos[3], utxos[2]},
+			getFeeUtxos(utxos, feeConfig, 10))
+	})
+
+	t.Run("getMaintenanceTxData", func(t *testing.T) {
+		inputs, outputs := getMaintenanceTxData(multisigAddr, utxos, 1, cardano.UtxoMaintenanceConfig{}, 10)
+		require.Nil(t, inputs)
+		require.Nil(t, outputs)
+
+		// the smallest utxos are consolidated and the largest one refills the buckets
+		inputs, outputs = getMaintenanceTxData(multisigAddr, utxos, 1, config, 10)
+		require.Equal(t, []*indexer.TxInputOutput{utxos[0], utxos[4], utxos[1]}, inputs)
+		require.Equal(t, []cardanowallet.TxOutput{
+			cardanowallet.NewTxOutput(multisigAddr, 38_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 10_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+		}, outputs)
+
+		inputs, outputs = getMaintenanceTxData(multisigAddr, utxos, 1, cardano.UtxoMaintenanceConfig{
+			ConsolidationThreshold: 3,
+			ConsolidationUtxoCount: 5,
+		}, 10)
+		require.Equal(t, []*indexer.TxInputOutput{utxos[0], utxos[4]}, inputs)
+		require.Equal(t, []cardanowallet.TxOutput{cardanowallet.NewTxOutput(multisigAddr, 2_000_000)}, outputs)
+
+		// a single utxo is not consolidated
+		inputs, outputs = getMaintenanceTxData(multisigAddr, utxos, 1, config, 1)
+		require.Equal(t, []*indexer.TxInputOutput{utxos[1]}, inputs)
+		require.Equal(t, []cardanowallet.TxOutput{
+			cardanowallet.NewTxOutput(multisigAddr, 36_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 10_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+			cardanowallet.NewTxOutput(multisigAddr, 2_000_000),
+		}, outputs)
+
+		// splitting the utxo would not lower the number of missing utxos
+		inputs, outputs = getMaintenanceTxData(multisigAddr, utxos[2:3], 1, cardano.UtxoMaintenanceConfig{
+			Buckets: []cardano.UtxoBucket{{MinAmount: 2_000_000, TargetCount: 1}},
+		}, 10)
+		require.Nil(t, inputs)
+		require.Nil(t, outputs)
+
+		// fee utxos are consolidated together with the smallest multisig utxo
+		feeConfig := cardano.UtxoMaintenanceConfig{FeeConsolidationThreshold: 3}
+
+		inputs, outputs = getMaintenanceTxData(multisigAddr, utxos, 3, feeConfig, 10)
+		require.Nil(t, inputs)
+		require.Nil(t, outputs)
+
+		inputs, outputs = getMaintenanceTxData(multisigAddr, utxos, 4, feeConfig, 10)
+		require.Equal(t, []*indexer.TxInputOutput{utxos[0]}, inputs)
+		require.Equal(t, []cardanowallet.TxOutput{cardanowallet.NewTxOutput(multisigAddr, 1_000_000)}, outputs)
+	})
+
+	t.Run("getUtxoAmounts", func(t *testing.T) {
+		require.Equal(t, []uint64{1_000_000, 50_000_000, 3_000_000, 1_500_000, 1_000_000}, getUtxoAmounts(utxos))
+	})
+}
@@ -0,0 +1,267 @@
+package batcher
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	cardano "github.com/Ethernal-Tech/apex-bridge/cardano"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+)
+
+// branchAndBoundMaxTries limits the number of visited nodes while searching for an exact match
+const branchAndBoundMaxTries = 100_000
+
+// UtxoSelector chooses the multisig utxos of a batch. Chosen utxos must either match the desired amount
+// or leave a change of at least minUtxoAmount. Every batcher must choose the same utxos,
+// so implementations must not depend on anything else than their inputs
+type UtxoSelector interface {
+	SelectUtxos(
+		inputUTXOs []*indexer.TxInputOutput,
+		desiredAmount uint64,
+		minUtxoAmount uint64,
+		maxUtxoCount int,
+		takeAtLeastUtxoCount int,
+	) ([]*indexer.TxInputOutput, error)
+}
+
+func NewUtxoSelector(strategy cardano.UtxoSelectionStrategy) (UtxoSelector, error) {
+	switch strategy {
+	case "", cardano.UtxoSelectionOldestFirst:
+		return &oldestFirstUtxoSelector{}, nil
+	case cardano.UtxoSelectionLargestFirst:
+		return &largestFirstUtxoSelector{}, nil
+	case cardano.UtxoSelectionRandomImprove:
+		return &randomImproveUtxoSelector{}, nil
+	case cardano.UtxoSelectionBranchAndBound:
+		return &branchAndBoundUtxoSelector{}, nil
+	default:
+		return nil, fmt.Errorf("unknown utxo selection strategy: %s", strategy)
+	}
+}
+
+type oldestFirstUtxoSelector struct{}
+
+func (s *oldestFirstUtxoSelector) SelectUtxos(
+	inputUTXOs []*indexer.TxInputOutput,
+	desiredAmount uint64,
+	minUtxoAmount uint64,
+	maxUtxoCount int,
+	takeAtLeastUtxoCount int,
+) ([]*indexer.TxInputOutput, error) {
+	return getNeededUtxos(inputUTXOs, desiredAmount, minUtxoAmount, maxUtxoCount, takeAtLeastUtxoCount)
+}
+
+// largestFirstUtxoSelector takes the largest utxos until desired amount has been met
+// and then adds the smallest utxos until takeAtLeastUtxoCount has been reached
+type largestFirstUtxoSelector struct{}
+
+func (s *largestFirstUtxoSelector) SelectUtxos(
+	inputUTXOs []*indexer.TxInputOutput,
+	desiredAmount uint64,
+	minUtxoAmount uint64,
+	maxUtxoCount int,
+	takeAtLeastUtxoCount int,
+) ([]*indexer.TxInputOutput, error) {
+	sortedUTXOs := sortUtxosByAmountDesc(inputUTXOs)
+	chosenUTXOsSum := uint64(0)
+
+	for i, utxo := range sortedUTXOs[:min(maxUtxoCount, len(sortedUTXOs))] {
+		chosenUTXOsSum += utxo.Output.Amount
+
+		if isUtxosSumOk(chosenUTXOsSum, desiredAmount, minUtxoAmount) {
+			chosenUTXOs := slices.Clone(sortedUTXOs[:i+1])
+			restUTXOs := sortedUTXOs[i+1:]
+			cnt := min(
+				len(restUTXOs),                        // still available in inputUTXOs
+				takeAtLeastUtxoCount-len(chosenUTXOs), // needed to fill takeAtLeastUtxoCount
+				maxUtxoCount-len(chosenUTXOs),         // maxUtxoCount limit must be preserved
+			)
+			if cnt > 0 {
+				chosenUTXOs = append(chosenUTXOs, restUTXOs[len(restUTXOs)-cnt:]...)
+			}
+
+			return chosenUTXOs, nil
+		}
+	}
+
+	return nil, getUtxosSelectionError(inputUTXOs, desiredAmount, minUtxoAmount)
+}
+
+// randomImproveUtxoSelector implements the random-improve algorithm from CIP-2.
+// Utxos are taken in random order until desired amount has been met and then more random utxos are added
+// while they move the sum closer to the double of desired amount. The random order is seeded by the inputs
+type randomImproveUtxoSelector struct{}
+
+func (s *randomImproveUtxoSelector) SelectUtxos(
+	inputUTXOs []*indexer.TxInputOutput,
+	desiredAmount uint64,
+	minUtxoAmount uint64,
+	maxUtxoCount int,
+	takeAtLeastUtxoCount int,
+) ([]*indexer.TxInputOutput, error) {
+	shuffledUTXOs := slices.Clone(inputUTXOs)
+	rnd := newUtxoSelectionRand(inputUTXOs, desiredAmount)
+
+	rnd.Shuffle(len(shuffledUTXOs), func(i, j int) {
+		shuffledUTXOs[i], shuffledUTXOs[j] = shuffledUTXOs[j], shuffledUTXOs[i]
+	})
+
+	chosenCnt := 0
+	chosenUTXOsSum := uint64(0)
+
+	for chosenCnt == 0 || !isUtxosSumOk(chosenUTXOsSum, desiredAmount, minUtxoAmount) {
+		if chosenCnt >= min(maxUtxoCount, len(shuffledUTXOs)) {
+			// random selection failed, as suggested by CIP-2 fallback to largest first
+			return (&largestFirstUtxoSelector{}).SelectUtxos(
+				inputUTXOs, desiredAmount, minUtxoAmount, maxUtxoCount, takeAtLeastUtxoCount)
+		}
+
+		chosenUTXOsSum += shuffledUTXOs[chosenCnt].Output.Amount
+		chosenCnt++
+	}
+
+	idealSum := max(2*desiredAmount, desiredAmount+minUtxoAmount)
+	maxSum := max(3*desiredAmount, idealSum)
+
+	for ; chosenCnt < min(maxUtxoCount, len(shuffledUTXOs)); chosenCnt++ {
+		newSum := chosenUTXOsSum + shuffledUTXOs[chosenCnt].Output.Amount
+		if newSum > maxSum || !isUtxosSumOk(newSum, desiredAmount, minUtxoAmount) ||
+			absDiff(newSum, idealSum) >= absDiff(chosenUTXOsSum, idealSum) {
+			break
+		}
+
+		chosenUTXOsSum = newSum
+	}
+
+	// try to add utxos until we reach takeAtLeastUtxoCount
+	chosenCnt = max(chosenCnt, min(takeAtLeastUtxoCount, maxUtxoCount, len(shuffledUTXOs)))
+
+	return shuffledUTXOs[:chosenCnt], nil
+}
+
+// branchAndBoundUtxoSelector searches for utxos whose sum matches desired amount exactly,
+// so the batch does not need a change output. If there is no such match it falls back to largest first
+type branchAndBoundUtxoSelector struct{}
+
+func (s *branchAndBoundUtxoSelector) SelectUtxos(
+	inputUTXOs []*indexer.TxInputOutput,
+	desiredAmount uint64,
+	minUtxoAmount uint64,
+	maxUtxoCount int,
+	takeAtLeastUtxoCount int,
+) ([]*indexer.TxInputOutput, error) {
+	sortedUTXOs := sortUtxosByAmountDesc(inputUTXOs)
+	// remainingSums[i] is the sum of all the utxos from i-th to the last one
+	remainingSums := make([]uint64, len(sortedUTXOs)+1)
+
+	for i := len(sortedUTXOs) - 1; i >= 0; i-- {
+		remainingSums[i] = remainingSums[i+1] + sortedUTXOs[i].Output.Amount
+	}
+
+	var (
+		tries       = 0
+		chosenUTXOs []*indexer.TxInputOutput
+		search      func(idx int, sum uint64) bool
+	)
+
+	search = func(idx int, sum uint64) bool {
+		tries++
+
+		switch {
+		case sum == desiredAmount && len(chosenUTXOs) > 0:
+			return true
+		case tries > branchAndBoundMaxTries, idx == len(sortedUTXOs), len(chosenUTXOs) >= maxUtxoCount,
+			sum > desiredAmount, sum+remainingSums[idx] < desiredAmount:
+			return false
+		}
+
+		chosenUTXOs = append(chosenUTXOs, sortedUTXOs[idx])
+
+		if search(idx+1, sum+sortedUTXOs[idx].Output.Amount) {
+			return true
+		}
+
+		chosenUTXOs = chosenUTXOs[:len(chosenUTXOs)-1]
+
+		// utxos with the same amount as the excluded one would lead to the same results
+		nextIdx := idx + 1
+		for nextIdx < len(sortedUTXOs) && sortedUTXOs[nextIdx].Output.Amount == sortedUTXOs[idx].Output.Amount {
+			nextIdx++
+		}
+
+		return search(nextIdx, sum)
+	}
+
+	if search(0, 0) {
+		return chosenUTXOs, nil
+	}
+
+	return (&largestFirstUtxoSelector{}).SelectUtxos(
+		inputUTXOs, desiredAmount, minUtxoAmount, maxUtxoCount, takeAtLeastUtxoCount)
+}
+
+// isUtxosSumOk returns true if the sum matches desired amount or if the change is not less than minUtxoAmount
+func isUtxosSumOk(sum uint64, desiredAmount uint64, minUtxoAmount uint64) bool {
+	return sum == desiredAmount || sum >= desiredAmount+minUtxoAmount
+}
+
+func getUtxosSelectionError(
+	inputUTXOs []*indexer.TxInputOutput, desiredAmount uint64, minUtxoAmount uint64,
+) error {
+	totalUTXOsSum := uint64(0)
+	for _, utxo := range inputUTXOs {
+		totalUTXOsSum += utxo.Output.Amount
+	}
+
+	if len(inputUTXOs) > 0 && isUtxosSumOk(totalUTXOsSum, desiredAmount, minUtxoAmount) {
+		return fmt.Errorf("%w: %d vs %d", errUTXOsLimitReached, totalUTXOsSum, desiredAmount+minUtxoAmount)
+	}
+
+	return fmt.Errorf("%w: %d vs %d", errUTXOsCouldNotSelect, totalUTXOsSum, desiredAmount+minUtxoAmount)
+}
+
+// sortUtxosByAmountDesc returns utxos sorted by their amounts. Utxos with the same amount are sorted by their inputs
+func sortUtxosByAmountDesc(utxos []*indexer.TxInputOutput) []*indexer.TxInputOutput {
+	sortedUTXOs := slices.Clone(utxos)
+
+	slices.SortFunc(sortedUTXOs, func(a, b *indexer.TxInputOutput) int {
+		if c := cmp.Compare(b.Output.Amount, a.Output.Amount); c != 0 {
+			return c
+		}
+
+		if c := bytes.Compare(a.Input.Hash[:], b.Input.Hash[:]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Input.Index, b.Input.Index)
+	})
+
+	return sortedUTXOs
+}
+
+// newUtxoSelectionRand returns a pseudo random generator seeded by the inputs, so all batchers get the same numbers
+func newUtxoSelectionRand(utxos []*indexer.TxInputOutput, desiredAmount uint64) *rand.Rand {
+	hasher := sha256.New()
+
+	for _, utxo := range utxos {
+		hasher.Write(utxo.Input.Hash[:])
+		hasher.Write(binary.BigEndian.AppendUint32(nil, utxo.Input.Index))
+	}
+
+	hasher.Write(binary.BigEndian.AppendUint64(nil, desiredAmount))
+
+	return rand.New(rand.NewChaCha8([32]byte(hasher.Sum(nil))))
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
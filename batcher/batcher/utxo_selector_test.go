@@ -0,0 +1,126 @@
+package batcher
+
+import (
+	"fmt"
+	"testing"
+
+	cardano "github.com/Ethernal-Tech/apex-bridge/cardano"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtxoSelectors(t *testing.T) {
+	inputs := []*indexer.TxInputOutput{
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x1"), Index: 0},
+			Output: indexer.TxOutput{Amount: 10},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x1"), Index: 1},
+			Output: indexer.TxOutput{Amount: 50},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x2"), Index: 0},
+			Output: indexer.TxOutput{Amount: 20},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x3"), Index: 0},
+			Output: indexer.TxOutput{Amount: 40},
+		},
+		{
+			Input:  indexer.TxInput{Hash: indexer.NewHashFromHexString("0x3"), Index: 1},
+			Output: indexer.TxOutput{Amount: 30},
+		},
+	}
+
+	getSum := func(utxos []*indexer.TxInputOutput) (sum uint64) {
+		for _, utxo := range utxos {
+			sum += utxo.Output.Amount
+		}
+
+		return sum
+	}
+
+	t.Run("NewUtxoSelector", func(t *testing.T) {
+		_, err := NewUtxoSelector("unknown")
+		require.ErrorContains(t, err, "unknown utxo selection strategy")
+
+		for _, strategy := range []cardano.UtxoSelectionStrategy{
+			"", cardano.UtxoSelectionOldestFirst, cardano.UtxoSelectionLargestFirst,
+			cardano.UtxoSelectionRandomImprove, cardano.UtxoSelectionBranchAndBound,
+		} {
+			selector, err := NewUtxoSelector(strategy)
+			require.NoError(t, err)
+			require.NotNil(t, selector)
+			require.NoError(t, strategy.Validate())
+		}
+	})
+
+	t.Run("largest first", func(t *testing.T) {
+		selector := &largestFirstUtxoSelector{}
+
+		result, err := selector.SelectUtxos(inputs, 65, 5, 25, 1)
+		require.NoError(t, err)
+		require.Equal(t, []*indexer.TxInputOutput{inputs[1], inputs[3]}, result)
+
+		result, err = selector.SelectUtxos(inputs, 65, 5, 25, 3)
+		require.NoError(t, err)
+		require.Equal(t, []*indexer.TxInputOutput{inputs[1], inputs[3], inputs[0]}, result)
+
+		result, err = selector.SelectUtxos(inputs, 90, 5, 25, 1)
+		require.NoError(t, err)
+		require.Equal(t, []*indexer.TxInputOutput{inputs[1], inputs[3]}, result)
+
+		_, err = selector.SelectUtxos(inputs, 65, 5, 1, 1)
+		require.ErrorIs(t, err, errUTXOsLimitReached)
+
+		_, err = selector.SelectUtxos(inputs, 160, 5, 25, 1)
+		require.ErrorIs(t, err, errUTXOsCouldNotSelect)
+	})
+
+	t.Run("random improve", func(t *testing.T) {
+		selector := &randomImproveUtxoSelector{}
+
+		for desiredAmount := uint64(1); desiredAmount <= 140; desiredAmount++ {
+			result, err := selector.SelectUtxos(inputs, desiredAmount, 5, 25, 1)
+			require.NoError(t, err, fmt.Sprintf("desired amount %d", desiredAmount))
+			require.True(t, isUtxosSumOk(getSum(result), desiredAmount, 5))
+
+			sameResult, err := selector.SelectUtxos(inputs, desiredAmount, 5, 25, 1)
+			require.NoError(t, err)
+			require.Equal(t, result, sameResult)
+		}
+
+		result, err := selector.SelectUtxos(inputs, 10, 5, 25, 4)
+		require.NoError(t, err)
+		require.Len(t, result, 4)
+
+		// only the two largest utxos reach desired amount
+		result, err = selector.SelectUtxos(inputs, 85, 5, 2, 1)
+		require.NoError(t, err)
+		require.Equal(t, []*indexer.TxInputOutput{inputs[1], inputs[3]}, result)
+
+		_, err = selector.SelectUtxos(inputs, 160, 5, 25, 1)
+		require.ErrorIs(t, err, errUTXOsCouldNotSelect)
+	})
+
+	t.Run("branch and bound", func(t *testing.T) {
+		selector := &branchAndBoundUtxoSelector{}
+
+		result, err := selector.SelectUtxos(inputs, 60, 5, 25, 1)
+		require.NoError(t, err)
+		require.Equal(t, []*indexer.TxInputOutput{inputs[1], inputs[0]}, result)
+
+		result, err = selector.SelectUtxos(inputs, 130, 5, 25, 1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(130), getSum(result))
+
+		// there is no exact match, so largest first is used
+		result, err = selector.SelectUtxos(inputs, 65, 5, 25, 1)
+		require.NoError(t, err)
+		require.Equal(t, []*indexer.TxInputOutput{inputs[1], inputs[3]}, result)
+
+		_, err = selector.SelectUtxos(inputs, 150, 5, 2, 1)
+		require.ErrorIs(t, err, errUTXOsLimitReached)
+	})
+}
@@ -11,6 +11,8 @@ type GeneratedBatchTxData struct {
 	BatchType uint8
 	TxRaw     []byte
 	TxHash    string
+	// IncludedTxs is set when the batch includes only a part of the confirmed transactions
+	IncludedTxs []eth.ConfirmedTransaction
 }
 
 type BatcherManager interface {
@@ -27,6 +29,11 @@ type ChainOperations interface {
 		ctx context.Context, bridgeSmartContract eth.IBridgeSmartContract,
 		destinationChain string, confirmedTransactions []eth.ConfirmedTransaction, batchNonceID uint64,
 	) (*GeneratedBatchTxData, error)
+	// GenerateMaintenanceTransaction returns nil if the utxos of the chain do not need maintenance
+	GenerateMaintenanceTransaction(
+		ctx context.Context, bridgeSmartContract eth.IBridgeSmartContract,
+		destinationChain string, batchNonceID uint64,
+	) (*GeneratedBatchTxData, error)
 	SignBatchTransaction(generatedBatchData *GeneratedBatchTxData) ([]byte, []byte, error)
 	IsSynchronized(
 		ctx context.Context, bridgeSmartContract eth.IBridgeSmartContract, chainID string,
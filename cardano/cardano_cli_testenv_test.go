@@ -0,0 +1,8 @@
+//go:build testenv
+// +build testenv
+
+package cardanotx
+
+// cardanoCliRequired fails the tests which need cardano-cli instead of skipping them,
+// because the test environment of the CI has cardano-cli
+const cardanoCliRequired = true
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/eth"
 	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
 )
 
@@ -16,7 +17,6 @@ type CardanoChainConfig struct {
 	BlockfrostURL         string                           `json:"blockfrostUrl,omitempty"`
 	BlockfrostAPIKey      string                           `json:"blockfrostApiKey,omitempty"`
 	SocketPath            string                           `json:"socketPath,omitempty"`
-	PotentialFee          uint64                           `json:"potentialFee"`
 	TTLSlotNumberInc      uint64                           `json:"ttlSlotNumberIncrement"`
 	SlotRoundingThreshold uint64                           `json:"slotRoundingThreshold"`
 	NoBatchPeriodPercent  float64                          `json:"noBatchPeriodPercent"`
@@ -25,6 +25,11 @@ type CardanoChainConfig struct {
 	MaxUtxoCount          uint                             `json:"maxUtxoCount"`
 	MinFeeForBridging     uint64                           `json:"minFeeForBridging"`
 	TakeAtLeastUtxoCount  uint                             `json:"takeAtLeastUtxoCount"`
+	// NativeTokens are the full names of the native tokens which can be bridged from and to this chain
+	NativeTokens          []string              `json:"nativeTokens,omitempty"`
+	UtxoSelectionStrategy UtxoSelectionStrategy `json:"utxoSelectionStrategy,omitempty"`
+	UtxoMaintenance       UtxoMaintenanceConfig `json:"utxoMaintenance"`
+	TxBuilderBackend      TxBuilderBackend      `json:"txBuilderBackend,omitempty"`
 }
 
 // GetChainType implements ChainSpecificConfig.
@@ -73,6 +78,12 @@ type BatcherEVMChainConfig struct {
 	NoBatchPeriodPercent   float64 `json:"noBatchPeriodPercent"`
 	TestMode               uint8   `json:"testMode,omitempty"` // only functional in test mode (`-tags testenv`)
 	MinFeeForBridging      uint64  `json:"minFeeForBridging"`
+
+	// the node is queried for the tagged block only with the safe and finalized confirmation modes
+	NodeURL          string               `json:"nodeUrl,omitempty"`
+	ConfirmationMode eth.ConfirmationMode `json:"confirmationMode,omitempty"`
+	// batches for a gateway deployed before the native tokens release are packed in its legacy layout
+	LegacyContractsABI bool `json:"legacyContractsAbi,omitempty"`
 }
 
 func NewBatcherEVMChainConfig(rawMessage json.RawMessage) (*BatcherEVMChainConfig, error) {
@@ -103,6 +114,8 @@ type RelayerEVMChainConfig struct {
 	GasFeeCap        uint64 `json:"gasFeeCap"`
 	GasTipCap        uint64 `json:"gasTipCap"`
 	GasFeeMultiplier uint64 `json:"gasFeeMultiplier"`
+	// the gateway deployed before the native tokens release is bound with the legacy ABI
+	LegacyContractsABI bool `json:"legacyContractsAbi,omitempty"`
 }
 
 func NewRelayerEVMChainConfig(rawMessage json.RawMessage) (*RelayerEVMChainConfig, error) {
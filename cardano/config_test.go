@@ -20,8 +20,7 @@ func TestConfig(t *testing.T) {
 			[]byte(`{
 				"testnetMagic": 2,
 				"blockfrostUrl": "pera",
-				"blockfrostApiKey": "zdera",
-				"potentialFee": 300000
+				"blockfrostApiKey": "zdera"
 				}`),
 		))
 		require.NoError(t, err)
@@ -30,6 +29,40 @@ func TestConfig(t *testing.T) {
 		require.Equal(t, uint32(2), config.NetworkMagic)
 		require.Equal(t, "pera", config.BlockfrostURL)
 		require.Equal(t, "zdera", config.BlockfrostAPIKey)
-		require.Equal(t, uint64(300000), config.PotentialFee)
+	})
+	t.Run("UtxoMaintenanceConfig", func(t *testing.T) {
+		require.NoError(t, UtxoMaintenanceConfig{}.Validate(1_000_000, 4))
+
+		require.ErrorContains(t, UtxoMaintenanceConfig{
+			ConsolidationThreshold: 10,
+		}.Validate(1_000_000, 4), "consolidation utxo count")
+
+		require.ErrorContains(t, UtxoMaintenanceConfig{
+			Buckets: []UtxoBucket{{MinAmount: 999_999, TargetCount: 1}},
+		}.Validate(1_000_000, 4), "less than minimal utxo amount")
+
+		require.ErrorContains(t, UtxoMaintenanceConfig{
+			Buckets: []UtxoBucket{{MinAmount: 1_000_000}},
+		}.Validate(1_000_000, 4), "target count")
+
+		require.ErrorContains(t, UtxoMaintenanceConfig{
+			Buckets: []UtxoBucket{{MinAmount: 5_000_000, TargetCount: 1}, {MinAmount: 2_000_000, TargetCount: 1}},
+		}.Validate(1_000_000, 4), "sorted")
+
+		require.ErrorContains(t, UtxoMaintenanceConfig{
+			FeeConsolidationThreshold: 5,
+		}.Validate(1_000_000, 1), "at least two fee utxos")
+
+		config := UtxoMaintenanceConfig{
+			ConsolidationThreshold: 10,
+			ConsolidationUtxoCount: 2,
+			Buckets:                []UtxoBucket{{MinAmount: 2_000_000, TargetCount: 1}, {MinAmount: 5_000_000, TargetCount: 3}},
+		}
+
+		require.NoError(t, config.Validate(1_000_000, 4))
+		require.Equal(t, -1, config.GetBucketIndex(1_999_999))
+		require.Equal(t, 0, config.GetBucketIndex(2_000_000))
+		require.Equal(t, 0, config.GetBucketIndex(4_999_999))
+		require.Equal(t, 1, config.GetBucketIndex(50_000_000))
 	})
 }
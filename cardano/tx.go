@@ -3,11 +3,12 @@ package cardanotx
 import (
 	"errors"
 	"fmt"
+	"maps"
 
 	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
 )
 
-// CreateTx creates tx and returns cbor of raw transaction data, tx hash and error
+// CreateTx creates tx with cardano-cli and returns cbor of raw transaction data, tx hash and error
 func CreateTx(
 	cardanoCliBinary string,
 	testNetMagic uint,
@@ -16,12 +17,39 @@ func CreateTx(
 	metadataBytes []byte,
 	txInputInfos TxInputInfos,
 	outputs []cardanowallet.TxOutput,
+) ([]byte, string, error) {
+	return CreateTxWithBackend(
+		TxBuilderBackendCli, cardanoCliBinary, testNetMagic, protocolParams,
+		timeToLive, metadataBytes, txInputInfos, outputs)
+}
+
+// CreateTxWithBackend creates tx with the tx builder backend and returns cbor of raw transaction data,
+// tx hash and error. Outputs below their minimal utxo amounts are raised to them at the expense of the multisig
+func CreateTxWithBackend(
+	txBuilderBackend TxBuilderBackend,
+	cardanoCliBinary string,
+	testNetMagic uint,
+	protocolParams []byte,
+	timeToLive uint64,
+	metadataBytes []byte,
+	txInputInfos TxInputInfos,
+	outputs []cardanowallet.TxOutput,
 ) ([]byte, string, error) {
 	// ensure there is at least one input for both the multisig and fee multisig.
 	if ln, feeLn := len(txInputInfos.MultiSig.Inputs), len(txInputInfos.MultiSigFee.Inputs); ln == 0 || feeLn == 0 {
 		return nil, "", fmt.Errorf("no inputs found for multisig (%d) or fee multisig (%d)", ln, feeLn)
 	}
 
+	txLimits, err := NewTxLimits(protocolParams)
+	if err != nil {
+		return nil, "", err
+	}
+
+	outputs, err = txLimits.RaiseToMinUtxo(outputs)
+	if err != nil {
+		return nil, "", err
+	}
+
 	outputsAmount := cardanowallet.GetOutputsSum(outputs)
 	lovelaceOutputsAmount := outputsAmount[cardanowallet.AdaTokenName]
 	multiSigIndex, multisigAmount := isAddressInOutputs(outputs, txInputInfos.MultiSig.Address)
@@ -35,7 +63,12 @@ func CreateTx(
 
 	changeAmount := multisigAmount - lovelaceOutputsAmount
 
-	builder, err := cardanowallet.NewTxBuilder(cardanoCliBinary)
+	changeTokens, err := getChangeTokens(txInputInfos.MultiSig, outputs, outputsAmount, multiSigIndex)
+	if err != nil {
+		return nil, "", err
+	}
+
+	builder, err := NewTxBuilder(txBuilderBackend, cardanoCliBinary)
 	if err != nil {
 		return nil, "", err
 	}
@@ -56,14 +89,19 @@ func CreateTx(
 
 	// add multisig output if change is not zero
 	if changeAmount > 0 {
+		changeOutput := cardanowallet.TxOutput{
+			Addr:   txInputInfos.MultiSig.Address,
+			Amount: changeAmount,
+			Tokens: changeTokens,
+		}
+
 		if multiSigIndex == -1 {
-			builder.AddOutputs(cardanowallet.TxOutput{
-				Addr:   txInputInfos.MultiSig.Address,
-				Amount: changeAmount,
-			})
+			builder.AddOutputs(changeOutput)
 		} else {
-			builder.UpdateOutputAmount(multiSigIndex, changeAmount)
+			builder.ReplaceOutput(multiSigIndex, changeOutput)
 		}
+	} else if len(changeTokens) > 0 {
+		return nil, "", fmt.Errorf("not enough funds on multisig for the change of %d tokens", len(changeTokens))
 	} else if multiSigIndex >= 0 {
 		// we need to decrement feeIndex if it was after multisig in outputs
 		if feeIndex > multiSigIndex {
@@ -76,27 +114,55 @@ func CreateTx(
 	builder.AddInputsWithScript(txInputInfos.MultiSig.PolicyScript, txInputInfos.MultiSig.Inputs...).
 		AddInputsWithScript(txInputInfos.MultiSigFee.PolicyScript, txInputInfos.MultiSigFee.Inputs...)
 
-	calcFee, err := builder.CalculateFee(0)
-	if err != nil {
+	witnessCount := txInputInfos.MultiSig.PolicyScript.GetCount() + txInputInfos.MultiSigFee.PolicyScript.GetCount()
+
+	if err := setMinFee(builder, txLimits, feeIndex, feeAmount, witnessCount); err != nil {
 		return nil, "", err
 	}
 
-	builder.SetFee(calcFee)
+	return buildAndCheckTx(builder, txLimits, witnessCount)
+}
+
+// getChangeTokens returns the native tokens of the multisig inputs which are not spent by the outputs
+func getChangeTokens(
+	multisig *TxInputInfo, outputs []cardanowallet.TxOutput, outputsSum map[string]uint64, multiSigIndex int,
+) ([]cardanowallet.TokenAmount, error) {
+	changeSum := map[string]uint64{}
 
-	if feeAmount < calcFee {
-		return nil, "", fmt.Errorf("not enough funds on fee multisig: %d vs %vs", feeAmount, calcFee)
+	for tokenName, amount := range multisig.Sum {
+		if tokenName != cardanowallet.AdaTokenName {
+			changeSum[tokenName] += amount
+		}
 	}
 
-	feeAmountFinal := feeAmount - calcFee
+	// tokens sent to the multisig address are part of the change
+	if multiSigIndex >= 0 {
+		for _, token := range outputs[multiSigIndex].Tokens {
+			changeSum[token.TokenName()] += token.Amount
+		}
+	}
+
+	for tokenName, amount := range outputsSum {
+		if tokenName == cardanowallet.AdaTokenName {
+			continue
+		}
+
+		if changeSum[tokenName] < amount {
+			return nil, fmt.Errorf("not enough %s tokens on multisig: %d vs %d", tokenName, changeSum[tokenName], amount)
+		}
 
-	// update multisigFee amount if needed (feeAmountFinal > 0) or remove it from output
-	if feeAmountFinal > 0 {
-		builder.UpdateOutputAmount(feeIndex, feeAmountFinal)
-	} else {
-		builder.RemoveOutput(feeIndex)
+		changeSum[tokenName] -= amount
 	}
 
-	return builder.Build()
+	maps.DeleteFunc(changeSum, func(_ string, amount uint64) bool {
+		return amount == 0
+	})
+
+	if len(changeSum) == 0 {
+		return nil, nil
+	}
+
+	return cardanowallet.GetTokensFromSumMap(changeSum)
 }
 
 func isAddressInOutputs(outputs []cardanowallet.TxOutput, addr string) (int, uint64) {
@@ -117,6 +183,22 @@ func CreateOnlyFeeTx(
 	metadataBytes []byte,
 	feeTxInput *TxInputInfo,
 	output cardanowallet.TxOutput,
+) ([]byte, string, error) {
+	return CreateOnlyFeeTxWithBackend(
+		TxBuilderBackendCli, cardanoCliBinary, testNetMagic, protocolParams,
+		timeToLive, metadataBytes, feeTxInput, output)
+}
+
+// CreateOnlyFeeTxWithBackend creates the tx which spends only the fee multisig utxos with the tx builder backend
+func CreateOnlyFeeTxWithBackend(
+	txBuilderBackend TxBuilderBackend,
+	cardanoCliBinary string,
+	testNetMagic uint,
+	protocolParams []byte,
+	timeToLive uint64,
+	metadataBytes []byte,
+	feeTxInput *TxInputInfo,
+	output cardanowallet.TxOutput,
 ) ([]byte, string, error) {
 	// ensure there is at least 1 fee input
 	if feeTxInput == nil || len(feeTxInput.Inputs) == 0 {
@@ -125,7 +207,12 @@ func CreateOnlyFeeTx(
 
 	feeAmount := feeTxInput.Sum[cardanowallet.AdaTokenName]
 
-	builder, err := cardanowallet.NewTxBuilder(cardanoCliBinary)
+	txLimits, err := NewTxLimits(protocolParams)
+	if err != nil {
+		return nil, "", err
+	}
+
+	builder, err := NewTxBuilder(txBuilderBackend, cardanoCliBinary)
 	if err != nil {
 		return nil, "", err
 	}
@@ -138,16 +225,68 @@ func CreateOnlyFeeTx(
 	builder.AddInputsWithScript(feeTxInput.PolicyScript, feeTxInput.Inputs...)
 
 	// expecting fee and payment when signing, but only fee is provided, so we multiply by 2
-	calcFee, err := builder.CalculateFee(feeTxInput.PolicyScript.GetCount() * 2)
-	if err != nil {
+	witnessCount := feeTxInput.PolicyScript.GetCount() * 2
+
+	if err := setMinFee(builder, txLimits, 0, feeAmount, witnessCount); err != nil {
 		return nil, "", err
 	}
 
-	builder.SetFee(calcFee)
+	return buildAndCheckTx(builder, txLimits, witnessCount)
+}
+
+// setMinFee sets the exact minimal fee of the tx signed by witnessCount vkey witnesses and pays it from
+// the fee multisig output, which holds feeAmount before the fee is paid. The output is removed if nothing is left.
+// The fee changes the size of the tx, so the tx is built again until its fee covers its own size
+func setMinFee(builder TxBuilder, txLimits *TxLimits, feeIndex int, feeAmount uint64, witnessCount int) error {
+	fee := txLimits.MinFeeB
 
-	feeAmountFinal := feeAmount - calcFee
+	for {
+		if feeAmount < fee {
+			return fmt.Errorf("not enough funds on fee multisig: %d vs %d", feeAmount, fee)
+		}
 
-	builder.UpdateOutputAmount(0, feeAmountFinal)
+		builder.SetFee(fee)
+
+		if feeAmount == fee {
+			builder.RemoveOutput(feeIndex)
+		} else {
+			builder.UpdateOutputAmount(feeIndex, feeAmount-fee)
+		}
+
+		txRaw, _, err := builder.Build()
+		if err != nil {
+			return err
+		}
+
+		minFee, err := txLimits.GetTxMinFee(txRaw, witnessCount)
+		if err != nil {
+			return err
+		}
+
+		if minFee <= fee {
+			return nil
+		}
+
+		if feeAmount == fee {
+			return fmt.Errorf("not enough funds on fee multisig: %d vs %d", feeAmount, minFee)
+		}
+
+		fee = minFee
+	}
+}
+
+// buildAndCheckTx builds the tx and rejects it if it breaks the ledger rules of the protocol parameters
+func buildAndCheckTx(
+	builder TxBuilder, txLimits *TxLimits, witnessCount int,
+) ([]byte, string, error) {
+	txRaw, txHash, err := builder.Build()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := txLimits.CheckTx(txRaw, witnessCount); err != nil {
+		return nil, "", fmt.Errorf("tx %s breaks ledger rules: %w", txHash, err)
+	}
 
-	return builder.Build()
+	return txRaw, txHash, nil
 }
@@ -0,0 +1,158 @@
+package cardanotx
+
+import (
+	"fmt"
+
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+)
+
+// TxBuilderBackend defines how the batch txs are built, witnessed and assembled
+type TxBuilderBackend string
+
+const (
+	// TxBuilderBackendCli shells out to the cardano-cli binary
+	TxBuilderBackendCli TxBuilderBackend = "cli"
+	// TxBuilderBackendNative serializes the txs in go without any external binary
+	TxBuilderBackendNative TxBuilderBackend = "native"
+)
+
+// Validate returns an error for an unknown backend. An empty backend is the cli backend
+func (b TxBuilderBackend) Validate() error {
+	switch b {
+	case "", TxBuilderBackendCli, TxBuilderBackendNative:
+		return nil
+	default:
+		return fmt.Errorf("unknown tx builder backend: %s", b)
+	}
+}
+
+// GetBatchMetadataValue returns the backend which the batch metadata commits to. The batches of different backends
+// have different hashes, so the signatures of the validators with different backends are never combined into a batch.
+// The cli backend returns an empty value, which keeps the metadata of its batches unchanged
+func (b TxBuilderBackend) GetBatchMetadataValue() string {
+	if b == TxBuilderBackendNative {
+		return string(b)
+	}
+
+	return ""
+}
+
+// TxBuilder builds the txs which spend multisig utxos, creates and assembles their witnesses
+type TxBuilder interface {
+	SetProtocolParameters(protocolParameters []byte) TxBuilder
+	SetTimeToLive(timeToLive uint64) TxBuilder
+	SetMetaData(metadata []byte) TxBuilder
+	SetTestNetMagic(testNetMagic uint) TxBuilder
+	SetFee(fee uint64) TxBuilder
+	AddInputsWithScript(script *cardanowallet.PolicyScript, inputs ...cardanowallet.TxInput) TxBuilder
+	AddOutputs(outputs ...cardanowallet.TxOutput) TxBuilder
+	ReplaceOutput(index int, output cardanowallet.TxOutput) TxBuilder
+	UpdateOutputAmount(index int, amount uint64) TxBuilder
+	RemoveOutput(index int) TxBuilder
+	// Build returns cbor of the tx without vkey witnesses and its hash
+	Build() ([]byte, string, error)
+	CreateTxWitness(txRaw []byte, signer cardanowallet.ITxSigner) ([]byte, error)
+	AssembleTxWitnesses(txRaw []byte, witnesses [][]byte) ([]byte, error)
+	Dispose()
+}
+
+func NewTxBuilder(backend TxBuilderBackend, cardanoCliBinary string) (TxBuilder, error) {
+	switch backend {
+	case "", TxBuilderBackendCli:
+		builder, err := cardanowallet.NewTxBuilder(cardanoCliBinary)
+		if err != nil {
+			return nil, err
+		}
+
+		return &cliTxBuilder{builder: builder}, nil
+	case TxBuilderBackendNative:
+		return &nativeTxBuilder{}, nil
+	default:
+		return nil, fmt.Errorf("unknown tx builder backend: %s", backend)
+	}
+}
+
+// cliTxBuilder is the TxBuilder of the cardano-infrastructure which runs cardano-cli
+type cliTxBuilder struct {
+	builder *cardanowallet.TxBuilder
+}
+
+var _ TxBuilder = (*cliTxBuilder)(nil)
+
+func (b *cliTxBuilder) SetProtocolParameters(protocolParameters []byte) TxBuilder {
+	b.builder.SetProtocolParameters(protocolParameters)
+
+	return b
+}
+
+func (b *cliTxBuilder) SetTimeToLive(timeToLive uint64) TxBuilder {
+	b.builder.SetTimeToLive(timeToLive)
+
+	return b
+}
+
+func (b *cliTxBuilder) SetMetaData(metadata []byte) TxBuilder {
+	b.builder.SetMetaData(metadata)
+
+	return b
+}
+
+func (b *cliTxBuilder) SetTestNetMagic(testNetMagic uint) TxBuilder {
+	b.builder.SetTestNetMagic(testNetMagic)
+
+	return b
+}
+
+func (b *cliTxBuilder) SetFee(fee uint64) TxBuilder {
+	b.builder.SetFee(fee)
+
+	return b
+}
+
+func (b *cliTxBuilder) AddInputsWithScript(
+	script *cardanowallet.PolicyScript, inputs ...cardanowallet.TxInput,
+) TxBuilder {
+	b.builder.AddInputsWithScript(script, inputs...)
+
+	return b
+}
+
+func (b *cliTxBuilder) AddOutputs(outputs ...cardanowallet.TxOutput) TxBuilder {
+	b.builder.AddOutputs(outputs...)
+
+	return b
+}
+
+func (b *cliTxBuilder) ReplaceOutput(index int, output cardanowallet.TxOutput) TxBuilder {
+	b.builder.ReplaceOutput(index, output)
+
+	return b
+}
+
+func (b *cliTxBuilder) UpdateOutputAmount(index int, amount uint64) TxBuilder {
+	b.builder.UpdateOutputAmount(index, amount)
+
+	return b
+}
+
+func (b *cliTxBuilder) RemoveOutput(index int) TxBuilder {
+	b.builder.RemoveOutput(index)
+
+	return b
+}
+
+func (b *cliTxBuilder) Build() ([]byte, string, error) {
+	return b.builder.Build()
+}
+
+func (b *cliTxBuilder) CreateTxWitness(txRaw []byte, signer cardanowallet.ITxSigner) ([]byte, error) {
+	return b.builder.CreateTxWitness(txRaw, signer)
+}
+
+func (b *cliTxBuilder) AssembleTxWitnesses(txRaw []byte, witnesses [][]byte) ([]byte, error) {
+	return b.builder.AssembleTxWitnesses(txRaw, witnesses)
+}
+
+func (b *cliTxBuilder) Dispose() {
+	b.builder.Dispose()
+}
@@ -0,0 +1,739 @@
+package cardanotx
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/fxamacker/cbor/v2"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// the ledger encodes lists, sets and maps with more items than the threshold with an indefinite length
+	cborLengthThreshold = 23
+	// the ledger limits text and bytes of the metadata to 64 bytes
+	metadataMaxLength = 64
+
+	cborMajorUint     = 0
+	cborMajorNegInt   = 1
+	cborMajorBytes    = 2
+	cborMajorText     = 3
+	cborMajorArray    = 4
+	cborMajorMap      = 5
+	cborMajorTag      = 6
+	cborIndefinite    = 31
+	cborBreak         = 0xff
+	cborTrue          = 0xf5
+	cborNull          = 0xf6
+	cborAuxiliaryData = 259
+
+	txBodyInputsKey           = 0
+	txBodyTTLKey              = 3
+	txBodyAuxDataHashKey      = 7
+	txWitnessNativeScriptsKey = 1
+	auxDataMetadataKey        = 0
+
+	nativeScriptSig     = 0
+	nativeScriptAll     = 1
+	nativeScriptAny     = 2
+	nativeScriptAtLeast = 3
+	nativeScriptAfter   = 4
+	nativeScriptBefore  = 5
+)
+
+var (
+	metadataIntRegex   = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	metadataBytesRegex = regexp.MustCompile(`^0x([0-9a-f]{2})*$`)
+)
+
+type nativeTxInput struct {
+	input        cardanowallet.TxInput
+	policyScript *cardanowallet.PolicyScript
+}
+
+// nativeTxBuilder serializes the txs in the same way as cardano-cli build-raw, witness and assemble commands do
+type nativeTxBuilder struct {
+	inputs             []nativeTxInput
+	outputs            []cardanowallet.TxOutput
+	metadata           []byte
+	protocolParameters []byte
+	timeToLive         uint64
+	fee                uint64
+}
+
+var _ TxBuilder = (*nativeTxBuilder)(nil)
+
+func (b *nativeTxBuilder) SetProtocolParameters(protocolParameters []byte) TxBuilder {
+	b.protocolParameters = protocolParameters
+
+	return b
+}
+
+func (b *nativeTxBuilder) SetTimeToLive(timeToLive uint64) TxBuilder {
+	b.timeToLive = timeToLive
+
+	return b
+}
+
+func (b *nativeTxBuilder) SetMetaData(metadata []byte) TxBuilder {
+	b.metadata = metadata
+
+	return b
+}
+
+// SetTestNetMagic implements TxBuilder. The network magic is not part of the tx
+func (b *nativeTxBuilder) SetTestNetMagic(uint) TxBuilder {
+	return b
+}
+
+func (b *nativeTxBuilder) SetFee(fee uint64) TxBuilder {
+	b.fee = fee
+
+	return b
+}
+
+func (b *nativeTxBuilder) AddInputsWithScript(
+	script *cardanowallet.PolicyScript, inputs ...cardanowallet.TxInput,
+) TxBuilder {
+	for _, input := range inputs {
+		b.inputs = append(b.inputs, nativeTxInput{
+			input:        input,
+			policyScript: script,
+		})
+	}
+
+	return b
+}
+
+func (b *nativeTxBuilder) AddOutputs(outputs ...cardanowallet.TxOutput) TxBuilder {
+	b.outputs = append(b.outputs, outputs...)
+
+	return b
+}
+
+func (b *nativeTxBuilder) ReplaceOutput(index int, output cardanowallet.TxOutput) TxBuilder {
+	if index < 0 {
+		index = len(b.outputs) + index
+	}
+
+	b.outputs[index] = output
+
+	return b
+}
+
+func (b *nativeTxBuilder) UpdateOutputAmount(index int, amount uint64) TxBuilder {
+	if index < 0 {
+		index = len(b.outputs) + index
+	}
+
+	b.outputs[index].Amount = amount
+
+	return b
+}
+
+func (b *nativeTxBuilder) RemoveOutput(index int) TxBuilder {
+	if index < 0 {
+		index = len(b.outputs) + index
+	}
+
+	b.outputs = slices.Delete(b.outputs, index, index+1)
+
+	return b
+}
+
+func (b *nativeTxBuilder) Build() ([]byte, string, error) {
+	if b.protocolParameters == nil {
+		return nil, "", errors.New("protocol parameters not set")
+	}
+
+	var errs []error
+
+	for i, x := range b.outputs {
+		if x.Amount == 0 {
+			errs = append(errs, fmt.Errorf("output (%s, %d) amount not specified", x.Addr, i))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, "", err
+	}
+
+	txRaw, err := b.buildTx(b.fee)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txHash, err := getTxBodyHash(txRaw)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return txRaw, hex.EncodeToString(txHash), nil
+}
+
+// CreateTxWitness implements TxBuilder. The witness is [0, [vkey, signature]] like the one of cardano-cli
+func (b *nativeTxBuilder) CreateTxWitness(txRaw []byte, signer cardanowallet.ITxSigner) ([]byte, error) {
+	txHash, err := getTxBodyHash(txRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	witness, err := signer.CreateTxWitness(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{cborMajorArray<<5 | 2, txWitnessVKeysKey}, witness...), nil
+}
+
+func (b *nativeTxBuilder) AssembleTxWitnesses(txRaw []byte, witnesses [][]byte) ([]byte, error) {
+	return assembleTxWitnesses(txRaw, witnesses)
+}
+
+func (b *nativeTxBuilder) Dispose() {}
+
+// buildTx returns [body, witness set with native scripts, true, auxiliary data]
+func (b *nativeTxBuilder) buildTx(fee uint64) ([]byte, error) {
+	inputs, err := b.encodeInputs()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([][]byte, len(b.outputs))
+
+	for i, output := range b.outputs {
+		outputs[i], err = encodeTxOutput(output)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	body := [][]byte{
+		encodeCborUint(txBodyInputsKey), inputs,
+		encodeCborUint(txBodyOutputsKey), encodeCborArray(outputs),
+		encodeCborUint(txBodyFeeKey), encodeCborUint(fee),
+		encodeCborUint(txBodyTTLKey), encodeCborUint(b.timeToLive),
+	}
+	auxData := []byte{cborNull}
+
+	if b.metadata != nil {
+		metadata, err := encodeMetadataJSON(b.metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		auxData = encodeCborTag(cborAuxiliaryData, encodeCborMap([][]byte{
+			encodeCborUint(auxDataMetadataKey), metadata,
+		}))
+		auxDataHash := blake2b.Sum256(auxData)
+
+		body = append(body, encodeCborUint(txBodyAuxDataHashKey), encodeCborBytes(auxDataHash[:]))
+	}
+
+	scripts, err := b.encodeNativeScripts()
+	if err != nil {
+		return nil, err
+	}
+
+	var witnessSet [][]byte
+	if scripts != nil {
+		witnessSet = [][]byte{encodeCborUint(txWitnessNativeScriptsKey), scripts}
+	}
+
+	return encodeCborArray([][]byte{
+		encodeCborMap(body), encodeCborMap(witnessSet), {cborTrue}, auxData,
+	}), nil
+}
+
+// encodeInputs returns the set of [hash, index] inputs sorted by hash and index
+func (b *nativeTxBuilder) encodeInputs() ([]byte, error) {
+	type txInput struct {
+		hash  []byte
+		index uint32
+	}
+
+	inputs := make([]txInput, len(b.inputs))
+
+	for i, inp := range b.inputs {
+		hash, err := hex.DecodeString(inp.input.Hash)
+		if err != nil || len(hash) != blake2b.Size256 {
+			return nil, fmt.Errorf("invalid tx input hash: %s", inp.input.Hash)
+		}
+
+		inputs[i] = txInput{hash: hash, index: inp.input.Index}
+	}
+
+	slices.SortFunc(inputs, func(a, b txInput) int {
+		if c := bytes.Compare(a.hash, b.hash); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.index, b.index)
+	})
+
+	inputs = slices.CompactFunc(inputs, func(a, b txInput) bool {
+		return bytes.Equal(a.hash, b.hash) && a.index == b.index
+	})
+
+	items := make([][]byte, len(inputs))
+	for i, inp := range inputs {
+		items[i] = encodeCborArray([][]byte{encodeCborBytes(inp.hash), encodeCborUint(uint64(inp.index))})
+	}
+
+	return encodeCborArray(items), nil
+}
+
+// encodeNativeScripts returns the set of distinct policy scripts of the inputs sorted by their hashes
+func (b *nativeTxBuilder) encodeNativeScripts() ([]byte, error) {
+	type script struct {
+		hash  []byte
+		bytes []byte
+	}
+
+	var scripts []script
+
+	for _, inp := range b.inputs {
+		if inp.policyScript == nil {
+			continue
+		}
+
+		scriptBytes, err := encodeNativeScript(*inp.policyScript)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := getNativeScriptHash(scriptBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		scripts = append(scripts, script{hash: hash, bytes: scriptBytes})
+	}
+
+	if len(scripts) == 0 {
+		return nil, nil
+	}
+
+	slices.SortFunc(scripts, func(a, b script) int {
+		return bytes.Compare(a.hash, b.hash)
+	})
+
+	scripts = slices.CompactFunc(scripts, func(a, b script) bool {
+		return bytes.Equal(a.hash, b.hash)
+	})
+
+	items := make([][]byte, len(scripts))
+	for i, x := range scripts {
+		items[i] = x.bytes
+	}
+
+	return encodeCborArray(items), nil
+}
+
+// assembleTxWitnesses adds the vkey witnesses sorted by their key hashes to the witness set of the tx
+func assembleTxWitnesses(txRaw []byte, witnesses [][]byte) ([]byte, error) {
+	var (
+		tx            []cbor.RawMessage
+		witnessSet    map[uint64]cbor.RawMessage
+		vkeyWitnesses []cbor.RawMessage
+	)
+
+	if err := cbor.Unmarshal(txRaw, &tx); err != nil {
+		return nil, fmt.Errorf("invalid tx: %w", err)
+	} else if len(tx) != 4 {
+		return nil, fmt.Errorf("invalid tx: expected 4 items but got %d", len(tx))
+	}
+
+	if err := cbor.Unmarshal(tx[1], &witnessSet); err != nil {
+		return nil, fmt.Errorf("invalid tx witness set: %w", err)
+	}
+
+	// witnesses which are already in the tx are kept
+	if existingWitnesses, exists := witnessSet[txWitnessVKeysKey]; exists {
+		if err := cbor.Unmarshal(existingWitnesses, &vkeyWitnesses); err != nil {
+			return nil, fmt.Errorf("invalid tx vkey witnesses: %w", err)
+		}
+	}
+
+	for _, witness := range witnesses {
+		vkeyWitnesses = append(vkeyWitnesses, witness)
+	}
+
+	type vkeyWitness struct {
+		keyHash []byte
+		bytes   []byte
+	}
+
+	sortedWitnesses := make([]vkeyWitness, len(vkeyWitnesses))
+
+	for i, witness := range vkeyWitnesses {
+		signature, vkey, err := cardanowallet.TxWitnessRaw(witness).GetSignatureAndVKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid tx vkey witness %d: %w", i, err)
+		}
+
+		keyHash, err := cardanowallet.GetKeyHashBytes(vkey)
+		if err != nil {
+			return nil, err
+		}
+
+		sortedWitnesses[i] = vkeyWitness{
+			keyHash: keyHash,
+			bytes:   encodeCborArray([][]byte{encodeCborBytes(vkey), encodeCborBytes(signature)}),
+		}
+	}
+
+	slices.SortFunc(sortedWitnesses, func(a, b vkeyWitness) int {
+		if c := bytes.Compare(a.keyHash, b.keyHash); c != 0 {
+			return c
+		}
+
+		return bytes.Compare(a.bytes, b.bytes)
+	})
+
+	sortedWitnesses = slices.CompactFunc(sortedWitnesses, func(a, b vkeyWitness) bool {
+		return bytes.Equal(a.bytes, b.bytes)
+	})
+
+	if len(sortedWitnesses) > 0 {
+		items := make([][]byte, len(sortedWitnesses))
+		for i, x := range sortedWitnesses {
+			items[i] = x.bytes
+		}
+
+		witnessSet[txWitnessVKeysKey] = encodeCborArray(items)
+	}
+
+	keys := slices.Sorted(maps.Keys(witnessSet))
+	witnessSetItems := make([][]byte, 0, len(keys)*2)
+
+	for _, key := range keys {
+		witnessSetItems = append(witnessSetItems, encodeCborUint(key), witnessSet[key])
+	}
+
+	return encodeCborArray([][]byte{tx[0], encodeCborMap(witnessSetItems), tx[2], tx[3]}), nil
+}
+
+// getTxBodyHash returns blake2b-256 hash of the tx body
+func getTxBodyHash(txRaw []byte) ([]byte, error) {
+	var tx []cbor.RawMessage
+
+	if err := cbor.Unmarshal(txRaw, &tx); err != nil {
+		return nil, fmt.Errorf("invalid tx: %w", err)
+	} else if len(tx) == 0 {
+		return nil, errors.New("invalid tx: body not found")
+	}
+
+	hash := blake2b.Sum256(tx[0])
+
+	return hash[:], nil
+}
+
+// encodeTxOutput returns the legacy [address, value] output which cardano-cli creates for outputs without datum
+func encodeTxOutput(output cardanowallet.TxOutput) ([]byte, error) {
+	addr, err := cardanowallet.NewCardanoAddressFromString(output.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output address %s: %w", output.Addr, err)
+	}
+
+	value := encodeCborUint(output.Amount)
+
+	multiAsset, err := encodeMultiAsset(output.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if multiAsset != nil {
+		value = encodeCborArray([][]byte{value, multiAsset})
+	}
+
+	return encodeCborArray([][]byte{encodeCborBytes(addr.GetBytes()), value}), nil
+}
+
+// encodeMultiAsset returns {policy id: {asset name: amount}} map without zero amounts.
+// Policy ids are sorted by their bytes and asset names by their length and bytes
+func encodeMultiAsset(tokens []cardanowallet.TokenAmount) ([]byte, error) {
+	assets := map[string]map[string]uint64{}
+
+	for _, token := range tokens {
+		if token.Amount == 0 {
+			continue
+		}
+
+		policyID, err := hex.DecodeString(token.PolicyID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy id of token %s: %w", token.TokenName(), err)
+		}
+
+		if _, exists := assets[string(policyID)]; !exists {
+			assets[string(policyID)] = map[string]uint64{}
+		}
+
+		assets[string(policyID)][token.Name] += token.Amount
+	}
+
+	if len(assets) == 0 {
+		return nil, nil
+	}
+
+	policyItems := make([][]byte, 0, len(assets)*2)
+
+	for _, policyID := range slices.Sorted(maps.Keys(assets)) {
+		names := slices.SortedFunc(maps.Keys(assets[policyID]), func(a, b string) int {
+			if c := cmp.Compare(len(a), len(b)); c != 0 {
+				return c
+			}
+
+			return strings.Compare(a, b)
+		})
+		nameItems := make([][]byte, 0, len(names)*2)
+
+		for _, name := range names {
+			nameItems = append(nameItems, encodeCborBytes([]byte(name)), encodeCborUint(assets[policyID][name]))
+		}
+
+		policyItems = append(policyItems, encodeCborBytes([]byte(policyID)), encodeCborMap(nameItems))
+	}
+
+	return encodeCborMap(policyItems), nil
+}
+
+func encodeNativeScript(ps cardanowallet.PolicyScript) ([]byte, error) {
+	switch ps.Type {
+	case cardanowallet.PolicyScriptSigType:
+		keyHash, err := hex.DecodeString(ps.KeyHash)
+		if err != nil || len(keyHash) != cardanowallet.KeyHashSize {
+			return nil, fmt.Errorf("invalid policy script key hash: %s", ps.KeyHash)
+		}
+
+		return encodeCborArray([][]byte{encodeCborUint(nativeScriptSig), encodeCborBytes(keyHash)}), nil
+	case "after":
+		return encodeCborArray([][]byte{encodeCborUint(nativeScriptAfter), encodeCborUint(ps.Slot)}), nil
+	case "before":
+		return encodeCborArray([][]byte{encodeCborUint(nativeScriptBefore), encodeCborUint(ps.Slot)}), nil
+	}
+
+	scripts := make([][]byte, len(ps.Scripts))
+
+	for i, script := range ps.Scripts {
+		scriptBytes, err := encodeNativeScript(script)
+		if err != nil {
+			return nil, err
+		}
+
+		scripts[i] = scriptBytes
+	}
+
+	switch ps.Type {
+	case "all":
+		return encodeCborArray([][]byte{encodeCborUint(nativeScriptAll), encodeCborArray(scripts)}), nil
+	case "any":
+		return encodeCborArray([][]byte{encodeCborUint(nativeScriptAny), encodeCborArray(scripts)}), nil
+	case cardanowallet.PolicyScriptAtLeastType:
+		return encodeCborArray([][]byte{
+			encodeCborUint(nativeScriptAtLeast), encodeCborUint(uint64(ps.Required)), encodeCborArray(scripts), //nolint:gosec
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported policy script type: %s", ps.Type)
+	}
+}
+
+// getNativeScriptHash returns blake2b-224 hash of the native script prefixed by its language tag (zero)
+func getNativeScriptHash(script []byte) ([]byte, error) {
+	return cardanowallet.GetKeyHashBytes(append([]byte{0}, script...))
+}
+
+// encodeMetadataJSON converts the json metadata in the same way as cardano-cli does it without a schema:
+// numbers are integers, strings prefixed by 0x are bytes, object keys are integers, bytes or strings
+func encodeMetadataJSON(metadataJSON []byte) ([]byte, error) {
+	var metadata map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader(metadataJSON))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+
+	labels := make(map[uint64][]byte, len(metadata))
+
+	for key, value := range metadata {
+		label, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metadata label: %s", key)
+		}
+
+		labels[label], err = encodeMetadatum(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	items := make([][]byte, 0, len(labels)*2)
+	for _, label := range slices.Sorted(maps.Keys(labels)) {
+		items = append(items, encodeCborUint(label), labels[label])
+	}
+
+	return encodeCborMap(items), nil
+}
+
+// encodeMetadatum encodes the metadata value. Unlike the other maps and lists of the tx,
+// maps and lists of the metadata always have a definite length and keep the order of the json object keys
+func encodeMetadatum(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return encodeMetadataInt(v.String())
+	case string:
+		if metadataBytesRegex.MatchString(v) {
+			value, _ := hex.DecodeString(v[2:])
+
+			return encodeMetadataBytes(value)
+		}
+
+		return encodeMetadataText(v)
+	case []any:
+		items := make([][]byte, len(v))
+
+		for i, item := range v {
+			itemBytes, err := encodeMetadatum(item)
+			if err != nil {
+				return nil, err
+			}
+
+			items[i] = itemBytes
+		}
+
+		return encodeCborCollection(cborMajorArray, len(items), items, false), nil
+	case map[string]any:
+		items := make([][]byte, 0, len(v)*2)
+
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			keyBytes, err := encodeMetadataKey(key)
+			if err != nil {
+				return nil, err
+			}
+
+			valueBytes, err := encodeMetadatum(v[key])
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, keyBytes, valueBytes)
+		}
+
+		return encodeCborCollection(cborMajorMap, len(v), items, false), nil
+	default:
+		return nil, fmt.Errorf("unsupported metadata value: %v", value)
+	}
+}
+
+func encodeMetadataKey(key string) ([]byte, error) {
+	if metadataIntRegex.MatchString(key) {
+		return encodeMetadataInt(key)
+	}
+
+	if metadataBytesRegex.MatchString(key) {
+		value, _ := hex.DecodeString(key[2:])
+
+		return encodeMetadataBytes(value)
+	}
+
+	return encodeMetadataText(key)
+}
+
+func encodeMetadataInt(value string) ([]byte, error) {
+	if n, err := strconv.ParseUint(strings.TrimPrefix(value, "+"), 10, 64); err == nil {
+		return encodeCborUint(n), nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n >= 0 {
+		return nil, fmt.Errorf("invalid metadata integer: %s", value)
+	}
+
+	return appendCborHeader(nil, cborMajorNegInt, uint64(-(n + 1))), nil
+}
+
+func encodeMetadataBytes(value []byte) ([]byte, error) {
+	if len(value) > metadataMaxLength {
+		return nil, fmt.Errorf("metadata bytes 0x%s are longer than %d bytes", hex.EncodeToString(value), metadataMaxLength)
+	}
+
+	return encodeCborBytes(value), nil
+}
+
+func encodeMetadataText(value string) ([]byte, error) {
+	if len(value) > metadataMaxLength {
+		return nil, fmt.Errorf("metadata text %s is longer than %d bytes", value, metadataMaxLength)
+	}
+
+	return append(appendCborHeader(nil, cborMajorText, uint64(len(value))), value...), nil
+}
+
+func encodeCborUint(n uint64) []byte {
+	return appendCborHeader(nil, cborMajorUint, n)
+}
+
+func encodeCborBytes(value []byte) []byte {
+	return append(appendCborHeader(nil, cborMajorBytes, uint64(len(value))), value...)
+}
+
+func encodeCborTag(tag uint64, content []byte) []byte {
+	return append(appendCborHeader(nil, cborMajorTag, tag), content...)
+}
+
+func encodeCborArray(items [][]byte) []byte {
+	return encodeCborCollection(cborMajorArray, len(items), items, len(items) > cborLengthThreshold)
+}
+
+// encodeCborMap encodes the map from the keys and values which alternate in the items
+func encodeCborMap(items [][]byte) []byte {
+	return encodeCborCollection(cborMajorMap, len(items)/2, items, len(items)/2 > cborLengthThreshold)
+}
+
+func encodeCborCollection(major byte, count int, items [][]byte, indefinite bool) []byte {
+	var result []byte
+
+	if indefinite {
+		result = []byte{major<<5 | cborIndefinite}
+	} else {
+		result = appendCborHeader(nil, major, uint64(count))
+	}
+
+	for _, item := range items {
+		result = append(result, item...)
+	}
+
+	if indefinite {
+		result = append(result, cborBreak)
+	}
+
+	return result
+}
+
+func appendCborHeader(dst []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(dst, major<<5|byte(n))
+	case n <= math.MaxUint8:
+		return append(dst, major<<5|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(dst, major<<5|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(dst, major<<5|26), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(dst, major<<5|27), n)
+	}
+}
@@ -0,0 +1,452 @@
+package cardanotx
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os/exec"
+	"testing"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+// expected values are created by cardano-cli
+const (
+	nativeTxRawHex = "84a50083825820098236134e0f2077a6434dd9d7727126fa8b3627bcab3ae030a194d46eded73e00825820d1fd0d772be7741d9bfaf0b037d02d2867a987ccba3e6ba2ee9aa2a861b7314502825820e99a5bde15aa05f24fcc04b7eabc1520d3397283b1ee720de9fe2653abbb0c9f00018382581d60244877c1aeefc7fd5405a6e14d927d91758d45e37c20fa2ac89cb1671a000f424082581d704aaad0f0626a8ce7b097497e542055b6520842ade881f980e002ae661a001e847682581d703ea4c4aef89a27f111e78464d7d6717b099f85ce27109ee9e5fbddec1a001a79bf021a00040ac103196dc0075820802e4d6f15ce98826886a5451e94855e77aae779cb341d3aab1e3bae4fb2f78da10182830304858200581c47344d5bd7b2fea56336ba789579705a944760032585ef64084c92db8200581c6837232854849427dae7c45892032d7ded136c5beb13c68fda635d878200581cd215701e2eb17c741b9d306cba553f9fbaaca1e12a5925a065b90fa88200581cf01018c1d8da54c2f557679243b09af1c4dd4d9c671512b01fa5f92b8200581cf0f4837b3a306752a2b3e52394168bc7391de3dce11364b723cc55cf830304858200581c06b4c7f5254d6395b527ac3de60c1d77194df7431d85fe55ca8f107d8200581c2368e8113bd5f32d713751791d29acee9e1b5a425b0454b963b2558b8200581c79df3577e4c7d7da04872c2182b8d8829d7b477912dbf35d89287c398200581ccba89c7084bf0ce4bf404346b668a7e83c8c9c250d1cafd8d8996e418200581cd6b67f93ffa4e2651271cc9bcdbdedb2539911266b534d9c163cba21f5d90103a100a200a36a6665655369676e65727305677369676e657273056474797065656d756c746904a26463697479684e6f76692053616464636f6d706845746865726e616c"
+	nativeTxHash   = "1b9298c51f4dc05c04cae37104124cfb76e9f98f04a7f6b8179cfe02913152ec"
+	// fee of nativeTx calculated by cardano-cli
+	nativeTxFee = 264897
+
+	// batch tx of the batcher tests whose fee multisig input is spent on the exact fee entirely
+	batchTxRawHex = "84a5008282582000000000000000000000000000000000000000000000000000000000000000120082582000000000000000000000000000000000000000000000000000000000000000ff00018282581d6033c378cee41b2e15ac848f7f6f1d2f78155ab12d93b713de898d855f1903e882581d702b5398fcb481e94163a6b5cca889c54bcd9d340fb71c5eaa9f2c8d441a001e8098021a0002b989031864075820c5e403ad2ee72ff4eb1ab7e988c1e1b4cb34df699cb9112d6bded8e8f3195f34a10182830301818200581ce67d6de92a4abb3712e887fe2cf0f07693028fad13a3e510dbe73394830301818200581c31a31e2f2cd4e1d66fc25f400aa02ab0fe6ca5a3d735c2974e842a89f5d90103a100a101a2616e016174656261746368"
+	batchTxHash   = "f964dc406e66e9bbc89c3b0cf0c734c264e57e687dcf30f5e2362b143bd291c4"
+
+	witnessData    = "825820c73cd59dbfba2e07577ad69621e964d404c7bef56f69e1691438abd37356199958408233a747b14fc78ba32fbe8501b842d3290c591a565f589dbeec1c1e8b3dfe27de19002784c6c7020871fd07a5dd70e1003b6d1449255985c823464123085a00"
+	witnessTxRaw   = "84a500818258201f55818892cc447cbf9fc27e04899ea98795538889555d3846a8071f4fdb75eb01018282581d70c4aab1955b120811d634e3a1b282ea090537d9e753842e8f46c280041a00200b2082583900712c77c7e146b95a569f2f7edf1dd81df2545edecb132701f17f84d4694c18049dcafc175d262c06eac9f52b86f205e38e8bfca6e6a545611a055e8308021a0002e908031a0152a319075820cb1b53bb62ee65e8ae893d04331dcc70d745298a32fcedf5ff9cc7a12d8471e3a0f5d90103a100a101a5616466766563746f726266611a0010c8e06173837828616464725f74657374317170636a63613738753972746a6b6a6b6e756868616863616d71776c793478287a376d6d39337866637037396c636634726666737671663877326c73743436663376716d34766e61781c6674736d6571746375773330373264653439673473737a333437377a61746662726964676562747881a26161827828766563746f725f7465737431766772677868347333356135706476306463347a6771333363726e33781934656d6e6b326537766e656e73663474657a7133746b6d396d616d1a000f4240"
+	witnessTxFinal = "84a500818258201f55818892cc447cbf9fc27e04899ea98795538889555d3846a8071f4fdb75eb01018282581d70c4aab1955b120811d634e3a1b282ea090537d9e753842e8f46c280041a00200b2082583900712c77c7e146b95a569f2f7edf1dd81df2545edecb132701f17f84d4694c18049dcafc175d262c06eac9f52b86f205e38e8bfca6e6a545611a055e8308021a0002e908031a0152a319075820cb1b53bb62ee65e8ae893d04331dcc70d745298a32fcedf5ff9cc7a12d8471e3a10081825820c73cd59dbfba2e07577ad69621e964d404c7bef56f69e1691438abd37356199958408233a747b14fc78ba32fbe8501b842d3290c591a565f589dbeec1c1e8b3dfe27de19002784c6c7020871fd07a5dd70e1003b6d1449255985c823464123085a00f5d90103a100a101a5616466766563746f726266611a0010c8e06173837828616464725f74657374317170636a63613738753972746a6b6a6b6e756868616863616d71776c793478287a376d6d39337866637037396c636634726666737671663877326c73743436663376716d34766e61781c6674736d6571746375773330373264653439673473737a333437377a61746662726964676562747881a26161827828766563746f725f7465737431766772677868347333356135706476306463347a6771333363726e33781934656d6e6b326537766e656e73663474657a7133746b6d396d616d1a000f4240"
+)
+
+var nativeTxProtocolParameters = []byte(`{
+	"maxTxSize": 16384,
+	"txFeeFixed": 155381,
+	"txFeePerByte": 44,
+	"utxoCostPerByte": 4310
+}`)
+
+func TestTxBuilderBackend(t *testing.T) {
+	require.NoError(t, TxBuilderBackend("").Validate())
+	require.NoError(t, TxBuilderBackendCli.Validate())
+	require.NoError(t, TxBuilderBackendNative.Validate())
+	require.ErrorContains(t, TxBuilderBackend("ogmios").Validate(), "unknown tx builder backend: ogmios")
+
+	// only the batches of the native backend commit to their backend
+	require.Empty(t, TxBuilderBackend("").GetBatchMetadataValue())
+	require.Empty(t, TxBuilderBackendCli.GetBatchMetadataValue())
+	require.Equal(t, "native", TxBuilderBackendNative.GetBatchMetadataValue())
+
+	_, err := NewTxBuilder("ogmios", "")
+	require.ErrorContains(t, err, "unknown tx builder backend: ogmios")
+
+	builder, err := NewTxBuilder(TxBuilderBackendNative, "")
+	require.NoError(t, err)
+	require.IsType(t, &nativeTxBuilder{}, builder)
+}
+
+func TestNativeTxBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		builder := newTestTxBuilder(t, TxBuilderBackendNative, "")
+		defer builder.Dispose()
+
+		builder.SetFee(nativeTxFee).UpdateOutputAmount(-2, 1_999_990).UpdateOutputAmount(-1, 2_000_000-nativeTxFee)
+
+		txRaw, txHash, err := builder.Build()
+		require.NoError(t, err)
+		require.Equal(t, nativeTxRawHex, hex.EncodeToString(txRaw))
+		require.Equal(t, nativeTxHash, txHash)
+	})
+
+	t.Run("Build invalid", func(t *testing.T) {
+		_, _, err := (&nativeTxBuilder{}).Build()
+		require.ErrorContains(t, err, "protocol parameters not set")
+
+		builder := newTestTxBuilder(t, TxBuilderBackendNative, "")
+
+		_, _, err = builder.Build()
+		require.ErrorContains(t, err, "amount not specified")
+
+		builder.UpdateOutputAmount(-2, 1).UpdateOutputAmount(-1, 1).SetMetaData([]byte(`{"1": 1.5}`))
+
+		_, _, err = builder.Build()
+		require.ErrorContains(t, err, "invalid metadata integer: 1.5")
+
+		builder.AddInputsWithScript(nil, wallet.TxInput{Hash: "ff"})
+
+		_, _, err = builder.Build()
+		require.ErrorContains(t, err, "invalid tx input hash: ff")
+	})
+
+	t.Run("Build", func(t *testing.T) {
+		builder := newTestTxBuilder(t, TxBuilderBackendNative, "")
+
+		builder.SetFee(nativeTxFee).UpdateOutputAmount(-2, 1_999_990).UpdateOutputAmount(-1, 2_000_000-nativeTxFee)
+
+		txRaw, txHash, err := builder.Build()
+		require.NoError(t, err)
+		require.Equal(t, nativeTxRawHex, hex.EncodeToString(txRaw))
+		require.Equal(t, nativeTxHash, txHash)
+	})
+
+	t.Run("CreateTxWitness", func(t *testing.T) {
+		txRaw, err := hex.DecodeString(witnessTxRaw)
+		require.NoError(t, err)
+
+		signer, err := wallet.GenerateWallet(false)
+		require.NoError(t, err)
+
+		witness, err := (&nativeTxBuilder{}).CreateTxWitness(txRaw, signer)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x82, 0x00}, witness[:2])
+
+		signature, vkey, err := wallet.TxWitnessRaw(witness).GetSignatureAndVKey()
+		require.NoError(t, err)
+		require.Equal(t, signer.VerificationKey, vkey)
+
+		txHash, err := getTxBodyHash(txRaw)
+		require.NoError(t, err)
+		require.NoError(t, wallet.VerifyMessage(txHash, vkey, signature))
+	})
+
+	t.Run("AssembleTxWitnesses", func(t *testing.T) {
+		txRaw, err := hex.DecodeString(witnessTxRaw)
+		require.NoError(t, err)
+
+		witness, err := hex.DecodeString("8200" + witnessData)
+		require.NoError(t, err)
+
+		builder := &nativeTxBuilder{}
+
+		txFinal, err := builder.AssembleTxWitnesses(txRaw, [][]byte{witness})
+		require.NoError(t, err)
+		require.Equal(t, witnessTxFinal, hex.EncodeToString(txFinal))
+
+		// witnesses which are already in the tx are kept only once
+		txFinal, err = builder.AssembleTxWitnesses(txFinal, [][]byte{witness})
+		require.NoError(t, err)
+		require.Equal(t, witnessTxFinal, hex.EncodeToString(txFinal))
+	})
+
+	t.Run("AssembleTxWitnesses order", func(t *testing.T) {
+		builder := newTestTxBuilder(t, TxBuilderBackendNative, "")
+		builder.SetFee(nativeTxFee).UpdateOutputAmount(-2, 1_999_990).UpdateOutputAmount(-1, 2_000_000-nativeTxFee)
+
+		txRaw, _, err := builder.Build()
+		require.NoError(t, err)
+
+		witnesses := make([][]byte, 3)
+
+		for i := range witnesses {
+			signer, err := wallet.GenerateWallet(false)
+			require.NoError(t, err)
+
+			witnesses[i], err = builder.CreateTxWitness(txRaw, signer)
+			require.NoError(t, err)
+		}
+
+		txFinal1, err := builder.AssembleTxWitnesses(txRaw, witnesses)
+		require.NoError(t, err)
+
+		txFinal2, err := builder.AssembleTxWitnesses(txRaw, [][]byte{witnesses[2], witnesses[0], witnesses[1]})
+		require.NoError(t, err)
+		require.Equal(t, txFinal1, txFinal2)
+		require.Len(t, txFinal1, len(txRaw)+1+1+3*101)
+
+		_, err = builder.AssembleTxWitnesses(txRaw, [][]byte{{0x01}})
+		require.ErrorContains(t, err, "invalid tx vkey witness 0")
+	})
+
+	t.Run("CreateTx", func(t *testing.T) {
+		policyScript, policyScriptFee := getTestPolicyScripts()
+		multisigAddr := getTestPolicyScriptAddress(t, policyScript)
+		feeAddr := getTestPolicyScriptAddress(t, policyScriptFee)
+		outputAddr := "addr_test1vqjysa7p4mhu0l25qknwznvj0kghtr29ud7zp732ezwtzec0w8g3u"
+
+		txInputInfos := TxInputInfos{
+			MultiSig: &TxInputInfo{
+				TxInputs: wallet.TxInputs{
+					Inputs: []wallet.TxInput{
+						{Hash: "e99a5bde15aa05f24fcc04b7eabc1520d3397283b1ee720de9fe2653abbb0c9f"},
+					},
+					Sum: map[string]uint64{wallet.AdaTokenName: 5_000_000},
+				},
+				PolicyScript: policyScript,
+				Address:      multisigAddr,
+			},
+			MultiSigFee: &TxInputInfo{
+				TxInputs: wallet.TxInputs{
+					Inputs: []wallet.TxInput{
+						{Hash: "e99a5bde15aa05f24fcc04b7eabc1520d3397283b1ee720de9fe2653abbb0c9f", Index: 1},
+					},
+					Sum: map[string]uint64{wallet.AdaTokenName: 3_000_000},
+				},
+				PolicyScript: policyScriptFee,
+				Address:      feeAddr,
+			},
+		}
+
+		txRaw, txHash, err := CreateTxWithBackend(TxBuilderBackendNative, "", 203, nativeTxProtocolParameters, 28096, nil,
+			txInputInfos, []wallet.TxOutput{wallet.NewTxOutput(outputAddr, 2_000_000)})
+		require.NoError(t, err)
+
+		info, err := common.ParseTxInfo(txRaw, true)
+		require.NoError(t, err)
+		require.Equal(t, txHash, info.Hash)
+		require.Len(t, info.Outputs, 3)
+		require.Equal(t, uint64(2_000_000), info.Outputs[0].Amount)
+		require.Equal(t, multisigAddr, info.Outputs[2].Address)
+		require.Equal(t, uint64(3_000_000), info.Outputs[2].Amount)
+		require.Equal(t, feeAddr, info.Outputs[1].Address)
+		require.Equal(t, 3_000_000-info.Fee, info.Outputs[1].Amount)
+
+		// the fee is the exact minimal fee of the signed tx
+		txLimits, err := NewTxLimits(nativeTxProtocolParameters)
+		require.NoError(t, err)
+
+		minFee, err := txLimits.GetTxMinFee(txRaw, policyScript.GetCount()+policyScriptFee.GetCount())
+		require.NoError(t, err)
+		require.Equal(t, minFee, info.Fee)
+	})
+
+	t.Run("CreateTx raises outputs to min utxo", func(t *testing.T) {
+		policyScript, policyScriptFee := getTestPolicyScripts()
+		outputAddr := "addr_test1vqjysa7p4mhu0l25qknwznvj0kghtr29ud7zp732ezwtzec0w8g3u"
+
+		txInputInfos := TxInputInfos{
+			MultiSig: &TxInputInfo{
+				TxInputs: wallet.TxInputs{
+					Inputs: []wallet.TxInput{
+						{Hash: "e99a5bde15aa05f24fcc04b7eabc1520d3397283b1ee720de9fe2653abbb0c9f"},
+					},
+					Sum: map[string]uint64{wallet.AdaTokenName: 5_000_000},
+				},
+				PolicyScript: policyScript,
+				Address:      getTestPolicyScriptAddress(t, policyScript),
+			},
+			MultiSigFee: &TxInputInfo{
+				TxInputs: wallet.TxInputs{
+					Inputs: []wallet.TxInput{
+						{Hash: "e99a5bde15aa05f24fcc04b7eabc1520d3397283b1ee720de9fe2653abbb0c9f", Index: 1},
+					},
+					Sum: map[string]uint64{wallet.AdaTokenName: 3_000_000},
+				},
+				PolicyScript: policyScriptFee,
+				Address:      getTestPolicyScriptAddress(t, policyScriptFee),
+			},
+		}
+
+		txLimits, err := NewTxLimits(nativeTxProtocolParameters)
+		require.NoError(t, err)
+
+		minUtxo, err := txLimits.GetMinUtxo(wallet.NewTxOutput(outputAddr, 1_000_000))
+		require.NoError(t, err)
+
+		txRaw, _, err := CreateTxWithBackend(TxBuilderBackendNative, "", 203, nativeTxProtocolParameters, 28096, nil,
+			txInputInfos, []wallet.TxOutput{wallet.NewTxOutput(outputAddr, 1_000)})
+		require.NoError(t, err)
+
+		info, err := common.ParseTxInfo(txRaw, true)
+		require.NoError(t, err)
+		require.Equal(t, minUtxo, info.Outputs[0].Amount)
+		require.Equal(t, 5_000_000-minUtxo, info.Outputs[2].Amount)
+	})
+
+	t.Run("CreateTx recorded batch", func(t *testing.T) {
+		policyScript := wallet.NewPolicyScript([]string{"e67d6de92a4abb3712e887fe2cf0f07693028fad13a3e510dbe73394"}, 1)
+		policyScriptFee := wallet.NewPolicyScript([]string{"31a31e2f2cd4e1d66fc25f400aa02ab0fe6ca5a3d735c2974e842a89"}, 1)
+		// the receiver amount is lower than the minimal utxo amount of nativeTxProtocolParameters
+		protocolParams := []byte(`{"maxTxSize": 16384, "txFeeFixed": 155381, "txFeePerByte": 44, "utxoCostPerByte": 1}`)
+
+		txInputInfos := TxInputInfos{
+			MultiSig: &TxInputInfo{
+				TxInputs: wallet.TxInputs{
+					Inputs: []wallet.TxInput{
+						{Hash: "0000000000000000000000000000000000000000000000000000000000000012"},
+					},
+					Sum: map[string]uint64{wallet.AdaTokenName: 2_000_000},
+				},
+				PolicyScript: policyScript,
+				Address:      getTestPolicyScriptAddress(t, policyScript),
+			},
+			MultiSigFee: &TxInputInfo{
+				TxInputs: wallet.TxInputs{
+					Inputs: []wallet.TxInput{
+						{Hash: "00000000000000000000000000000000000000000000000000000000000000ff"},
+					},
+					Sum: map[string]uint64{wallet.AdaTokenName: 178_569},
+				},
+				PolicyScript: policyScriptFee,
+				Address:      getTestPolicyScriptAddress(t, policyScriptFee),
+			},
+		}
+
+		txRaw, txHash, err := CreateTxWithBackend(TxBuilderBackendNative, "", 42, protocolParams, 100,
+			[]byte(`{"1": {"t": "batch", "n": 1}}`), txInputInfos, []wallet.TxOutput{
+				wallet.NewTxOutput("addr_test1vqeux7xwusdju9dvsj8h7mca9aup2k439kfmwy773xxc2hcu7zy99", 1_000),
+			})
+		require.NoError(t, err)
+		require.Equal(t, batchTxRawHex, hex.EncodeToString(txRaw))
+		require.Equal(t, batchTxHash, txHash)
+	})
+
+	t.Run("encodeMetadataJSON", func(t *testing.T) {
+		metadata, err := encodeMetadataJSON([]byte(
+			`{"674": {"b": [-1, 24, "0xab"], "a": "text", "0x01": 0, "7": {}}}`))
+		require.NoError(t, err)
+		// {674: {h'01': 0, 7: {}, "a": "text", "b": [-1, 24, h'ab']}}
+		require.Equal(t, "a11902a2a4410100"+"07a0"+"61616474657874"+"6162"+"83201818"+"41ab",
+			hex.EncodeToString(metadata))
+
+		_, err = encodeMetadataJSON([]byte(`{"a": 1}`))
+		require.ErrorContains(t, err, "invalid metadata label: a")
+
+		_, err = encodeMetadataJSON([]byte(`{"1": "0123456789012345678901234567890123456789012345678901234567890123456789"}`))
+		require.ErrorContains(t, err, "is longer than 64 bytes")
+	})
+}
+
+// TestTxBuilderBackends checks that both backends create the same txs and witnesses.
+// It runs in the test environment of the CI, which has cardano-cli
+func TestTxBuilderBackends(t *testing.T) {
+	cardanoCliBinary := wallet.ResolveCardanoCliBinary(wallet.TestNetNetwork)
+	if _, err := exec.LookPath(cardanoCliBinary); err != nil {
+		if cardanoCliRequired {
+			t.Fatalf("%s not found", cardanoCliBinary)
+		}
+
+		t.Skipf("%s not found", cardanoCliBinary)
+	}
+
+	signer, err := wallet.GenerateWallet(false)
+	require.NoError(t, err)
+
+	policyScript, policyScriptFee := getTestPolicyScripts()
+	txInputInfos := TxInputInfos{
+		MultiSig: &TxInputInfo{
+			TxInputs: wallet.TxInputs{
+				Inputs: []wallet.TxInput{
+					{Hash: "e99a5bde15aa05f24fcc04b7eabc1520d3397283b1ee720de9fe2653abbb0c9f"},
+					{Hash: "d1fd0d772be7741d9bfaf0b037d02d2867a987ccba3e6ba2ee9aa2a861b73145", Index: 2},
+				},
+				Sum: map[string]uint64{wallet.AdaTokenName: 5_000_000},
+			},
+			PolicyScript: policyScript,
+			Address:      getTestPolicyScriptAddress(t, policyScript),
+		},
+		MultiSigFee: &TxInputInfo{
+			TxInputs: wallet.TxInputs{
+				Inputs: []wallet.TxInput{
+					{Hash: "098236134e0f2077a6434dd9d7727126fa8b3627bcab3ae030a194d46eded73e"},
+				},
+				Sum: map[string]uint64{wallet.AdaTokenName: 3_000_000},
+			},
+			PolicyScript: policyScriptFee,
+			Address:      getTestPolicyScriptAddress(t, policyScriptFee),
+		},
+	}
+
+	results := make([][][]byte, 2)
+
+	for i, backend := range []TxBuilderBackend{TxBuilderBackendCli, TxBuilderBackendNative} {
+		builder := newTestTxBuilder(t, backend, cardanoCliBinary)
+		defer builder.Dispose()
+
+		builder.SetFee(nativeTxFee).UpdateOutputAmount(-2, 1_999_990).UpdateOutputAmount(-1, 2_000_000-nativeTxFee)
+
+		txRaw, txHash, err := builder.Build()
+		require.NoError(t, err)
+
+		witness, err := builder.CreateTxWitness(txRaw, signer)
+		require.NoError(t, err)
+
+		txFinal, err := builder.AssembleTxWitnesses(txRaw, [][]byte{witness})
+		require.NoError(t, err)
+
+		// the exact fee does not depend on the backend
+		batchTxRaw, batchTxHash, err := CreateTxWithBackend(backend, cardanoCliBinary, 203,
+			nativeTxProtocolParameters, 28096, []byte(`{"1": {"t": "batch", "n": 1}}`), txInputInfos,
+			[]wallet.TxOutput{
+				wallet.NewTxOutput("addr_test1vqjysa7p4mhu0l25qknwznvj0kghtr29ud7zp732ezwtzec0w8g3u", 2_000_000),
+			})
+		require.NoError(t, err)
+
+		results[i] = [][]byte{txRaw, []byte(txHash), witness, txFinal, batchTxRaw, []byte(batchTxHash)}
+	}
+
+	require.Equal(t, results[0], results[1])
+}
+
+// newTestTxBuilder returns the builder of the tx with two multisig inputs, one fee multisig input,
+// metadata and outputs whose amounts are set after the fee is calculated
+func newTestTxBuilder(t *testing.T, backend TxBuilderBackend, cardanoCliBinary string) TxBuilder {
+	t.Helper()
+
+	policyScript, policyScriptFee := getTestPolicyScripts()
+
+	metadata, err := json.Marshal(map[uint64]any{
+		0: map[string]any{"type": "multi", "signers": 5, "feeSigners": 5},
+		4: map[string]any{"comp": "Ethernal", "city": "Novi Sad"},
+	})
+	require.NoError(t, err)
+
+	builder, err := NewTxBuilder(backend, cardanoCliBinary)
+	require.NoError(t, err)
+
+	builder.SetTimeToLive(28096).SetProtocolParameters(nativeTxProtocolParameters).
+		SetMetaData(metadata).SetTestNetMagic(203).
+		AddOutputs(
+			wallet.NewTxOutput("addr_test1vqjysa7p4mhu0l25qknwznvj0kghtr29ud7zp732ezwtzec0w8g3u", 1_000_000),
+			wallet.TxOutput{Addr: getTestPolicyScriptAddress(t, policyScript)},
+			wallet.TxOutput{Addr: getTestPolicyScriptAddress(t, policyScriptFee)}).
+		AddInputsWithScript(policyScript,
+			wallet.TxInput{Hash: "e99a5bde15aa05f24fcc04b7eabc1520d3397283b1ee720de9fe2653abbb0c9f", Index: 0},
+			wallet.TxInput{Hash: "d1fd0d772be7741d9bfaf0b037d02d2867a987ccba3e6ba2ee9aa2a861b73145", Index: 2}).
+		AddInputsWithScript(policyScriptFee,
+			wallet.TxInput{Hash: "098236134e0f2077a6434dd9d7727126fa8b3627bcab3ae030a194d46eded73e", Index: 0})
+
+	return builder
+}
+
+// getTestPolicyScripts returns the multisig and fee multisig policy scripts (4 of 5)
+func getTestPolicyScripts() (*wallet.PolicyScript, *wallet.PolicyScript) {
+	policyScript := wallet.NewPolicyScript([]string{
+		"d6b67f93ffa4e2651271cc9bcdbdedb2539911266b534d9c163cba21",
+		"cba89c7084bf0ce4bf404346b668a7e83c8c9c250d1cafd8d8996e41",
+		"79df3577e4c7d7da04872c2182b8d8829d7b477912dbf35d89287c39",
+		"2368e8113bd5f32d713751791d29acee9e1b5a425b0454b963b2558b",
+		"06b4c7f5254d6395b527ac3de60c1d77194df7431d85fe55ca8f107d",
+	}, 4)
+	policyScriptFee := wallet.NewPolicyScript([]string{
+		"f0f4837b3a306752a2b3e52394168bc7391de3dce11364b723cc55cf",
+		"47344d5bd7b2fea56336ba789579705a944760032585ef64084c92db",
+		"f01018c1d8da54c2f557679243b09af1c4dd4d9c671512b01fa5f92b",
+		"6837232854849427dae7c45892032d7ded136c5beb13c68fda635d87",
+		"d215701e2eb17c741b9d306cba553f9fbaaca1e12a5925a065b90fa8",
+	}, 4)
+
+	return policyScript, policyScriptFee
+}
+
+func getTestPolicyScriptAddress(t *testing.T, ps *wallet.PolicyScript) string {
+	t.Helper()
+
+	script, err := encodeNativeScript(*ps)
+	require.NoError(t, err)
+
+	policyID, err := getNativeScriptHash(script)
+	require.NoError(t, err)
+
+	addr, err := wallet.NewPolicyScriptEnterpriseAddress(wallet.TestNetNetwork, hex.EncodeToString(policyID))
+	require.NoError(t, err)
+
+	return addr.String()
+}
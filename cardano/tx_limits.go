@@ -0,0 +1,364 @@
+package cardanotx
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	// minUtxoEntryOverhead is the size of the utxo entry which is not part of the serialized output
+	minUtxoEntryOverhead = 160
+	// vkeyWitnessSize is the size of a single serialized vkey witness: [bytes(32), bytes(64)]
+	vkeyWitnessSize = 101
+
+	txBodyOutputsKey  = 1
+	txBodyFeeKey      = 2
+	txWitnessVKeysKey = 0
+	txRedeemersKey    = 5
+)
+
+var ErrTxSizeTooBig = errors.New("tx size too big")
+
+type redeemerKey struct {
+	_     struct{} `cbor:",toarray"`
+	Tag   uint64
+	Index uint64
+}
+
+// TxLimits are the ledger rules of the protocol parameters which every batch tx must satisfy
+type TxLimits struct {
+	MaxTxSize           uint64
+	MinFeeA             uint64
+	MinFeeB             uint64
+	CoinsPerUTxOByte    uint64
+	MaxValueSize        uint64
+	MaxTxExecutionUnits cardanowallet.ProtocolParametersMemorySteps
+	ExecutionUnitPrices cardanowallet.ProtocolParametersPriceMemorySteps
+}
+
+func NewTxLimits(protocolParams []byte) (*TxLimits, error) {
+	var params cardanowallet.ProtocolParameters
+
+	if err := json.Unmarshal(protocolParams, &params); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protocol parameters: %w", err)
+	}
+
+	if params.MaxTxSize == 0 || params.UtxoCostPerByte == 0 {
+		return nil, errors.New("max tx size or utxo cost per byte is not set in protocol parameters")
+	}
+
+	return &TxLimits{
+		MaxTxSize:           params.MaxTxSize,
+		MinFeeA:             params.TxFeePerByte,
+		MinFeeB:             params.TxFeeFixed,
+		CoinsPerUTxOByte:    params.UtxoCostPerByte,
+		MaxValueSize:        params.MaxValueSize,
+		MaxTxExecutionUnits: params.MaxTxExecutionUnits,
+		ExecutionUnitPrices: params.ExecutionUnitPrices,
+	}, nil
+}
+
+// GetMinFee returns the minimal fee of the tx with the given size which consumes the given execution units
+func (l TxLimits) GetMinFee(txSize uint64, executionUnits cardanowallet.ProtocolParametersMemorySteps) uint64 {
+	executionFee := math.Ceil(l.ExecutionUnitPrices.PriceMemory*float64(executionUnits.Memory) +
+		l.ExecutionUnitPrices.PriceSteps*float64(executionUnits.Steps))
+
+	return l.MinFeeA*txSize + l.MinFeeB + uint64(executionFee)
+}
+
+// GetMinUtxo returns the minimal lovelace amount of the output including its native tokens
+func (l TxLimits) GetMinUtxo(output cardanowallet.TxOutput) (uint64, error) {
+	addr, err := cardanowallet.NewCardanoAddressFromString(output.Addr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid output address %s: %w", output.Addr, err)
+	}
+
+	value := any(output.Amount)
+
+	if len(output.Tokens) > 0 {
+		multiAsset := map[cbor.ByteString]map[cbor.ByteString]uint64{}
+
+		for _, token := range output.Tokens {
+			policyID, err := hex.DecodeString(token.PolicyID)
+			if err != nil {
+				return 0, fmt.Errorf("invalid policy id of token %s: %w", token.TokenName(), err)
+			}
+
+			assets, exists := multiAsset[cbor.ByteString(policyID)]
+			if !exists {
+				assets = map[cbor.ByteString]uint64{}
+				multiAsset[cbor.ByteString(policyID)] = assets
+			}
+
+			assets[cbor.ByteString(token.Name)] += token.Amount
+		}
+
+		value = []any{output.Amount, multiAsset}
+	}
+
+	outputBytes, err := cbor.Marshal([]any{addr.GetBytes(), value})
+	if err != nil {
+		return 0, err
+	}
+
+	return l.getMinUtxo(uint64(len(outputBytes))), nil
+}
+
+// RaiseToMinUtxo returns the outputs with lovelace amounts raised to their minimal utxo amounts
+func (l TxLimits) RaiseToMinUtxo(outputs []cardanowallet.TxOutput) ([]cardanowallet.TxOutput, error) {
+	result := slices.Clone(outputs)
+
+	for i := range result {
+		// the minimal utxo amount grows with the size of the amount, so it is calculated again for the new amount
+		for {
+			minUtxo, err := l.GetMinUtxo(result[i])
+			if err != nil {
+				return nil, err
+			}
+
+			if result[i].Amount >= minUtxo {
+				break
+			}
+
+			result[i].Amount = minUtxo
+		}
+	}
+
+	return result, nil
+}
+
+// GetTxMinFee returns the exact minimal fee of the raw tx signed by witnessCount vkey witnesses
+func (l TxLimits) GetTxMinFee(txRaw []byte, witnessCount int) (uint64, error) {
+	tx, err := parseTx(txRaw, witnessCount)
+	if err != nil {
+		return 0, err
+	}
+
+	executionUnits, err := getRedeemersExecutionUnits(tx.witnessSet[txRedeemersKey])
+	if err != nil {
+		return 0, err
+	}
+
+	return l.GetMinFee(tx.size, executionUnits), nil
+}
+
+// CheckTx validates the raw tx against the ledger rules before it is signed.
+// Size and fee of the tx are checked as if it was signed by witnessCount vkey witnesses,
+// so witnessCount should be the maximal number of signatures which can be attached to the tx
+func (l TxLimits) CheckTx(txRaw []byte, witnessCount int) error {
+	var (
+		outputs []cbor.RawMessage
+		fee     uint64
+	)
+
+	tx, err := parseTx(txRaw, witnessCount)
+	if err != nil {
+		return err
+	}
+
+	if err := cbor.Unmarshal(tx.body[txBodyOutputsKey], &outputs); err != nil {
+		return fmt.Errorf("invalid tx outputs: %w", err)
+	}
+
+	if err := cbor.Unmarshal(tx.body[txBodyFeeKey], &fee); err != nil {
+		return fmt.Errorf("invalid tx fee: %w", err)
+	}
+
+	if tx.size > l.MaxTxSize {
+		return fmt.Errorf("%w: (size, max) = (%d, %d)", ErrTxSizeTooBig, tx.size, l.MaxTxSize)
+	}
+
+	executionUnits, err := getRedeemersExecutionUnits(tx.witnessSet[txRedeemersKey])
+	if err != nil {
+		return err
+	}
+
+	if executionUnits.Memory > l.MaxTxExecutionUnits.Memory || executionUnits.Steps > l.MaxTxExecutionUnits.Steps {
+		return fmt.Errorf("tx execution units exceeded: (%d, %d) vs (%d, %d)",
+			executionUnits.Memory, executionUnits.Steps, l.MaxTxExecutionUnits.Memory, l.MaxTxExecutionUnits.Steps)
+	}
+
+	if minFee := l.GetMinFee(tx.size, executionUnits); fee < minFee {
+		return fmt.Errorf("tx fee is less than minimal fee: %d vs %d", fee, minFee)
+	}
+
+	for i, output := range outputs {
+		amount, valueSize, err := getOutputAmountAndValueSize(output)
+		if err != nil {
+			return fmt.Errorf("invalid tx output %d: %w", i, err)
+		}
+
+		if minUtxo := l.getMinUtxo(uint64(len(output))); amount < minUtxo {
+			return fmt.Errorf("tx output %d amount is less than minimal utxo amount: %d vs %d", i, amount, minUtxo)
+		}
+
+		if l.MaxValueSize > 0 && valueSize > l.MaxValueSize {
+			return fmt.Errorf("tx output %d value size exceeded: %d vs %d", i, valueSize, l.MaxValueSize)
+		}
+	}
+
+	return nil
+}
+
+type parsedTx struct {
+	body       map[uint64]cbor.RawMessage
+	witnessSet map[uint64]cbor.RawMessage
+	size       uint64
+}
+
+// parseTx returns the body and the witness set of the raw tx and the size which the ledger counts for the tx
+// signed by witnessCount vkey witnesses. The ledger does not count the isValid flag
+func parseTx(txRaw []byte, witnessCount int) (*parsedTx, error) {
+	var (
+		tx     []cbor.RawMessage
+		result parsedTx
+	)
+
+	if err := cbor.Unmarshal(txRaw, &tx); err != nil {
+		return nil, fmt.Errorf("invalid tx: %w", err)
+	} else if len(tx) < 2 {
+		return nil, errors.New("invalid tx: witness set not found")
+	}
+
+	if err := cbor.Unmarshal(tx[0], &result.body); err != nil {
+		return nil, fmt.Errorf("invalid tx body: %w", err)
+	}
+
+	if err := cbor.Unmarshal(tx[1], &result.witnessSet); err != nil {
+		return nil, fmt.Errorf("invalid tx witness set: %w", err)
+	}
+
+	result.size = uint64(len(txRaw))
+	if len(tx) == 4 {
+		result.size -= uint64(len(tx[2]))
+	}
+
+	if _, exists := result.witnessSet[txWitnessVKeysKey]; !exists && witnessCount > 0 {
+		result.size += 1 + getCborHeaderSize(uint64(witnessCount)) + uint64(witnessCount)*vkeyWitnessSize
+	}
+
+	return &result, nil
+}
+
+func (l TxLimits) getMinUtxo(outputSize uint64) uint64 {
+	return (minUtxoEntryOverhead + outputSize) * l.CoinsPerUTxOByte
+}
+
+// getOutputAmountAndValueSize returns lovelace amount and size of the value of the legacy or the post alonzo output
+func getOutputAmountAndValueSize(output cbor.RawMessage) (uint64, uint64, error) {
+	var value cbor.RawMessage
+
+	if len(output) > 0 && output[0]>>5 == 4 { // array
+		var legacyOutput []cbor.RawMessage
+
+		if err := cbor.Unmarshal(output, &legacyOutput); err != nil {
+			return 0, 0, fmt.Errorf("invalid legacy output: %w", err)
+		} else if len(legacyOutput) < 2 {
+			return 0, 0, errors.New("invalid legacy output: value not found")
+		}
+
+		value = legacyOutput[1]
+	} else {
+		var postAlonzoOutput map[uint64]cbor.RawMessage
+
+		if err := cbor.Unmarshal(output, &postAlonzoOutput); err != nil {
+			return 0, 0, fmt.Errorf("invalid output: %w", err)
+		}
+
+		value = postAlonzoOutput[1]
+	}
+
+	var amount uint64
+
+	if err := cbor.Unmarshal(value, &amount); err != nil {
+		var multiAssetValue []cbor.RawMessage
+
+		if err := cbor.Unmarshal(value, &multiAssetValue); err != nil {
+			return 0, 0, fmt.Errorf("invalid output value: %w", err)
+		} else if len(multiAssetValue) != 2 {
+			return 0, 0, errors.New("invalid output value: multi asset not found")
+		}
+
+		if err := cbor.Unmarshal(multiAssetValue[0], &amount); err != nil {
+			return 0, 0, fmt.Errorf("invalid output amount: %w", err)
+		}
+	}
+
+	return amount, uint64(len(value)), nil
+}
+
+// getRedeemersExecutionUnits returns the sum of execution units of the legacy array or the conway map of redeemers
+func getRedeemersExecutionUnits(redeemers cbor.RawMessage) (
+	result cardanowallet.ProtocolParametersMemorySteps, err error,
+) {
+	if len(redeemers) == 0 {
+		return result, nil
+	}
+
+	var exUnits [][]cbor.RawMessage
+
+	if redeemers[0]>>5 == 4 { // array of [tag, index, data, ex_units]
+		var legacyRedeemers [][]cbor.RawMessage
+
+		if err := cbor.Unmarshal(redeemers, &legacyRedeemers); err != nil {
+			return result, fmt.Errorf("invalid redeemers: %w", err)
+		}
+
+		for _, redeemer := range legacyRedeemers {
+			if len(redeemer) != 4 {
+				return result, errors.New("invalid redeemer")
+			}
+
+			exUnits = append(exUnits, redeemer[3:])
+		}
+	} else { // map of [tag, index] => [data, ex_units]
+		var mapRedeemers map[redeemerKey][]cbor.RawMessage
+
+		if err := cbor.Unmarshal(redeemers, &mapRedeemers); err != nil {
+			return result, fmt.Errorf("invalid redeemers: %w", err)
+		}
+
+		for _, redeemer := range mapRedeemers {
+			if len(redeemer) != 2 {
+				return result, errors.New("invalid redeemer")
+			}
+
+			exUnits = append(exUnits, redeemer[1:])
+		}
+	}
+
+	for _, units := range exUnits {
+		var memorySteps [2]uint64
+
+		if err := cbor.Unmarshal(units[0], &memorySteps); err != nil {
+			return result, fmt.Errorf("invalid redeemer execution units: %w", err)
+		}
+
+		result.Memory += memorySteps[0]
+		result.Steps += memorySteps[1]
+	}
+
+	return result, nil
+}
+
+func getCborHeaderSize(n uint64) uint64 {
+	switch {
+	case n < 24:
+		return 1
+	case n <= math.MaxUint8:
+		return 2
+	case n <= math.MaxUint16:
+		return 3
+	case n <= math.MaxUint32:
+		return 5
+	default:
+		return 9
+	}
+}
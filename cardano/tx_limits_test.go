@@ -0,0 +1,218 @@
+package cardanotx
+
+import (
+	"encoding/hex"
+	"testing"
+
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxLimits(t *testing.T) {
+	const (
+		addr     = "addr_test1vqeux7xwusdju9dvsj8h7mca9aup2k439kfmwy773xxc2hcu7zy99"
+		policyID = "29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8"
+	)
+
+	protocolParams := []byte(`{
+		"maxTxSize": 16384,
+		"txFeeFixed": 155381,
+		"txFeePerByte": 44,
+		"utxoCostPerByte": 4310,
+		"maxValueSize": 5000,
+		"maxTxExecutionUnits": {"memory": 16000000, "steps": 10000000000},
+		"executionUnitPrices": {"priceMemory": 0.0577, "priceSteps": 0.0000721}
+	}`)
+
+	cardanoAddr, err := cardanowallet.NewCardanoAddressFromString(addr)
+	require.NoError(t, err)
+
+	policyIDBytes, err := hex.DecodeString(policyID)
+	require.NoError(t, err)
+
+	tokenValue := []any{
+		uint64(1_500_000),
+		map[cbor.ByteString]map[cbor.ByteString]uint64{
+			cbor.ByteString(policyIDBytes): {cbor.ByteString("Route3"): 5},
+		},
+	}
+
+	createTx := func(fee uint64, outputs []any, witnessSet map[uint64]any) []byte {
+		txRaw, err := cbor.Marshal([]any{
+			map[uint64]any{
+				0: [][]any{{make([]byte, 32), 0}},
+				1: outputs,
+				2: fee,
+			},
+			witnessSet,
+			true,
+			nil,
+		})
+		require.NoError(t, err)
+
+		return txRaw
+	}
+
+	outputs := []any{
+		[]any{cardanoAddr.GetBytes(), uint64(2_000_000)},
+		map[uint64]any{0: cardanoAddr.GetBytes(), 1: tokenValue},
+	}
+
+	t.Run("NewTxLimits", func(t *testing.T) {
+		_, err := NewTxLimits([]byte("{"))
+		require.ErrorContains(t, err, "failed to unmarshal protocol parameters")
+
+		_, err = NewTxLimits([]byte(`{"maxTxSize": 16384}`))
+		require.ErrorContains(t, err, "is not set in protocol parameters")
+
+		txLimits, err := NewTxLimits(protocolParams)
+		require.NoError(t, err)
+		require.Equal(t, &TxLimits{
+			MaxTxSize:           16384,
+			MinFeeA:             44,
+			MinFeeB:             155381,
+			CoinsPerUTxOByte:    4310,
+			MaxValueSize:        5000,
+			MaxTxExecutionUnits: cardanowallet.NewProtocolParametersMemorySteps(16_000_000, 10_000_000_000),
+			ExecutionUnitPrices: cardanowallet.NewProtocolParametersPriceMemorySteps(0.0577, 0.0000721),
+		}, txLimits)
+	})
+
+	txLimits, err := NewTxLimits(protocolParams)
+	require.NoError(t, err)
+
+	t.Run("GetMinFee", func(t *testing.T) {
+		require.Equal(t, uint64(44*300+155381), txLimits.GetMinFee(300, cardanowallet.ProtocolParametersMemorySteps{}))
+		require.Equal(t, uint64(44*300+155381+5770+721), txLimits.GetMinFee(
+			300, cardanowallet.NewProtocolParametersMemorySteps(100_000, 10_000_000)))
+	})
+
+	t.Run("GetMinUtxo", func(t *testing.T) {
+		_, err := txLimits.GetMinUtxo(cardanowallet.NewTxOutput("invalid", 1))
+		require.ErrorContains(t, err, "invalid output address")
+
+		// array header + address bytes + amount
+		minUtxo, err := txLimits.GetMinUtxo(cardanowallet.NewTxOutput(addr, 2_000_000))
+		require.NoError(t, err)
+		require.Equal(t, uint64((160+1+31+5)*4310), minUtxo)
+
+		token := cardanowallet.NewToken(policyID, "Route3")
+
+		minUtxoWithTokens, err := txLimits.GetMinUtxo(cardanowallet.NewTxOutput(
+			addr, 2_000_000, cardanowallet.NewTokenAmount(token, 5)))
+		require.NoError(t, err)
+		// [amount, {policy id: {name: amount}}]
+		require.Equal(t, minUtxo+(1+1+30+1+7+1)*4310, minUtxoWithTokens)
+	})
+
+	t.Run("RaiseToMinUtxo", func(t *testing.T) {
+		_, err := txLimits.RaiseToMinUtxo([]cardanowallet.TxOutput{cardanowallet.NewTxOutput("invalid", 1)})
+		require.ErrorContains(t, err, "invalid output address")
+
+		outputs := []cardanowallet.TxOutput{
+			cardanowallet.NewTxOutput(addr, 2_000_000),
+			cardanowallet.NewTxOutput(addr, 1),
+		}
+
+		raisedOutputs, err := txLimits.RaiseToMinUtxo(outputs)
+		require.NoError(t, err)
+		// the amount of the raised output is encoded with five bytes instead of one
+		require.Equal(t, []cardanowallet.TxOutput{
+			cardanowallet.NewTxOutput(addr, 2_000_000),
+			cardanowallet.NewTxOutput(addr, (160+1+31+5)*4310),
+		}, raisedOutputs)
+		require.Equal(t, uint64(1), outputs[1].Amount)
+	})
+
+	t.Run("GetTxMinFee", func(t *testing.T) {
+		txRaw := createTx(200_000, outputs, map[uint64]any{})
+
+		_, err := txLimits.GetTxMinFee([]byte{0x01}, 4)
+		require.ErrorContains(t, err, "invalid tx")
+
+		// the isValid flag is not counted
+		minFee, err := txLimits.GetTxMinFee(txRaw, 0)
+		require.NoError(t, err)
+		require.Equal(t, txLimits.GetMinFee(uint64(len(txRaw)-1), cardanowallet.ProtocolParametersMemorySteps{}), minFee)
+
+		minFee, err = txLimits.GetTxMinFee(txRaw, 4)
+		require.NoError(t, err)
+		require.Equal(t, txLimits.GetMinFee(uint64(len(txRaw)-1+1+1+4*vkeyWitnessSize),
+			cardanowallet.ProtocolParametersMemorySteps{}), minFee)
+		require.NoError(t, txLimits.CheckTx(createTx(minFee, outputs, map[uint64]any{}), 4))
+	})
+
+	t.Run("CheckTx valid", func(t *testing.T) {
+		txRaw := createTx(200_000, outputs, map[uint64]any{})
+
+		require.NoError(t, txLimits.CheckTx(txRaw, 4))
+	})
+
+	t.Run("CheckTx invalid tx", func(t *testing.T) {
+		require.ErrorContains(t, txLimits.CheckTx([]byte{0x01}, 4), "invalid tx")
+
+		txRaw, err := cbor.Marshal([]any{map[uint64]any{}})
+		require.NoError(t, err)
+		require.ErrorContains(t, txLimits.CheckTx(txRaw, 4), "witness set not found")
+	})
+
+	t.Run("CheckTx size too big", func(t *testing.T) {
+		txRaw := createTx(200_000, outputs, map[uint64]any{})
+
+		smallTxLimits := *txLimits
+		smallTxLimits.MaxTxSize = uint64(len(txRaw)) + 20
+
+		require.NoError(t, smallTxLimits.CheckTx(txRaw, 0))
+		require.ErrorIs(t, smallTxLimits.CheckTx(txRaw, 1), ErrTxSizeTooBig)
+	})
+
+	t.Run("CheckTx fee too low", func(t *testing.T) {
+		txRaw := createTx(170_000, outputs, map[uint64]any{})
+
+		require.NoError(t, txLimits.CheckTx(txRaw, 0))
+		require.ErrorContains(t, txLimits.CheckTx(txRaw, 4), "tx fee is less than minimal fee")
+	})
+
+	t.Run("CheckTx output less than minimal utxo", func(t *testing.T) {
+		txRaw := createTx(200_000, []any{
+			outputs[0],
+			map[uint64]any{0: cardanoAddr.GetBytes(), 1: []any{uint64(1_000_000), tokenValue[1]}},
+		}, map[uint64]any{})
+
+		require.ErrorContains(t, txLimits.CheckTx(txRaw, 4), "tx output 1 amount is less than minimal utxo amount")
+	})
+
+	t.Run("CheckTx value size exceeded", func(t *testing.T) {
+		txRaw := createTx(200_000, outputs, map[uint64]any{})
+
+		smallTxLimits := *txLimits
+		smallTxLimits.MaxValueSize = 20
+
+		require.ErrorContains(t, smallTxLimits.CheckTx(txRaw, 4), "tx output 1 value size exceeded")
+	})
+
+	t.Run("CheckTx execution units", func(t *testing.T) {
+		legacyRedeemers := []any{
+			[]any{0, 0, 1, []uint64{10_000_000, 6_000_000_000}},
+			[]any{0, 1, 1, []uint64{10_000_000, 1_000_000_000}},
+		}
+		mapRedeemers := map[redeemerKey][]any{
+			{Tag: 0, Index: 0}: {1, []uint64{10_000_000, 1_000_000_000}},
+			{Tag: 0, Index: 1}: {1, []uint64{1_000_000, 9_500_000_000}},
+		}
+
+		txRaw := createTx(2_000_000, outputs, map[uint64]any{txRedeemersKey: legacyRedeemers})
+		require.ErrorContains(t, txLimits.CheckTx(txRaw, 4), "tx execution units exceeded: (20000000, 7000000000)")
+
+		txRaw = createTx(2_000_000, outputs, map[uint64]any{txRedeemersKey: mapRedeemers})
+		require.ErrorContains(t, txLimits.CheckTx(txRaw, 4), "tx execution units exceeded: (11000000, 10500000000)")
+
+		txRaw = createTx(2_000_000, outputs, map[uint64]any{txRedeemersKey: legacyRedeemers[1:]})
+		require.NoError(t, txLimits.CheckTx(txRaw, 4))
+
+		// execution units are paid too
+		txRaw = createTx(300_000, outputs, map[uint64]any{txRedeemersKey: legacyRedeemers[1:]})
+		require.ErrorContains(t, txLimits.CheckTx(txRaw, 4), "tx fee is less than minimal fee")
+	})
+}
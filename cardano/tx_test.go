@@ -493,3 +493,51 @@ func TestCreateTx(t *testing.T) {
 		assert.False(t, isInOutputs(info.Outputs, feeAddr))
 	})
 }
+
+func TestGetChangeTokens(t *testing.T) {
+	token1 := wallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route3")
+	token2 := wallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route4")
+	multisig := &TxInputInfo{
+		TxInputs: wallet.TxInputs{
+			Sum: map[string]uint64{
+				wallet.AdaTokenName: 100,
+				token1.String():     50,
+				token2.String():     20,
+			},
+		},
+	}
+
+	t.Run("not enough tokens", func(t *testing.T) {
+		outputs := []wallet.TxOutput{
+			{Addr: "addr1", Amount: 10, Tokens: []wallet.TokenAmount{wallet.NewTokenAmount(token1, 51)}},
+		}
+
+		_, err := getChangeTokens(multisig, outputs, wallet.GetOutputsSum(outputs), -1)
+		require.ErrorContains(t, err, "not enough")
+	})
+
+	t.Run("all tokens spent", func(t *testing.T) {
+		outputs := []wallet.TxOutput{
+			{Addr: "addr1", Amount: 10, Tokens: []wallet.TokenAmount{
+				wallet.NewTokenAmount(token1, 50), wallet.NewTokenAmount(token2, 20),
+			}},
+		}
+
+		tokens, err := getChangeTokens(multisig, outputs, wallet.GetOutputsSum(outputs), -1)
+		require.NoError(t, err)
+		require.Nil(t, tokens)
+	})
+
+	t.Run("change", func(t *testing.T) {
+		outputs := []wallet.TxOutput{
+			{Addr: "addr1", Amount: 10, Tokens: []wallet.TokenAmount{wallet.NewTokenAmount(token1, 30)}},
+			{Addr: "multisig", Amount: 10, Tokens: []wallet.TokenAmount{wallet.NewTokenAmount(token1, 5)}},
+		}
+
+		tokens, err := getChangeTokens(multisig, outputs, wallet.GetOutputsSum(outputs), 1)
+		require.NoError(t, err)
+		require.Equal(t, []wallet.TokenAmount{
+			wallet.NewTokenAmount(token1, 20), wallet.NewTokenAmount(token2, 20),
+		}, tokens)
+	})
+}
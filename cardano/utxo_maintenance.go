@@ -0,0 +1,65 @@
+package cardanotx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UtxoBucket is a range of multisig utxo amounts starting at MinAmount and ending at MinAmount of the next bucket
+type UtxoBucket struct {
+	MinAmount   uint64 `json:"minAmount"`
+	TargetCount uint   `json:"targetCount"`
+}
+
+// UtxoMaintenanceConfig keeps the multisig and fee utxos in shape while regular batches are created.
+// While the multisig has more than ConsolidationThreshold utxos, every batch spends up to
+// ConsolidationUtxoCount additional smallest utxos. The change of a batch is split into utxos
+// of the buckets which hold less than TargetCount utxos. While the fee address has more than
+// FeeConsolidationThreshold utxos, the largest fee utxo pays the fee and the rest of the spent
+// fee utxos are the smallest ones.
+// When the bridge contract grants a batch id to a chain without confirmed transactions, the batch id is used
+// for a maintenance batch which consolidates the smallest utxos and splits the largest one into the buckets.
+// Every batcher decides from the same utxos whether the maintenance batch is needed
+type UtxoMaintenanceConfig struct {
+	ConsolidationThreshold    uint         `json:"consolidationThreshold,omitempty"`
+	ConsolidationUtxoCount    uint         `json:"consolidationUtxoCount,omitempty"`
+	Buckets                   []UtxoBucket `json:"buckets,omitempty"`
+	FeeConsolidationThreshold uint         `json:"feeConsolidationThreshold,omitempty"`
+}
+
+func (c UtxoMaintenanceConfig) Validate(minUtxoAmount uint64, maxFeeUtxoCount uint) error {
+	if c.ConsolidationThreshold > 0 && c.ConsolidationUtxoCount == 0 {
+		return errors.New("consolidation utxo count must be greater than zero")
+	}
+
+	if c.FeeConsolidationThreshold > 0 && maxFeeUtxoCount < 2 {
+		return errors.New("fee consolidation requires at least two fee utxos per batch")
+	}
+
+	for i, bucket := range c.Buckets {
+		if bucket.TargetCount == 0 {
+			return fmt.Errorf("target count of utxo bucket %d must be greater than zero", bucket.MinAmount)
+		}
+
+		if bucket.MinAmount < minUtxoAmount {
+			return fmt.Errorf("utxo bucket %d is less than minimal utxo amount %d", bucket.MinAmount, minUtxoAmount)
+		}
+
+		if i > 0 && bucket.MinAmount <= c.Buckets[i-1].MinAmount {
+			return errors.New("utxo buckets must be sorted by their amounts")
+		}
+	}
+
+	return nil
+}
+
+// GetBucketIndex returns the index of the bucket which the amount belongs to or -1 if it is below all the buckets
+func (c UtxoMaintenanceConfig) GetBucketIndex(amount uint64) int {
+	for i := len(c.Buckets) - 1; i >= 0; i-- {
+		if amount >= c.Buckets[i].MinAmount {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,28 @@
+package cardanotx
+
+import "fmt"
+
+// UtxoSelectionStrategy defines how the batcher chooses the multisig utxos of a batch
+type UtxoSelectionStrategy string
+
+const (
+	// UtxoSelectionOldestFirst takes the oldest utxos and replaces the smallest ones once the utxo limit is reached
+	UtxoSelectionOldestFirst UtxoSelectionStrategy = "oldestFirst"
+	// UtxoSelectionLargestFirst takes the largest utxos and fills the rest of the inputs with the smallest ones
+	UtxoSelectionLargestFirst UtxoSelectionStrategy = "largestFirst"
+	// UtxoSelectionRandomImprove takes random utxos and improves the change towards the desired amount (CIP-2)
+	UtxoSelectionRandomImprove UtxoSelectionStrategy = "randomImprove"
+	// UtxoSelectionBranchAndBound searches for utxos which match the desired amount exactly, so no change is needed
+	UtxoSelectionBranchAndBound UtxoSelectionStrategy = "branchAndBound"
+)
+
+// Validate returns an error for an unknown strategy. An empty strategy is the oldest first strategy
+func (s UtxoSelectionStrategy) Validate() error {
+	switch s {
+	case "", UtxoSelectionOldestFirst, UtxoSelectionLargestFirst,
+		UtxoSelectionRandomImprove, UtxoSelectionBranchAndBound:
+		return nil
+	default:
+		return fmt.Errorf("unknown utxo selection strategy: %s", s)
+	}
+}
@@ -15,6 +15,11 @@ var (
 	validatorsDataParamsData           = &validatorsDataParams{}
 	bridgingAddressesBalancesData      = &bridgingAddressesBalancesParams{}
 	setValidatorChange                 = &setValidatorChangeParams{}
+	requeueParamsData                  = &oracleTxActionParams{action: "Requeue"}
+	invalidateParamsData               = &oracleTxActionParams{action: "Invalidate", isReasonRequired: true}
+	forceRefundParamsData              = &oracleTxActionParams{action: "ForceRefund"}
+	replayParamsData                   = &oracleTxActionParams{action: "ReplayDeadLetter"}
+	approveParamsData                  = &oracleTxActionParams{action: "Approve", isApproval: true}
 )
 
 func GetBridgeAdminCommand() *cobra.Command {
@@ -91,6 +96,46 @@ func GetBridgeAdminCommand() *cobra.Command {
 		},
 		Run: common.GetCliRunCommand(setValidatorChange),
 	}
+	requeueCmd := &cobra.Command{
+		Use:   "requeue",
+		Short: "move a stuck oracle tx back to the unprocessed txs with its try counts reset",
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return requeueParamsData.ValidateFlags()
+		},
+		Run: common.GetCliRunCommand(requeueParamsData),
+	}
+	invalidateCmd := &cobra.Command{
+		Use:   "invalidate",
+		Short: "mark a stuck oracle tx as invalid",
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return invalidateParamsData.ValidateFlags()
+		},
+		Run: common.GetCliRunCommand(invalidateParamsData),
+	}
+	forceRefundCmd := &cobra.Command{
+		Use:   "force-refund",
+		Short: "hand a stuck bridging request over to the refund processor",
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return forceRefundParamsData.ValidateFlags()
+		},
+		Run: common.GetCliRunCommand(forceRefundParamsData),
+	}
+	replayCmd := &cobra.Command{
+		Use:   "replay-dead-letter",
+		Short: "move a dead-letter tx back to the unprocessed txs with its try counts reset",
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return replayParamsData.ValidateFlags()
+		},
+		Run: common.GetCliRunCommand(replayParamsData),
+	}
+	approveCmd := &cobra.Command{
+		Use:   "approve",
+		Short: "approve a bridging request held for manual approval",
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return approveParamsData.ValidateFlags()
+		},
+		Run: common.GetCliRunCommand(approveParamsData),
+	}
 
 	getChainTokenQuantityParamsData.RegisterFlags(getChainTokenQuantityCmd)
 	updateChainTokenQuantityParamsData.RegisterFlags(updateChainTokenQuantityCmd)
@@ -101,6 +146,11 @@ func GetBridgeAdminCommand() *cobra.Command {
 	validatorsDataParamsData.RegisterFlags(validatorDataCmd)
 	bridgingAddressesBalancesData.RegisterFlags(bridgingAddressesBalancesCmd)
 	setValidatorChange.RegisterFlags(setValidatorChangeCmd)
+	requeueParamsData.RegisterFlags(requeueCmd)
+	invalidateParamsData.RegisterFlags(invalidateCmd)
+	forceRefundParamsData.RegisterFlags(forceRefundCmd)
+	replayParamsData.RegisterFlags(replayCmd)
+	approveParamsData.RegisterFlags(approveCmd)
 
 	cmd := &cobra.Command{
 		Use:   "bridge-admin",
@@ -117,6 +167,11 @@ func GetBridgeAdminCommand() *cobra.Command {
 		validatorDataCmd,
 		bridgingAddressesBalancesCmd,
 		setValidatorChangeCmd,
+		requeueCmd,
+		invalidateCmd,
+		forceRefundCmd,
+		replayCmd,
+		approveCmd,
 	)
 
 	return cmd
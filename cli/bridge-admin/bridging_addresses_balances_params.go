@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	ethtxhelper "github.com/Ethernal-Tech/apex-bridge/eth/txhelper"
@@ -13,45 +14,45 @@ import (
 	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
 	indexerDb "github.com/Ethernal-Tech/cardano-infrastructure/indexer/db"
 	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 )
 
 const (
-	primeWalletAddressFlag  = "prime-wallet-addr"
-	vectorWalletAddressFlag = "vector-wallet-addr"
-	nexusWalletAddressFlag  = "nexus-wallet-addr"
-	indexerDbsPathFlag      = "indexer-dbs-path"
-
-	primeWalletAddressFlagDesc  = "prime hot wallet/bridging/multisig address"
-	vectorWalletAddressFlagDesc = "vector hot wallet/bridging/multisig address"
-	nexusWalletAddressFlagDesc  = "nexus NativeTokenWallet Proxy sc address"
-	indexerDbsPathFlagDesc      = "path to the indexer database"
+	walletAddressFlag  = "wallet-addr"
+	indexerDbsPathFlag = "indexer-dbs-path"
+
+	walletAddressFlagDesc = "chainID:address pair of the hot wallet/bridging/multisig address " +
+		"(or NativeTokenWallet Proxy sc address for evm chains). one is required for each chain from the config"
+	indexerDbsPathFlagDesc = "path to the indexer database"
 )
 
 type bridgingAddressesBalancesParams struct {
-	config              string
-	primeWalletAddress  string
-	vectorWalletAddress string
-	nexusWalletAddress  string
-	indexerDbsPath      string
+	config         string
+	walletAddrs    []string
+	indexerDbsPath string
+
+	chainWalletAddr map[string]string
 }
 
 func (b *bridgingAddressesBalancesParams) ValidateFlags() error {
-	if b.primeWalletAddress == "" || b.vectorWalletAddress == "" || b.nexusWalletAddress == "" {
-		return fmt.Errorf("all wallet addresses --%s, --%s and --%s must be set",
-			primeWalletAddressFlag, vectorWalletAddressFlag, nexusWalletAddressFlag)
+	if len(b.walletAddrs) == 0 {
+		return fmt.Errorf("--%s flag not specified", walletAddressFlag)
 	}
 
-	if !common.IsValidAddress(common.ChainIDStrPrime, b.primeWalletAddress) {
-		return fmt.Errorf("invalid address: --%s", primeWalletAddressFlag)
-	}
+	b.chainWalletAddr = make(map[string]string, len(b.walletAddrs))
 
-	if !common.IsValidAddress(common.ChainIDStrVector, b.vectorWalletAddress) {
-		return fmt.Errorf("invalid address: --%s", vectorWalletAddressFlag)
-	}
+	for _, x := range b.walletAddrs {
+		chainID, addr, found := strings.Cut(x, ":")
+		if !found || chainID == "" || addr == "" {
+			return fmt.Errorf("invalid --%s flag: %s", walletAddressFlag, x)
+		}
 
-	if !common.IsValidAddress(common.ChainIDStrNexus, b.nexusWalletAddress) {
-		return fmt.Errorf("invalid address: --%s", nexusWalletAddressFlag)
+		if _, exists := b.chainWalletAddr[chainID]; exists {
+			return fmt.Errorf("invalid --%s flag: duplicated chain %s", walletAddressFlag, chainID)
+		}
+
+		b.chainWalletAddr[chainID] = addr
 	}
 
 	if b.config == "" {
@@ -86,23 +87,11 @@ func (b *bridgingAddressesBalancesParams) RegisterFlags(cmd *cobra.Command) {
 		"",
 		configFlagDesc,
 	)
-	cmd.Flags().StringVar(
-		&b.primeWalletAddress,
-		primeWalletAddressFlag,
-		"",
-		primeWalletAddressFlagDesc,
-	)
-	cmd.Flags().StringVar(
-		&b.vectorWalletAddress,
-		vectorWalletAddressFlag,
-		"",
-		vectorWalletAddressFlagDesc,
-	)
-	cmd.Flags().StringVar(
-		&b.nexusWalletAddress,
-		nexusWalletAddressFlag,
-		"",
-		nexusWalletAddressFlagDesc,
+	cmd.Flags().StringSliceVar(
+		&b.walletAddrs,
+		walletAddressFlag,
+		nil,
+		walletAddressFlagDesc,
 	)
 	cmd.Flags().StringVar(
 		&b.indexerDbsPath,
@@ -118,10 +107,13 @@ func (b *bridgingAddressesBalancesParams) Execute(outputter common.OutputFormatt
 		return nil, err
 	}
 
-	chainWalletAddr := map[string]string{
-		common.ChainIDStrPrime:  b.primeWalletAddress,
-		common.ChainIDStrVector: b.vectorWalletAddress,
-		common.ChainIDStrNexus:  b.nexusWalletAddress,
+	if err := appConfig.RegisterChains(); err != nil {
+		return nil, err
+	}
+
+	chainWalletAddr, err := b.getChainWalletAddresses(appConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	multisigUtxos := make(map[string][]cardanowallet.Utxo)
@@ -220,7 +212,7 @@ func (b *bridgingAddressesBalancesParams) Execute(outputter common.OutputFormatt
 			return nil, err
 		}
 
-		address := common.HexToAddress(b.nexusWalletAddress)
+		address := common.HexToAddress(chainWalletAddr[chainID])
 
 		balance, err := ethHelper.GetClient().BalanceAt(context.Background(), address, nil)
 		if err != nil {
@@ -228,7 +220,7 @@ func (b *bridgingAddressesBalancesParams) Execute(outputter common.OutputFormatt
 		}
 
 		_, _ = outputter.Write([]byte(fmt.Sprintf("Balances on %s chain: \n", chainID)))
-		_, _ = outputter.Write([]byte(fmt.Sprintf("Bridging Address = %s\n", b.nexusWalletAddress)))
+		_, _ = outputter.Write([]byte(fmt.Sprintf("Bridging Address = %s\n", chainWalletAddr[chainID])))
 		_, _ = outputter.Write([]byte(fmt.Sprintf("Balance =  %d\n", balance)))
 		outputter.WriteOutput()
 	}
@@ -236,6 +228,43 @@ func (b *bridgingAddressesBalancesParams) Execute(outputter common.OutputFormatt
 	return nil, nil
 }
 
+// getChainWalletAddresses checks that an address of the right type is provided for every configured chain
+func (b *bridgingAddressesBalancesParams) getChainWalletAddresses(
+	appConfig *vcCore.AppConfig,
+) (map[string]string, error) {
+	getAddr := func(chainID string, isValid func(string) bool) error {
+		addr, exists := b.chainWalletAddr[chainID]
+		if !exists {
+			return fmt.Errorf("--%s flag not specified for chain %s", walletAddressFlag, chainID)
+		}
+
+		if !isValid(addr) {
+			return fmt.Errorf("invalid --%s flag: invalid address for chain %s", walletAddressFlag, chainID)
+		}
+
+		return nil
+	}
+
+	for chainID := range appConfig.CardanoChains {
+		err := getAddr(chainID, func(addr string) bool {
+			cardanoAddr, err := cardanowallet.NewCardanoAddressFromString(addr)
+
+			return err == nil && cardanoAddr.GetInfo().AddressType != cardanowallet.RewardAddress
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for chainID := range appConfig.EthChains {
+		if err := getAddr(chainID, ethcommon.IsHexAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.chainWalletAddr, nil
+}
+
 var (
 	_ common.CliCommandExecutor = (*bridgingAddressesBalancesParams)(nil)
 )
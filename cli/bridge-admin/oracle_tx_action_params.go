@@ -0,0 +1,176 @@
+package clibridgeadmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/request"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/response"
+	"github.com/spf13/cobra"
+)
+
+const (
+	apiURLFlag       = "api-url"
+	apiKeyFlag       = "api-key"
+	apiKeyHeaderFlag = "api-key-header"
+	txHashFlag       = "tx"
+	reasonFlag       = "reason"
+
+	apiURLFlagDesc       = "validator components api url including the path prefix (e.g. http://localhost:10000/api)"
+	apiKeyFlagDesc       = "admin api key of the operator for the validator components api"
+	apiKeyHeaderFlagDesc = "header in which the api key is sent"
+	txChainIDFlagDesc    = "source chain ID of the tx"
+	txHashFlagDesc       = "hash of the tx"
+	reasonFlagDesc       = "reason for the action, written to the audit log and the bridging request state"
+
+	defaultAPIKeyHeader = "x-api-key"
+	requestTimeout      = time.Second * 30
+)
+
+type oracleTxActionParams struct {
+	action           string
+	isReasonRequired bool
+	// the approve action responds with the manual approval instead of the tx
+	isApproval bool
+
+	apiURL       string
+	apiKey       string
+	apiKeyHeader string
+	chainID      string
+	txHash       string
+	reason       string
+}
+
+func (p *oracleTxActionParams) ValidateFlags() error {
+	if !common.IsValidHTTPURL(p.apiURL) {
+		return fmt.Errorf("invalid --%s flag", apiURLFlag)
+	}
+
+	if p.apiKey == "" {
+		return fmt.Errorf("--%s flag not specified", apiKeyFlag)
+	}
+
+	if p.chainID == "" {
+		return fmt.Errorf("--%s flag not specified", chainIDFlag)
+	}
+
+	if _, err := common.DecodeHex(p.txHash); err != nil || p.txHash == "" {
+		return fmt.Errorf("invalid --%s flag", txHashFlag)
+	}
+
+	if p.isReasonRequired && p.reason == "" {
+		return fmt.Errorf("--%s flag not specified", reasonFlag)
+	}
+
+	return nil
+}
+
+func (p *oracleTxActionParams) Execute(_ common.OutputFormatter) (common.ICommandResult, error) {
+	body, err := json.Marshal(request.OracleTxAdminActionRequest{
+		ChainID: p.chainID,
+		TxHash:  p.txHash,
+		Reason:  p.reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("%s/OracleAdmin/%s", strings.TrimSuffix(p.apiURL, "/"), p.action)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(p.apiKeyHeader, p.apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse response.ErrorResponse
+
+		if err := json.NewDecoder(resp.Body).Decode(&errResponse); err != nil || errResponse.Err == "" {
+			return nil, fmt.Errorf("request failed with status %s", resp.Status)
+		}
+
+		return nil, errors.New(errResponse.Err)
+	}
+
+	if p.isApproval {
+		var approvalResponse response.ManualApprovalResponse
+
+		if err := json.NewDecoder(resp.Body).Decode(&approvalResponse); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		return &manualApprovalResult{approval: &approvalResponse}, nil
+	}
+
+	var txResponse response.OracleTxResponse
+
+	if err := json.NewDecoder(resp.Body).Decode(&txResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &oracleTxResult{tx: &txResponse}, nil
+}
+
+func (p *oracleTxActionParams) RegisterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&p.apiURL,
+		apiURLFlag,
+		"",
+		apiURLFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&p.apiKey,
+		apiKeyFlag,
+		"",
+		apiKeyFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&p.apiKeyHeader,
+		apiKeyHeaderFlag,
+		defaultAPIKeyHeader,
+		apiKeyHeaderFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&p.chainID,
+		chainIDFlag,
+		"",
+		txChainIDFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&p.txHash,
+		txHashFlag,
+		"",
+		txHashFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&p.reason,
+		reasonFlag,
+		"",
+		reasonFlagDesc,
+	)
+}
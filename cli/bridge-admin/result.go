@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/response"
 )
 
 type chainTokenQuantity struct {
@@ -41,3 +43,48 @@ func (r successResult) GetOutput() string {
 
 	return buffer.String()
 }
+
+type oracleTxResult struct {
+	tx *response.OracleTxResponse
+}
+
+func (r oracleTxResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(common.FormatKV([]string{
+		fmt.Sprintf("Chain ID|%s", r.tx.ChainID),
+		fmt.Sprintf("Tx Hash|%s", r.tx.Hash),
+		fmt.Sprintf("Submit Try Count|%d", r.tx.SubmitTryCount),
+		fmt.Sprintf("Batch Try Count|%d", r.tx.BatchTryCount),
+		fmt.Sprintf("Refund Try Count|%d", r.tx.RefundTryCount),
+		fmt.Sprintf("Invalid|%t", r.tx.IsInvalid),
+		fmt.Sprintf("Processed|%t", r.tx.IsProcessed),
+	}))
+
+	return buffer.String()
+}
+
+type manualApprovalResult struct {
+	approval *response.ManualApprovalResponse
+}
+
+func (r manualApprovalResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	operators := make([]string, len(r.approval.Approvals))
+	for i, x := range r.approval.Approvals {
+		operators[i] = x.Operator
+	}
+
+	buffer.WriteString(common.FormatKV([]string{
+		fmt.Sprintf("Chain ID|%s", r.approval.SourceChainID),
+		fmt.Sprintf("Tx Hash|%s", r.approval.TxHash),
+		fmt.Sprintf("Destination Chain ID|%s", r.approval.DestinationChainID),
+		fmt.Sprintf("Amount|%s", r.approval.Amount),
+		fmt.Sprintf("Held At Block|%d", r.approval.HeldAtBlock),
+		fmt.Sprintf("Deadline Block|%d", r.approval.DeadlineBlock),
+		fmt.Sprintf("Approved By|%s", strings.Join(operators, ", ")),
+	}))
+
+	return buffer.String()
+}
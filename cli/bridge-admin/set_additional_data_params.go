@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	ethtxhelper "github.com/Ethernal-Tech/apex-bridge/eth/txhelper"
 	infracommon "github.com/Ethernal-Tech/cardano-infrastructure/common"
@@ -22,6 +23,9 @@ const (
 	bridgeSCAddrFlagDesc = "bridge smart contract address"
 	bridgingAddrFlagDesc = "bridging address string"
 	feeAddrFlagDesc      = "fee address string"
+
+	legacyContractsABIFlag     = "legacy-contracts-abi"
+	legacyContractsABIFlagDesc = "bind the contract deployed before the native tokens release with its legacy ABI"
 )
 
 type setAdditionalDataParams struct {
@@ -32,6 +36,8 @@ type setAdditionalDataParams struct {
 	privateKeyConfig string
 	bridgingAddr     string
 	feeAddr          string
+
+	legacyContractsABI bool
 }
 
 func (ip *setAdditionalDataParams) ValidateFlags() error {
@@ -118,6 +124,13 @@ func (ip *setAdditionalDataParams) RegisterFlags(cmd *cobra.Command) {
 		feeAddrFlagDesc,
 	)
 
+	cmd.Flags().BoolVar(
+		&ip.legacyContractsABI,
+		legacyContractsABIFlag,
+		false,
+		legacyContractsABIFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(privateKeyConfigFlag, bridgePrivateKeyFlag)
 }
 
@@ -137,7 +150,8 @@ func (ip *setAdditionalDataParams) Execute(
 		ethtxhelper.WithInitClientAndChainIDFn(ctx),
 		ethtxhelper.WithNonceStrategyType(ethtxhelper.NonceInMemoryStrategy),
 		ethtxhelper.WithDynamicTx(false))
-	smartContract := eth.NewBridgeSmartContract(ip.bridgeSCAddr, txHelperWrapper)
+	smartContract := eth.NewBridgeSmartContract(
+		ip.bridgeSCAddr, txHelperWrapper, contractbinding.GetContractsABI(ip.legacyContractsABI))
 
 	_, _ = outputter.Write([]byte("Sending transactions..."))
 	outputter.WriteOutput()
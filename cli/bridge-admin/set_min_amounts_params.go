@@ -42,6 +42,8 @@ type setMinAmountsParams struct {
 
 	minFeeAmount      *big.Int
 	minBridgingAmount *big.Int
+
+	legacyContractsABI bool
 }
 
 func (ip *setMinAmountsParams) ValidateFlags() error {
@@ -128,6 +130,13 @@ func (ip *setMinAmountsParams) RegisterFlags(cmd *cobra.Command) {
 		minBridgingAmountFlagDesc,
 	)
 
+	cmd.Flags().BoolVar(
+		&ip.legacyContractsABI,
+		legacyContractsABIFlag,
+		false,
+		legacyContractsABIFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(privateKeyConfigFlag, evmPrivateKeyFlag)
 }
 
@@ -150,16 +159,18 @@ func (ip *setMinAmountsParams) Execute(outputter common.OutputFormatter) (common
 	}
 
 	contractAddress := common.HexToAddress(ip.contractAddress)
+	contractsABI := contractbinding.GetContractsABI(ip.legacyContractsABI)
 
 	transaction, err := infracommon.ExecuteWithRetry(ctx, func(ctx context.Context) (*types.Transaction, error) {
-		contract, err := contractbinding.NewGateway(
+		contract, err := contractbinding.NewGatewayWithABI(
 			contractAddress,
-			txHelper.GetClient())
+			txHelper.GetClient(),
+			contractsABI)
 		if err != nil {
 			return nil, fmt.Errorf("failed to connect to gateway smart contract: %w", err)
 		}
 
-		parsedABI, err := contractbinding.GatewayMetaData.GetAbi()
+		parsedABI, err := contractsABI.GatewayMetaData().GetAbi()
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse gateway smart contract abi: %w", err)
 		}
@@ -84,12 +84,14 @@ func (v *validatorsDataParams) Execute(outputter common.OutputFormatter) (common
 		return nil, err
 	}
 
-	contract, err := contractbinding.NewBridgeContract(common.HexToAddress(v.bridgeSCAddr), txHelper.GetClient())
+	config, err := common.LoadConfig[vcCore.AppConfig](v.config, "")
 	if err != nil {
 		return nil, err
 	}
 
-	config, err := common.LoadConfig[vcCore.AppConfig](v.config, "")
+	contract, err := contractbinding.NewBridgeContractWithABI(
+		common.HexToAddress(v.bridgeSCAddr), txHelper.GetClient(),
+		contractbinding.GetContractsABI(config.Bridge.LegacyContractsABI))
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +174,8 @@ func printChainValidatorsDataInfo(
 	for _, x := range data {
 		var formattedData string
 
-		switch chainID {
-		case common.ChainIDStrNexus:
+		switch {
+		case common.IsEVMChainID(chainID):
 			pub, err := bn256.UnmarshalPublicKeyFromBigInt(x.Key)
 			if err != nil {
 				return err
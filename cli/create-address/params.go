@@ -6,6 +6,7 @@ import (
 
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	ethtxhelper "github.com/Ethernal-Tech/apex-bridge/eth/txhelper"
 	"github.com/Ethernal-Tech/cardano-infrastructure/wallet"
@@ -32,6 +33,9 @@ const (
 	bridgePrivateKeyFlagDesc = "private key for bridge admin"
 	privateKeyConfigFlagDesc = "path to secrets manager config file"
 	showPolicyScrFlagDesc    = "show policy script"
+
+	legacyContractsABIFlag     = "legacy-contracts-abi"
+	legacyContractsABIFlagDesc = "bind the bridge contract deployed before the native tokens release with its legacy ABI"
 )
 
 type createAddressParams struct {
@@ -44,6 +48,8 @@ type createAddressParams struct {
 	bridgePrivateKey string
 	privateKeyConfig string
 	showPolicyScript bool
+
+	legacyContractsABI bool
 }
 
 func (ip *createAddressParams) validateFlags() error {
@@ -55,8 +61,8 @@ func (ip *createAddressParams) validateFlags() error {
 		return fmt.Errorf("invalid --%s flag", bridgeSCAddrFlag)
 	}
 
-	if !common.IsExistingChainID(ip.chainID) {
-		return fmt.Errorf("unexisting chain: %s", ip.chainID)
+	if !common.IsCardanoChainID(ip.chainID) {
+		return fmt.Errorf("invalid --%s flag: %s is not a cardano chain", chainIDFlag, ip.chainID)
 	}
 
 	return nil
@@ -94,7 +100,7 @@ func (ip *createAddressParams) setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(
 		&ip.chainID,
 		chainIDFlag,
-		common.ChainIDStrPrime,
+		"",
 		chainIDFlagDesc,
 	)
 
@@ -119,6 +125,13 @@ func (ip *createAddressParams) setFlags(cmd *cobra.Command) {
 		showPolicyScrFlagDesc,
 	)
 
+	cmd.Flags().BoolVar(
+		&ip.legacyContractsABI,
+		legacyContractsABIFlag,
+		false,
+		legacyContractsABIFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(privateKeyConfigFlag, bridgePrivateKeyFlag)
 }
 
@@ -130,7 +143,8 @@ func (ip *createAddressParams) Execute(
 		return nil, err
 	}
 
-	bridgeContract := eth.NewBridgeSmartContract(ip.bridgeSCAddr, txHelperBridge)
+	bridgeContract := eth.NewBridgeSmartContract(
+		ip.bridgeSCAddr, txHelperBridge, contractbinding.GetContractsABI(ip.legacyContractsABI))
 	cliBinary := wallet.ResolveCardanoCliBinary(wallet.CardanoNetworkType(ip.networkID))
 
 	validatorsData, err := bridgeContract.GetValidatorsChainData(ctx, ip.chainID)
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	ethcontracts "github.com/Ethernal-Tech/apex-bridge/eth/contracts"
 	ethtxhelper "github.com/Ethernal-Tech/apex-bridge/eth/txhelper"
@@ -46,7 +47,7 @@ const (
 	evmSCDirFlagDesc        = "the directory where the repository will be cloned, or the directory where the compiled evm smart contracts (JSON files) are located." //nolint:lll
 	evmPrivateKeyFlagDesc   = "private key for smart contract admin"
 	evmBlsKeyFlagDesc       = "bls key of the bridge validator. it can be used multiple times, but the order must be the same as on the bridge" //nolint:lll
-	evmChainIDFlagDesc      = "evm chain ID (prime, vector, etc)"
+	evmChainIDFlagDesc      = "evm chain ID (nexus, etc). mandatory if the bridge is used"
 	evmDynamicTxFlagDesc    = "dynamic tx"
 	evmCloneEvmRepoFlagDesc = "clone evm gateway repository and build smart contracts"
 	evmBranchNameFlagDesc   = "branch to use if the evm gateway repository is cloned"
@@ -61,7 +62,8 @@ const (
 	minFeeAmountFlagDesc      = "minimal fee amount"
 	minBridgingAmountFlagDesc = "minimal amount to bridge"
 
-	defaultEVMChainID = common.ChainIDStrNexus
+	legacyContractsABIFlag     = "legacy-contracts-abi"
+	legacyContractsABIFlagDesc = "bind the bridge contract deployed before the native tokens release with its legacy ABI"
 
 	evmGatewayRepositoryName  = "apex-evm-gateway"
 	evmGatewayRepositoryURL   = "https://github.com/Ethernal-Tech/" + evmGatewayRepositoryName
@@ -89,6 +91,8 @@ type deployEVMParams struct {
 	bridgeSCAddr     string
 	bridgePrivateKey string
 
+	legacyContractsABI bool
+
 	privateKeyConfig string
 
 	minFeeString            string
@@ -103,10 +107,6 @@ func (ip *deployEVMParams) validateFlags() error {
 		return fmt.Errorf("invalid --%s flag", evmNodeURLFlag)
 	}
 
-	if !common.IsExistingChainID(ip.evmChainID) {
-		return fmt.Errorf("unexisting chain: %s", ip.evmChainID)
-	}
-
 	if ip.evmPrivateKey == "" && ip.privateKeyConfig == "" {
 		return fmt.Errorf("specify at least one: --%s or --%s", evmPrivateKeyFlag, privateKeyConfigFlag)
 	}
@@ -119,6 +119,10 @@ func (ip *deployEVMParams) validateFlags() error {
 		if !ethcommon.IsHexAddress(ip.bridgeSCAddr) {
 			return fmt.Errorf("invalid --%s flag", bridgeSCAddrFlag)
 		}
+
+		if !common.IsEVMChainID(ip.evmChainID) {
+			return fmt.Errorf("invalid --%s flag: %s is not an evm chain", evmChainIDFlag, ip.evmChainID)
+		}
 	} else if len(ip.evmBlsKeys) == 0 {
 		return fmt.Errorf("bls keys not specified: --%s", evmBlsKeyFlag)
 	}
@@ -179,7 +183,7 @@ func (ip *deployEVMParams) setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(
 		&ip.evmChainID,
 		evmChainIDFlag,
-		defaultEVMChainID,
+		"",
 		evmChainIDFlagDesc,
 	)
 
@@ -247,6 +251,12 @@ func (ip *deployEVMParams) setFlags(cmd *cobra.Command) {
 		"",
 		privateKeyConfigFlagDesc,
 	)
+	cmd.Flags().BoolVar(
+		&ip.legacyContractsABI,
+		legacyContractsABIFlag,
+		false,
+		legacyContractsABIFlagDesc,
+	)
 
 	cmd.MarkFlagsMutuallyExclusive(bridgePrivateKeyFlag, privateKeyConfigFlag)
 	cmd.MarkFlagsMutuallyExclusive(evmPrivateKeyFlag, privateKeyConfigFlag)
@@ -413,7 +423,7 @@ func (ip *deployEVMParams) setChainAdditionalData(
 		return nil
 	}
 
-	sc := eth.NewBridgeSmartContract(ip.bridgeSCAddr, txHelper)
+	sc := eth.NewBridgeSmartContract(ip.bridgeSCAddr, txHelper, contractbinding.GetContractsABI(ip.legacyContractsABI))
 
 	_, _ = outputter.Write(fmt.Appendf(nil, "Configuring bridge smart contract at %s...", ip.bridgeSCAddr))
 	outputter.WriteOutput()
@@ -432,7 +442,9 @@ func (ip *deployEVMParams) getValidatorsChainData(
 		_, _ = outputter.Write(fmt.Appendf(nil, "Get data from bridge smart contract at %s...", ip.bridgeSCAddr))
 		outputter.WriteOutput()
 
-		return eth.NewBridgeSmartContract(ip.bridgeSCAddr, txHelper).GetValidatorsChainData(ctx, ip.evmChainID)
+		sc := eth.NewBridgeSmartContract(ip.bridgeSCAddr, txHelper, contractbinding.GetContractsABI(ip.legacyContractsABI))
+
+		return sc.GetValidatorsChainData(ctx, ip.evmChainID)
 	}
 
 	result := make([]eth.ValidatorChainData, len(ip.evmBlsKeys))
@@ -41,6 +41,10 @@ func (ip *setValidatorsChainDataEVMParams) validateFlags() error {
 		if !ethcommon.IsHexAddress(ip.bridgeSCAddr) {
 			return fmt.Errorf("invalid --%s flag", bridgeSCAddrFlag)
 		}
+
+		if !common.IsEVMChainID(ip.evmChainID) {
+			return fmt.Errorf("invalid --%s flag: %s is not an evm chain", evmChainIDFlag, ip.evmChainID)
+		}
 	} else if len(ip.evmBlsKeys) == 0 {
 		return fmt.Errorf("bls keys not specified: --%s", evmBlsKeyFlag)
 	}
@@ -98,7 +102,7 @@ func (ip *setValidatorsChainDataEVMParams) setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(
 		&ip.evmChainID,
 		evmChainIDFlag,
-		defaultEVMChainID,
+		"",
 		evmChainIDFlagDesc,
 	)
 
@@ -145,7 +145,7 @@ func (ip *upgradeEVMParams) Execute(
 			return nil, fmt.Errorf("invalid contract name for --%s number %d", contractFlag, i)
 		}
 
-		if !common.IsValidAddress(common.ChainIDStrNexus, ss[1]) {
+		if !ethcommon.IsHexAddress(ss[1]) {
 			return nil, fmt.Errorf("invalid address for --%s number %d", contractFlag, i)
 		}
 
@@ -31,6 +31,8 @@ const (
 	minFeeForBridgingFlag      = "min-fee-for-bridging"
 	blockConfirmationCountFlag = "block-confirmation-count"
 	allowedDirectionsFlag      = "allowed-directions"
+	utxoSelectionStrategyFlag  = "utxo-selection-strategy"
+	txBuilderBackendFlag       = "tx-builder-backend"
 
 	chainIDStringFlagDesc          = "(mandatory) chain id string for the chain config"
 	networkAddressFlagDesc         = "(mandatory) address of network"
@@ -47,6 +49,8 @@ const (
 	minFeeForBridgingFlagDesc      = "minimal bridging fee for the chain"
 	blockConfirmationCountFlagDesc = "block confirmation count for the chain"
 	allowedDirectionsFlagDesc      = "allowed bridging directions for the chain"
+	utxoSelectionStrategyFlagDesc  = "strategy used by the batcher to choose multisig utxos: oldestFirst, largestFirst, randomImprove or branchAndBound" //nolint:lll
+	txBuilderBackendFlagDesc       = "backend used to build, witness and assemble batch txs: cli or native. all the validators must use the same one"    //nolint:lll
 
 	defaultBlockConfirmationCount = 10
 	defaultTTLSlotNumberInc       = 1800 + defaultBlockConfirmationCount*10 // BlockTimeSeconds
@@ -72,6 +76,8 @@ type cardanoChainGenerateConfigsParams struct {
 	minFeeForBridging      uint64
 	blockConfirmationCount uint
 	allowedDirections      []string
+	utxoSelectionStrategy  string
+	txBuilderBackend       string
 
 	dbsPath string
 
@@ -111,6 +117,14 @@ func (p *cardanoChainGenerateConfigsParams) validateFlags() error {
 		}
 	}
 
+	if err := cardanotx.UtxoSelectionStrategy(p.utxoSelectionStrategy).Validate(); err != nil {
+		return fmt.Errorf("invalid %s: %w", utxoSelectionStrategyFlag, err)
+	}
+
+	if err := cardanotx.TxBuilderBackend(p.txBuilderBackend).Validate(); err != nil {
+		return fmt.Errorf("invalid %s: %w", txBuilderBackendFlag, err)
+	}
+
 	if p.minFeeForBridging < p.utxoMinAmount {
 		return fmt.Errorf("%s minimal fee for bridging: %d should't be less than minimal UTXO amount: %d",
 			p.chainIDString, p.minFeeForBridging, p.utxoMinAmount)
@@ -216,6 +230,18 @@ func (p *cardanoChainGenerateConfigsParams) setFlags(cmd *cobra.Command) {
 		nil,
 		allowedDirectionsFlagDesc,
 	)
+	cmd.Flags().StringVar(
+		&p.utxoSelectionStrategy,
+		utxoSelectionStrategyFlag,
+		string(cardanotx.UtxoSelectionOldestFirst),
+		utxoSelectionStrategyFlagDesc,
+	)
+	cmd.Flags().StringVar(
+		&p.txBuilderBackend,
+		txBuilderBackendFlag,
+		string(cardanotx.TxBuilderBackendCli),
+		txBuilderBackendFlagDesc,
+	)
 
 	cmd.Flags().StringVar(
 		&p.dbsPath,
@@ -278,13 +304,14 @@ func (p *cardanoChainGenerateConfigsParams) Execute(outputter common.OutputForma
 			BlockfrostURL:         p.blockfrostURL,
 			BlockfrostAPIKey:      p.blockfrostAPIKey,
 			SocketPath:            p.socketPath,
-			PotentialFee:          300000,
 			SlotRoundingThreshold: p.slotRoundingThreshold,
 			NoBatchPeriodPercent:  defaultNoBatchPeriodPercent,
 			UtxoMinAmount:         p.utxoMinAmount,
 			MaxFeeUtxoCount:       defaultMaxFeeUtxoCount,
 			MaxUtxoCount:          defaultMaxUtxoCount,
 			TakeAtLeastUtxoCount:  defaultTakeAtLeastUtxoCount,
+			UtxoSelectionStrategy: cardanotx.UtxoSelectionStrategy(p.utxoSelectionStrategy),
+			TxBuilderBackend:      cardanotx.TxBuilderBackend(p.txBuilderBackend),
 		},
 		NetworkAddress:           p.networkAddress,
 		StartBlockHash:           startingHash,
@@ -8,6 +8,7 @@ import (
 
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/eth"
 	oCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	rCore "github.com/Ethernal-Tech/apex-bridge/relayer/core"
 	vcCore "github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
@@ -20,7 +21,9 @@ const (
 	evmChainBlockRoundingThresholdFlag = "evm-block-rounding-threshold"
 	evmChainStartingBlockFlag          = "evm-starting-block"
 	evmChainMinFeeForBridgingFlag      = "evm-min-fee-for-bridging"
+	evmChainConfirmationModeFlag       = "evm-confirmation-mode"
 	evmRelayerGasFeeMultiplierFlag     = "evm-relayer-gas-fee-multiplier"
+	evmLegacyContractsABIFlag          = "evm-legacy-contracts-abi"
 
 	relayerDataDirFlag    = "relayer-data-dir"
 	relayerConfigPathFlag = "relayer-config"
@@ -30,7 +33,9 @@ const (
 	evmChainBlockRoundingThresholdFlagDesc = "defines the upper limit used for rounding block values for evm chain. Any block value between 0 and `blockRoundingThreshold` will be rounded to `blockRoundingThreshold` etc" //nolint:lll
 	evmChainStartingBlockFlagDesc          = "block from where to start evm chain oracle / evm chain block submitter"
 	evmChainMinFeeForBridgingFlagDesc      = "minimal bridging fee for evm chain"
+	evmChainConfirmationModeFlagDesc       = "defines when an evm chain block is confirmed: depth (fixed number of blocks), safe or finalized (block tags of the node)" //nolint:lll
 	evmRelayerGasFeeMultiplierFlagDesc     = "gas fee multiplier for evm relayer"
+	evmLegacyContractsABIFlagDesc          = "bind the gateway contract deployed before the native tokens release with its legacy ABI" //nolint:lll
 
 	relayerDataDirFlagDesc    = "path to relayer secret directory when using local secrets manager"
 	relayerConfigPathFlagDesc = "path to relayer secrets manager config file"
@@ -54,6 +59,8 @@ type evmChainGenerateConfigsParams struct {
 	evmChainBlockRoundingThreshold uint64
 	evmChainStartingBlock          uint64
 	evmChainMinFeeForBridging      uint64
+	evmChainConfirmationMode       string
+	evmLegacyContractsABI          bool
 
 	evmRelayerGasFeeMultiplier uint64
 	emptyBlocksThreshold       uint
@@ -78,6 +85,10 @@ func (p *evmChainGenerateConfigsParams) validateFlags() error {
 		return fmt.Errorf("invalid %s: %s", evmChainNodeURLFlag, p.evmChainNodeURL)
 	}
 
+	if err := eth.ConfirmationMode(p.evmChainConfirmationMode).Validate(); err != nil {
+		return fmt.Errorf("invalid %s: %w", evmChainConfirmationModeFlag, err)
+	}
+
 	if p.relayerDataDir == "" && p.relayerConfigPath == "" {
 		return fmt.Errorf("specify at least one of: %s, %s", relayerDataDirFlag, relayerConfigPathFlag)
 	}
@@ -122,6 +133,18 @@ func (p *evmChainGenerateConfigsParams) setFlags(cmd *cobra.Command) {
 		common.MinFeeForBridgingDefault,
 		evmChainMinFeeForBridgingFlagDesc,
 	)
+	cmd.Flags().StringVar(
+		&p.evmChainConfirmationMode,
+		evmChainConfirmationModeFlag,
+		string(eth.ConfirmationModeDepth),
+		evmChainConfirmationModeFlagDesc,
+	)
+	cmd.Flags().BoolVar(
+		&p.evmLegacyContractsABI,
+		evmLegacyContractsABIFlag,
+		false,
+		evmLegacyContractsABIFlagDesc,
+	)
 
 	cmd.Flags().Uint64Var(
 		&p.evmRelayerGasFeeMultiplier,
@@ -208,6 +231,7 @@ func (p *evmChainGenerateConfigsParams) Execute(outputter common.OutputFormatter
 		NodeURL:                 p.evmChainNodeURL,
 		SyncBatchSize:           defaultEvmSyncBatchSize,
 		NumBlockConfirmations:   defaultEvmBlockConfirmationCount,
+		ConfirmationMode:        eth.ConfirmationMode(p.evmChainConfirmationMode),
 		StartBlockNumber:        p.evmChainStartingBlock,
 		PoolIntervalMiliseconds: defaultEvmPoolIntervalMiliseconds,
 		TTLBlockNumberInc:       p.evmChainTTLBlockNumberInc,
@@ -217,6 +241,7 @@ func (p *evmChainGenerateConfigsParams) Execute(outputter common.OutputFormatter
 		MinFeeForBridging:       p.evmChainMinFeeForBridging,
 		RestartTrackerPullCheck: time.Second * 150,
 		FeeAddrBridgingAmount:   defaultEvmFeeAddrBridgingAmount,
+		LegacyContractsABI:      p.evmLegacyContractsABI,
 	}
 
 	if vcConfig.Bridge.SubmitConfig.EmptyBlocksThreshold == nil {
@@ -243,11 +268,12 @@ func (p *evmChainGenerateConfigsParams) Execute(outputter common.OutputFormatter
 	}
 
 	chainSpecificJSONRaw, err := json.Marshal(cardanotx.RelayerEVMChainConfig{
-		NodeURL:          p.evmChainNodeURL,
-		DataDir:          cleanPath(p.relayerDataDir),
-		ConfigPath:       cleanPath(p.relayerConfigPath),
-		DynamicTx:        true,
-		GasFeeMultiplier: p.evmRelayerGasFeeMultiplier,
+		NodeURL:            p.evmChainNodeURL,
+		DataDir:            cleanPath(p.relayerDataDir),
+		ConfigPath:         cleanPath(p.relayerConfigPath),
+		DynamicTx:          true,
+		GasFeeMultiplier:   p.evmRelayerGasFeeMultiplier,
+		LegacyContractsABI: p.evmLegacyContractsABI,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal chain specific config to json: %w", err)
@@ -33,6 +33,8 @@ const (
 	apiPortFlag = "api-port"
 	apiKeysFlag = "api-keys"
 
+	adminAPIKeysFlag = "admin-api-keys"
+
 	outputDirFlag                         = "output-dir"
 	outputValidatorComponentsFileNameFlag = "output-validator-components-file-name"
 	outputRelayerFileNameFlag             = "output-relayer-file-name"
@@ -41,6 +43,8 @@ const (
 
 	emptyBlocksThresholdFlag = "empty-blocks-threshold"
 
+	legacyContractsABIFlag = "legacy-contracts-abi"
+
 	bridgeNodeURLFlagDesc   = "(mandatory) node URL of bridge chain"
 	bridgeSCAddressFlagDesc = "(mandatory) bridging smart contract address on bridge chain"
 	adminSCAddressFlagDesc  = "(mandatory) admin smart contract address on bridge chain"
@@ -54,6 +58,8 @@ const (
 	apiPortFlagDesc = "port at which API should run"
 	apiKeysFlagDesc = "(mandatory) list of keys for API access"
 
+	adminAPIKeysFlagDesc = "list of operator:role:key keys for the admin API access. role is operator or admin"
+
 	outputDirFlagDesc                         = "path to config jsons output directory"
 	outputValidatorComponentsFileNameFlagDesc = "validator components config json output file name"
 	outputRelayerFileNameFlagDesc             = "relayer config json output file name"
@@ -62,6 +68,8 @@ const (
 
 	emptyBlocksThresholdFlagDesc = "specifies the maximum number of empty blocks for blocks submitter to skip"
 
+	legacyContractsABIFlagDesc = "bind the bridge contract deployed before the native tokens release with its legacy ABI"
+
 	defaultNetworkMagic                      = 0
 	defaultLogsPath                          = "./logs"
 	defaultDBsPath                           = "./db"
@@ -86,6 +94,8 @@ type generateConfigsParams struct {
 	bridgeSCAddress string
 	adminSCAddress  string
 
+	legacyContractsABI bool
+
 	validatorDataDir string
 	validatorConfig  string
 
@@ -95,6 +105,9 @@ type generateConfigsParams struct {
 	apiPort uint32
 	apiKeys []string
 
+	adminAPIKeysRaw []string
+	adminAPIKeys    []vcCore.AdminAPIKey
+
 	outputDir                         string
 	outputValidatorComponentsFileName string
 	outputRelayerFileName             string
@@ -123,6 +136,22 @@ func (p *generateConfigsParams) validateFlags() error {
 		return fmt.Errorf("specify at least one %s", apiKeysFlag)
 	}
 
+	p.adminAPIKeys = make([]vcCore.AdminAPIKey, len(p.adminAPIKeysRaw))
+
+	for i, x := range p.adminAPIKeysRaw {
+		parts := strings.SplitN(x, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" ||
+			(parts[1] != string(vcCore.AdminRoleOperator) && parts[1] != string(vcCore.AdminRoleAdmin)) {
+			return fmt.Errorf("invalid %s: %s", adminAPIKeysFlag, x)
+		}
+
+		p.adminAPIKeys[i] = vcCore.AdminAPIKey{
+			Operator: parts[0],
+			Role:     vcCore.AdminRole(parts[1]),
+			Key:      parts[2],
+		}
+	}
+
 	if p.telemetry != "" {
 		parts := strings.Split(p.telemetry, ",")
 		if len(parts) < 1 || len(parts) > 2 || !common.IsValidNetworkAddress(strings.TrimSpace(parts[0])) ||
@@ -154,6 +183,12 @@ func (p *generateConfigsParams) setFlags(cmd *cobra.Command) {
 		"",
 		adminSCAddressFlagDesc,
 	)
+	cmd.Flags().BoolVar(
+		&p.legacyContractsABI,
+		legacyContractsABIFlag,
+		false,
+		legacyContractsABIFlagDesc,
+	)
 
 	cmd.Flags().StringVar(
 		&p.validatorDataDir,
@@ -212,6 +247,12 @@ func (p *generateConfigsParams) setFlags(cmd *cobra.Command) {
 		nil,
 		apiKeysFlagDesc,
 	)
+	cmd.Flags().StringArrayVar(
+		&p.adminAPIKeysRaw,
+		adminAPIKeysFlag,
+		nil,
+		adminAPIKeysFlagDesc,
+	)
 
 	cmd.Flags().StringVar(
 		&p.telemetry,
@@ -248,6 +289,7 @@ func (p *generateConfigsParams) Execute() (common.ICommandResult, error) {
 			DynamicTx:                  false,
 			BridgeSmartContractAddress: p.bridgeSCAddress,
 			AdminSmartContractAddress:  p.adminSCAddress,
+			LegacyContractsABI:         p.legacyContractsABI,
 			SubmitConfig: oCore.SubmitConfig{
 				ConfirmedBlocksThreshold:  20,
 				ConfirmedBlocksSubmitTime: 3000,
@@ -306,6 +348,7 @@ func (p *generateConfigsParams) Execute() (common.ICommandResult, error) {
 			},
 			APIKeyHeader: "x-api-key",
 			APIKeys:      p.apiKeys,
+			AdminAPIKeys: p.adminAPIKeys,
 		},
 		Telemetry: telemetryConfig,
 	}
@@ -315,6 +358,7 @@ func (p *generateConfigsParams) Execute() (common.ICommandResult, error) {
 			NodeURL:              p.bridgeNodeURL,
 			DynamicTx:            false,
 			SmartContractAddress: p.bridgeSCAddress,
+			LegacyContractsABI:   p.legacyContractsABI,
 		},
 		Chains:        map[string]rCore.ChainConfig{},
 		PullTimeMilis: 1000,
@@ -61,11 +61,8 @@ func (ip *registerChainParams) validateFlags() error {
 	ip.chainID = strings.ToLower(ip.chainID)
 
 	// for known chain IDs, chainType is already known
-	switch ip.chainID {
-	case common.ChainIDStrPrime, common.ChainIDStrVector:
-		ip.chainType = common.ChainTypeCardano
-	case common.ChainIDStrNexus:
-		ip.chainType = common.ChainTypeEVM
+	if chainInfo, exists := common.GetChainInfo(ip.chainID); exists {
+		ip.chainType = chainInfo.ChainType
 	}
 
 	if !common.IsValidHTTPURL(ip.bridgeURL) {
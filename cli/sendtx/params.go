@@ -39,6 +39,8 @@ const (
 	gatewayAddressFlag  = "gateway-addr"
 	nexusURLFlag        = "nexus-url"
 
+	legacyContractsABIFlag = "legacy-contracts-abi"
+
 	privateKeyFlagDesc      = "wallet payment signing key"
 	stakePrivateKeyFlagDesc = "wallet stake signing key"
 	ogmiosURLSrcFlagDesc    = "source chain ogmios url"
@@ -54,6 +56,8 @@ const (
 	gatewayAddressFlagDesc  = "address of gateway contract"
 	nexusURLFlagDesc        = "nexus chain URL"
 
+	legacyContractsABIFlagDesc = "bind the gateway contract deployed before the native tokens release with its legacy ABI"
+
 	defaultFeeAmount = 1_100_000
 	ttlSlotNumberInc = 500
 
@@ -89,8 +93,9 @@ func ToGatewayStruct(receivers []*receiverAmount) ([]contractbinding.IGatewayStr
 	gatewayOutputs := make([]contractbinding.IGatewayStructsReceiverWithdraw, len(receivers))
 	for idx, rec := range receivers {
 		gatewayOutputs[idx] = contractbinding.IGatewayStructsReceiverWithdraw{
-			Receiver: rec.ReceiverAddr,
-			Amount:   rec.Amount,
+			Receiver:    rec.ReceiverAddr,
+			Amount:      rec.Amount,
+			TokenAmount: big.NewInt(0),
 		}
 
 		total.Add(total, rec.Amount)
@@ -118,8 +123,9 @@ type sendTxParams struct {
 	ogmiosURLDst    string
 
 	// nexus
-	gatewayAddress string
-	nexusURL       string
+	gatewayAddress     string
+	nexusURL           string
+	legacyContractsABI bool
 
 	feeAmount       *big.Int
 	receiversParsed []*receiverAmount
@@ -224,7 +230,7 @@ func (ip *sendTxParams) validateFlags() error {
 			return fmt.Errorf("--%s number %d has invalid address: %s", receiverFlag, i, x)
 		}
 
-		if ip.chainIDDst != common.ChainIDStrNexus &&
+		if !common.IsEVMChainID(ip.chainIDDst) &&
 			amount.Cmp(new(big.Int).SetUint64(common.MinUtxoAmountDefault)) < 0 {
 			return fmt.Errorf("--%s number %d has insufficient amount: %s", receiverFlag, i, x)
 		}
@@ -339,6 +345,13 @@ func (ip *sendTxParams) setFlags(cmd *cobra.Command) {
 		nexusURLFlagDesc,
 	)
 
+	cmd.Flags().BoolVar(
+		&ip.legacyContractsABI,
+		legacyContractsABIFlag,
+		false,
+		legacyContractsABIFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(gatewayAddressFlag, testnetMagicFlag)
 	cmd.MarkFlagsMutuallyExclusive(gatewayAddressFlag, networkIDSrcFlag)
 	cmd.MarkFlagsMutuallyExclusive(gatewayAddressFlag, ogmiosURLSrcFlag)
@@ -466,7 +479,9 @@ func (ip *sendTxParams) executeEvm(
 		return nil, err
 	}
 
-	contract, err := contractbinding.NewGateway(contractAddress, txHelper.GetClient())
+	contractsABI := contractbinding.GetContractsABI(ip.legacyContractsABI)
+
+	contract, err := contractbinding.NewGatewayWithABI(contractAddress, txHelper.GetClient(), contractsABI)
 	if err != nil {
 		return nil, err
 	}
@@ -474,7 +489,7 @@ func (ip *sendTxParams) executeEvm(
 	_, _ = outputter.Write([]byte("Estimating gas..."))
 	outputter.WriteOutput()
 
-	abi, err := contractbinding.GatewayMetaData.GetAbi()
+	abi, err := contractsABI.GatewayMetaData().GetAbi()
 	if err != nil {
 		return nil, err
 	}
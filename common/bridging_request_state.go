@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"time"
 )
 
 type BridgingRequestStatus string
@@ -9,6 +10,7 @@ type BridgingRequestStatus string
 const (
 	BridgingRequestStatusDiscoveredOnSource           BridgingRequestStatus = "DiscoveredOnSource"
 	BridgingRequestStatusInvalidRequest               BridgingRequestStatus = "InvalidRequest"
+	BridgingRequestStatusAwaitingApproval             BridgingRequestStatus = "AwaitingApproval"
 	BridgingRequestStatusSubmittedToBridge            BridgingRequestStatus = "SubmittedToBridge"
 	BridgingRequestStatusIncludedInBatch              BridgingRequestStatus = "IncludedInBatch"
 	BridgingRequestStatusSubmittedToDestination       BridgingRequestStatus = "SubmittedToDestination"
@@ -21,6 +23,18 @@ const (
 	bridgingRequestStatusRefundExecuted                 = "RefundExecuted"
 )
 
+func (s BridgingRequestStatus) IsValid() bool {
+	switch s {
+	case BridgingRequestStatusDiscoveredOnSource, BridgingRequestStatusInvalidRequest,
+		BridgingRequestStatusAwaitingApproval, BridgingRequestStatusSubmittedToBridge, BridgingRequestStatusIncludedInBatch,
+		BridgingRequestStatusSubmittedToDestination, BridgingRequestStatusFailedToExecuteOnDestination,
+		BridgingRequestStatusExecutedOnDestination:
+		return true
+	default:
+		return false
+	}
+}
+
 type BridgingRequestState struct {
 	SourceChainID      string
 	SourceTxHash       Hash
@@ -28,12 +42,51 @@ type BridgingRequestState struct {
 	Status             BridgingRequestStatus
 	DestinationTxHash  Hash
 	IsRefund           bool
+	BatchID            uint64
+	FailureReason      string
+	SenderAddr         string
+	ReceiverAddrs      []string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	// RequiredConfirmations is set if the amount tier of the request requires
+	// a deeper confirmation than the default one of the source chain
+	RequiredConfirmations uint64
+	// ScreeningDecision is set if the sender or one of the receivers is in the address blocklist
+	ScreeningDecision string
+}
+
+// BridgingRequestStateTransition is a single entry in the history of a bridging request state
+type BridgingRequestStateTransition struct {
+	Status             BridgingRequestStatus `json:"status"`
+	IsRefund           bool                  `json:"isRefund"`
+	DestinationChainID string                `json:"dstChainId"`
+	DestinationTxHash  Hash                  `json:"dstTxHash"`
+	BatchID            uint64                `json:"batchId"`
+	FailureReason      string                `json:"reason"`
+	Timestamp          time.Time             `json:"timestamp"`
+}
+
+func (t *BridgingRequestStateTransition) StatusStr() string {
+	return BridgingRequestStateStatusStr(t.Status, t.IsRefund)
 }
 
 func (s *BridgingRequestState) ToDBKey() []byte {
 	return ToBridgingRequestStateDBKey(s.SourceChainID, s.SourceTxHash)
 }
 
+// ToTransition returns the history entry which corresponds to the current state
+func (s *BridgingRequestState) ToTransition() *BridgingRequestStateTransition {
+	return &BridgingRequestStateTransition{
+		Status:             s.Status,
+		IsRefund:           s.IsRefund,
+		DestinationChainID: s.DestinationChainID,
+		DestinationTxHash:  s.DestinationTxHash,
+		BatchID:            s.BatchID,
+		FailureReason:      s.FailureReason,
+		Timestamp:          s.UpdatedAt,
+	}
+}
+
 func (s *BridgingRequestState) StatusStr() string {
 	return BridgingRequestStateStatusStr(s.Status, s.IsRefund)
 }
@@ -74,6 +127,10 @@ func (s *BridgingRequestState) ToInvalidRequest() {
 	s.Status = BridgingRequestStatusInvalidRequest
 }
 
+func (s *BridgingRequestState) ToAwaitingApproval() {
+	s.Status = BridgingRequestStatusAwaitingApproval
+}
+
 func (s *BridgingRequestState) ToSubmittedToBridge() {
 	s.Status = BridgingRequestStatusSubmittedToBridge
 }
@@ -104,22 +161,25 @@ func (s *BridgingRequestState) IsTransitionPossible(newStatus BridgingRequestSta
 	case BridgingRequestStatusInvalidRequest:
 		isInvalidTransition = true
 
+	case BridgingRequestStatusAwaitingApproval:
+
 	case BridgingRequestStatusSubmittedToBridge:
 		isInvalidTransition = newStatus == BridgingRequestStatusDiscoveredOnSource ||
-			newStatus == BridgingRequestStatusInvalidRequest
+			newStatus == BridgingRequestStatusInvalidRequest || newStatus == BridgingRequestStatusAwaitingApproval
 
 	case BridgingRequestStatusIncludedInBatch:
 		isInvalidTransition = newStatus == BridgingRequestStatusDiscoveredOnSource ||
-			newStatus == BridgingRequestStatusInvalidRequest ||
+			newStatus == BridgingRequestStatusInvalidRequest || newStatus == BridgingRequestStatusAwaitingApproval ||
 			newStatus == BridgingRequestStatusSubmittedToBridge
 
 	case BridgingRequestStatusSubmittedToDestination:
 		isInvalidTransition = newStatus == BridgingRequestStatusDiscoveredOnSource ||
-			newStatus == BridgingRequestStatusInvalidRequest ||
+			newStatus == BridgingRequestStatusInvalidRequest || newStatus == BridgingRequestStatusAwaitingApproval ||
 			newStatus == BridgingRequestStatusSubmittedToBridge || newStatus == BridgingRequestStatusIncludedInBatch
 
 	case BridgingRequestStatusFailedToExecuteOnDestination:
-		isInvalidTransition = newStatus == BridgingRequestStatusDiscoveredOnSource
+		isInvalidTransition = newStatus == BridgingRequestStatusDiscoveredOnSource ||
+			newStatus == BridgingRequestStatusAwaitingApproval
 
 	case BridgingRequestStatusExecutedOnDestination:
 		isInvalidTransition = true
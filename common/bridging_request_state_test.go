@@ -70,6 +70,17 @@ func TestBridgingRequestState(t *testing.T) {
 		state := NewBridgingRequestState(ChainIDStrNexus, txHash, false)
 		state.ToFailedToExecuteOnDestination()
 		require.Error(t, state.IsTransitionPossible(BridgingRequestStatusDiscoveredOnSource))
+		require.Error(t, state.IsTransitionPossible(BridgingRequestStatusAwaitingApproval))
 		require.NoError(t, state.IsTransitionPossible(BridgingRequestStatusExecutedOnDestination))
 	})
+
+	t.Run("IsTransitionPossible BridgingRequestStatusAwaitingApproval", func(t *testing.T) {
+		state := NewBridgingRequestState(ChainIDStrNexus, txHash, false)
+		state.ToAwaitingApproval()
+		require.NoError(t, state.IsTransitionPossible(BridgingRequestStatusSubmittedToBridge))
+		require.NoError(t, state.IsTransitionPossible(BridgingRequestStatusInvalidRequest))
+
+		state.ToSubmittedToBridge()
+		require.Error(t, state.IsTransitionPossible(BridgingRequestStatusAwaitingApproval))
+	})
 }
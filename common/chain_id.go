@@ -1,5 +1,11 @@
 package common
 
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
 type chainIDNum = uint8
 
 const (
@@ -18,33 +24,175 @@ const (
 	ChainTypeEVMStr     = "evm"
 )
 
-var (
-	strToInt = map[string]chainIDNum{
-		ChainIDStrPrime:  ChainIDIntPrime,
-		ChainIDStrVector: ChainIDIntVector,
-		ChainIDStrNexus:  ChainIDIntNexus,
+// ChainInfo describes a chain known to the bridge
+type ChainInfo struct {
+	ID        string
+	NumID     chainIDNum
+	ChainType uint8
+}
+
+type chainRegistry struct {
+	lock     sync.RWMutex
+	strToNum map[string]ChainInfo
+	numToStr map[chainIDNum]ChainInfo
+}
+
+// chains is populated with the default networks and can be extended at startup
+// from configuration and from the chains registered on the bridge contract
+var chains = newChainRegistry()
+
+func newChainRegistry() *chainRegistry {
+	r := &chainRegistry{
+		strToNum: map[string]ChainInfo{},
+		numToStr: map[chainIDNum]ChainInfo{},
 	}
-	intToStr = map[chainIDNum]string{
-		ChainIDIntPrime:  ChainIDStrPrime,
-		ChainIDIntVector: ChainIDStrVector,
-		ChainIDIntNexus:  ChainIDStrNexus,
+
+	for _, info := range defaultChains() {
+		r.strToNum[info.ID] = info
+		r.numToStr[info.NumID] = info
 	}
-)
+
+	return r
+}
+
+func defaultChains() []ChainInfo {
+	return []ChainInfo{
+		{ID: ChainIDStrPrime, NumID: ChainIDIntPrime, ChainType: ChainTypeCardano},
+		{ID: ChainIDStrVector, NumID: ChainIDIntVector, ChainType: ChainTypeCardano},
+		{ID: ChainIDStrNexus, NumID: ChainIDIntNexus, ChainType: ChainTypeEVM},
+	}
+}
+
+func (r *chainRegistry) register(info ChainInfo) error {
+	if info.ID == "" {
+		return fmt.Errorf("empty chain id for numeric chain id %d", info.NumID)
+	}
+
+	if info.NumID == 0 {
+		return fmt.Errorf("invalid numeric chain id for chain %s", info.ID)
+	}
+
+	if info.ChainType != ChainTypeCardano && info.ChainType != ChainTypeEVM {
+		return fmt.Errorf("invalid chain type %d for chain %s", info.ChainType, info.ID)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if existing, exists := r.strToNum[info.ID]; exists && existing != info {
+		return fmt.Errorf("chain %s already registered as (%d, %d)", info.ID, existing.NumID, existing.ChainType)
+	}
+
+	if existing, exists := r.numToStr[info.NumID]; exists && existing != info {
+		return fmt.Errorf("numeric chain id %d already registered for chain %s", info.NumID, existing.ID)
+	}
+
+	r.strToNum[info.ID] = info
+	r.numToStr[info.NumID] = info
+
+	return nil
+}
+
+func (r *chainRegistry) getByStr(chainIDStr string) (ChainInfo, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	info, exists := r.strToNum[chainIDStr]
+
+	return info, exists
+}
+
+func (r *chainRegistry) getByNum(chainIDNum chainIDNum) (ChainInfo, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	info, exists := r.numToStr[chainIDNum]
+
+	return info, exists
+}
+
+func (r *chainRegistry) all() []ChainInfo {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	result := make([]ChainInfo, 0, len(r.numToStr))
+	for _, info := range r.numToStr {
+		result = append(result, info)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NumID < result[j].NumID
+	})
+
+	return result
+}
+
+// RegisterChain adds a chain to the registry. Registering an already known chain with the same
+// numeric id and type is a no-op, while any conflicting registration returns an error
+func RegisterChain(chainIDStr string, chainIDNum chainIDNum, chainType uint8) error {
+	return chains.register(ChainInfo{
+		ID:        chainIDStr,
+		NumID:     chainIDNum,
+		ChainType: chainType,
+	})
+}
+
+func GetRegisteredChains() []ChainInfo {
+	return chains.all()
+}
+
+func GetChainInfo(chainIDStr string) (ChainInfo, bool) {
+	return chains.getByStr(chainIDStr)
+}
 
 func ToNumChainID(chainIDStr string) chainIDNum {
-	return strToInt[chainIDStr]
+	info, _ := chains.getByStr(chainIDStr)
+
+	return info.NumID
 }
 
 func ToStrChainID(chainIDNum chainIDNum) string {
-	return intToStr[chainIDNum]
+	info, _ := chains.getByNum(chainIDNum)
+
+	return info.ID
 }
 
 func IsExistingChainID(chainIDStr string) bool {
-	_, exists := strToInt[chainIDStr]
+	_, exists := chains.getByStr(chainIDStr)
 
 	return exists
 }
 
 func IsEVMChainID(chainIDStr string) bool {
-	return chainIDStr == ChainIDStrNexus
+	info, exists := chains.getByStr(chainIDStr)
+
+	return exists && info.ChainType == ChainTypeEVM
+}
+
+func IsCardanoChainID(chainIDStr string) bool {
+	info, exists := chains.getByStr(chainIDStr)
+
+	return exists && info.ChainType == ChainTypeCardano
+}
+
+func ChainTypeToStr(chainType uint8) string {
+	switch chainType {
+	case ChainTypeCardano:
+		return ChainTypeCardanoStr
+	case ChainTypeEVM:
+		return ChainTypeEVMStr
+	default:
+		return ""
+	}
+}
+
+func ChainTypeFromStr(chainTypeStr string) (uint8, error) {
+	switch chainTypeStr {
+	case ChainTypeCardanoStr:
+		return ChainTypeCardano, nil
+	case ChainTypeEVMStr:
+		return ChainTypeEVM, nil
+	default:
+		return 0, fmt.Errorf("unknown chain type: %s", chainTypeStr)
+	}
 }
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainRegistry(t *testing.T) {
+	t.Run("default chains", func(t *testing.T) {
+		r := newChainRegistry()
+
+		info, exists := r.getByStr(ChainIDStrPrime)
+		require.True(t, exists)
+		assert.Equal(t, ChainIDIntPrime, info.NumID)
+		assert.Equal(t, uint8(ChainTypeCardano), info.ChainType)
+
+		info, exists = r.getByNum(ChainIDIntNexus)
+		require.True(t, exists)
+		assert.Equal(t, ChainIDStrNexus, info.ID)
+		assert.Equal(t, uint8(ChainTypeEVM), info.ChainType)
+
+		assert.Len(t, r.all(), 3)
+	})
+
+	t.Run("register new chains", func(t *testing.T) {
+		r := newChainRegistry()
+
+		require.NoError(t, r.register(ChainInfo{ID: "cardano4", NumID: 4, ChainType: ChainTypeCardano}))
+		require.NoError(t, r.register(ChainInfo{ID: "evm5", NumID: 5, ChainType: ChainTypeEVM}))
+		// registering the same chain twice is allowed
+		require.NoError(t, r.register(ChainInfo{ID: "evm5", NumID: 5, ChainType: ChainTypeEVM}))
+
+		info, exists := r.getByNum(4)
+		require.True(t, exists)
+		assert.Equal(t, "cardano4", info.ID)
+
+		info, exists = r.getByStr("evm5")
+		require.True(t, exists)
+		assert.Equal(t, uint8(5), info.NumID)
+		assert.Equal(t, uint8(ChainTypeEVM), info.ChainType)
+
+		all := r.all()
+		require.Len(t, all, 5)
+		assert.Equal(t, uint8(5), all[4].NumID)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		r := newChainRegistry()
+
+		require.ErrorContains(t, r.register(ChainInfo{ID: "", NumID: 4}), "empty chain id")
+		require.ErrorContains(t, r.register(ChainInfo{ID: "a", NumID: 0}), "invalid numeric chain id")
+		require.ErrorContains(t, r.register(ChainInfo{ID: "a", NumID: 4, ChainType: 7}), "invalid chain type")
+		require.ErrorContains(t, r.register(
+			ChainInfo{ID: ChainIDStrPrime, NumID: 4, ChainType: ChainTypeCardano}), "already registered as")
+		require.ErrorContains(t, r.register(
+			ChainInfo{ID: ChainIDStrNexus, NumID: ChainIDIntNexus, ChainType: ChainTypeCardano}), "already registered as")
+		require.ErrorContains(t, r.register(
+			ChainInfo{ID: "other", NumID: ChainIDIntVector, ChainType: ChainTypeCardano}), "already registered for chain")
+	})
+}
+
+func TestChainIDFunctions(t *testing.T) {
+	defer ResetChainRegistry()
+
+	require.NoError(t, RegisterChain("evmtest", 200, ChainTypeEVM))
+	require.NoError(t, RegisterChain("cardanotest", 201, ChainTypeCardano))
+
+	assert.Equal(t, uint8(200), ToNumChainID("evmtest"))
+	assert.Equal(t, "cardanotest", ToStrChainID(201))
+	assert.Equal(t, uint8(0), ToNumChainID("unknown"))
+	assert.Equal(t, "", ToStrChainID(202))
+
+	assert.True(t, IsExistingChainID("evmtest"))
+	assert.False(t, IsExistingChainID("unknown"))
+
+	assert.True(t, IsEVMChainID("evmtest"))
+	assert.True(t, IsEVMChainID(ChainIDStrNexus))
+	assert.False(t, IsEVMChainID("cardanotest"))
+	assert.False(t, IsEVMChainID("unknown"))
+	assert.True(t, IsCardanoChainID("cardanotest"))
+
+	assert.True(t, IsValidAddress("evmtest", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	assert.False(t, IsValidAddress("cardanotest", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	assert.Equal(t, big.NewInt(1_000_000), GetDfmAmount("evmtest", new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(1e12))))
+	assert.Equal(t, big.NewInt(1_000_000), GetDfmAmount("cardanotest", big.NewInt(1_000_000)))
+}
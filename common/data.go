@@ -38,8 +38,11 @@ func NewBridgingRequestStateKey(sourceChainID string, sourceTxHash Hash, isRefun
 }
 
 type NewBridgingRequestStateModel struct {
-	SourceTxHash Hash
-	IsRefund     bool
+	SourceTxHash       Hash
+	IsRefund           bool
+	SenderAddr         string
+	DestinationChainID string
+	ReceiverAddrs      []string
 }
 
 type ConfirmedTxType uint8
@@ -0,0 +1,12 @@
+package common
+
+// ResetChainRegistry restores the registry to the default chains
+func ResetChainRegistry() {
+	newRegistry := newChainRegistry()
+
+	chains.lock.Lock()
+	defer chains.lock.Unlock()
+
+	chains.strToNum = newRegistry.strToNum
+	chains.numToStr = newRegistry.numToStr
+}
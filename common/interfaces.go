@@ -5,12 +5,20 @@ import "context"
 type BridgingRequestStateUpdater interface {
 	New(srcChainID string, model *NewBridgingRequestStateModel) error
 	NewMultiple(srcChainID string, models []*NewBridgingRequestStateModel) error
-	Invalid(key BridgingRequestStateKey) error
+	Invalid(key BridgingRequestStateKey, reason string) error
+	// Reorged sets the state back to DiscoveredOnSource after the source tx was reorged out of its block
+	Reorged(key BridgingRequestStateKey, reason string) error
+	// AwaitingConfirmations records that the request is held until its amount tier confirmation depth is reached
+	AwaitingConfirmations(key BridgingRequestStateKey, requiredConfirmations uint64) error
+	// AwaitingApproval records that the request is held until enough operators approve it
+	AwaitingApproval(key BridgingRequestStateKey) error
+	// AddressScreened records the decision made for a request with a blocked sender or receiver address
+	AddressScreened(key BridgingRequestStateKey, decision string) error
 	SubmittedToBridge(key BridgingRequestStateKey, dstChainID string) error
-	IncludedInBatch(txs []BridgingRequestStateKey, dstChainID string) error
-	SubmittedToDestination(txs []BridgingRequestStateKey, dstChainID string) error
-	FailedToExecuteOnDestination(txs []BridgingRequestStateKey, dstChainID string) error
-	ExecutedOnDestination(txs []BridgingRequestStateKey, dstTxHash Hash, dstChainID string) error
+	IncludedInBatch(txs []BridgingRequestStateKey, dstChainID string, batchID uint64) error
+	SubmittedToDestination(txs []BridgingRequestStateKey, dstChainID string, batchID uint64) error
+	FailedToExecuteOnDestination(txs []BridgingRequestStateKey, dstChainID string, batchID uint64) error
+	ExecutedOnDestination(txs []BridgingRequestStateKey, dstTxHash Hash, dstChainID string, batchID uint64) error
 }
 
 // ChainSpecificConfig defines the interface for chain-specific configurations
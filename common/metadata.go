@@ -10,7 +10,6 @@ import (
 
 type BridgingTxType string
 type MetadataEncodingType string
-type BridgingRequestMetadata sendtx.BridgingRequestMetadata
 
 const (
 	BridgingTxTypeBridgingRequest BridgingTxType = "bridge"
@@ -25,6 +24,23 @@ const (
 	MetadataMapKey = 1
 )
 
+// BridgingRequestMetadataTransaction extends the receiver of the wallets metadata with an optional native token.
+// Token is the full name of the token (policy id and hex encoded asset name) split like the address
+type BridgingRequestMetadataTransaction struct {
+	Address     []string `cbor:"a" json:"a"`
+	Amount      uint64   `cbor:"m" json:"m"`
+	Token       []string `cbor:"tk,omitempty" json:"tk,omitempty"`
+	TokenAmount uint64   `cbor:"tm,omitempty" json:"tm,omitempty"`
+}
+
+type BridgingRequestMetadata struct {
+	BridgingTxType     sendtx.BridgingRequestType           `cbor:"t" json:"t"`
+	DestinationChainID string                               `cbor:"d" json:"d"`
+	SenderAddr         []string                             `cbor:"s" json:"s"`
+	Transactions       []BridgingRequestMetadataTransaction `cbor:"tx" json:"tx"`
+	BridgingFee        uint64                               `cbor:"fa" json:"fa"`
+}
+
 type BaseMetadata struct {
 	BridgingTxType BridgingTxType `cbor:"t" json:"t"`
 }
@@ -38,6 +54,8 @@ type BatchExecutedMetadata struct {
 	BridgingTxType BridgingTxType `cbor:"t" json:"t"`
 	BatchNonceID   uint64         `cbor:"n" json:"n"`
 	IsFeeOnlyTx    uint8          `cbor:"f" json:"f"`
+	// TxBuilderBackend is the backend which built the batch if it is not the cardano-cli one
+	TxBuilderBackend string `cbor:"b,omitempty" json:"b,omitempty"`
 }
 
 type marshalFunc = func(v any) ([]byte, error)
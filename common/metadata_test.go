@@ -113,6 +113,18 @@ func TestMetadata(t *testing.T) {
 		require.Equal(t, uint64(245), metadata.BatchNonceID)
 	})
 
+	t.Run("Json Marshal BatchExecutedMetadata tx builder backend", func(t *testing.T) {
+		result, err := MarshalMetadata(MetadataEncodingTypeJSON, BatchExecutedMetadata{BatchNonceID: 245})
+		require.NoError(t, err)
+		require.NotContains(t, string(result), `"b"`)
+
+		result, err = MarshalMetadata(MetadataEncodingTypeJSON, BatchExecutedMetadata{
+			BatchNonceID: 245, TxBuilderBackend: "native",
+		})
+		require.NoError(t, err)
+		require.Contains(t, string(result), `"b":"native"`)
+	})
+
 	t.Run("Cbor Marshal BatchExecutedMetadata", func(t *testing.T) {
 		result, err := MarshalMetadata[BatchExecutedMetadata](MetadataEncodingTypeCbor, BatchExecutedMetadata{BridgingTxType: "test"})
 
@@ -56,7 +56,42 @@ func (m *BridgingRequestStateUpdaterMock) NewMultiple(sourceChainID string, txs
 }
 
 // Invalid implements BridgingRequestStateUpdater.
-func (m *BridgingRequestStateUpdaterMock) Invalid(key BridgingRequestStateKey) error {
+func (m *BridgingRequestStateUpdaterMock) Invalid(key BridgingRequestStateKey, reason string) error {
+	if m.ReturnNil {
+		return nil
+	}
+
+	args := m.Called(key, reason)
+
+	return args.Error(0)
+}
+
+// Reorged implements BridgingRequestStateUpdater.
+func (m *BridgingRequestStateUpdaterMock) Reorged(key BridgingRequestStateKey, reason string) error {
+	if m.ReturnNil {
+		return nil
+	}
+
+	args := m.Called(key, reason)
+
+	return args.Error(0)
+}
+
+// AwaitingConfirmations implements BridgingRequestStateUpdater.
+func (m *BridgingRequestStateUpdaterMock) AwaitingConfirmations(
+	key BridgingRequestStateKey, requiredConfirmations uint64,
+) error {
+	if m.ReturnNil {
+		return nil
+	}
+
+	args := m.Called(key, requiredConfirmations)
+
+	return args.Error(0)
+}
+
+// AwaitingApproval implements BridgingRequestStateUpdater.
+func (m *BridgingRequestStateUpdaterMock) AwaitingApproval(key BridgingRequestStateKey) error {
 	if m.ReturnNil {
 		return nil
 	}
@@ -66,6 +101,17 @@ func (m *BridgingRequestStateUpdaterMock) Invalid(key BridgingRequestStateKey) e
 	return args.Error(0)
 }
 
+// AddressScreened implements BridgingRequestStateUpdater.
+func (m *BridgingRequestStateUpdaterMock) AddressScreened(key BridgingRequestStateKey, decision string) error {
+	if m.ReturnNil {
+		return nil
+	}
+
+	args := m.Called(key, decision)
+
+	return args.Error(0)
+}
+
 // SubmittedToBridge implements BridgingRequestStateUpdater.
 func (m *BridgingRequestStateUpdaterMock) SubmittedToBridge(
 	key BridgingRequestStateKey, dstChainID string,
@@ -81,52 +127,52 @@ func (m *BridgingRequestStateUpdaterMock) SubmittedToBridge(
 
 // IncludedInBatch implements BridgingRequestStateUpdater.
 func (m *BridgingRequestStateUpdaterMock) IncludedInBatch(
-	txs []BridgingRequestStateKey, dstChainID string,
+	txs []BridgingRequestStateKey, dstChainID string, batchID uint64,
 ) error {
 	if m.ReturnNil {
 		return nil
 	}
 
-	args := m.Called(txs, dstChainID)
+	args := m.Called(txs, dstChainID, batchID)
 
 	return args.Error(0)
 }
 
 // SubmittedToDestination implements BridgingRequestStateUpdater.
 func (m *BridgingRequestStateUpdaterMock) SubmittedToDestination(
-	txs []BridgingRequestStateKey, dstChainID string,
+	txs []BridgingRequestStateKey, dstChainID string, batchID uint64,
 ) error {
 	if m.ReturnNil {
 		return nil
 	}
 
-	args := m.Called(txs, dstChainID)
+	args := m.Called(txs, dstChainID, batchID)
 
 	return args.Error(0)
 }
 
 // FailedToExecuteOnDestination implements BridgingRequestStateUpdater.
 func (m *BridgingRequestStateUpdaterMock) FailedToExecuteOnDestination(
-	txs []BridgingRequestStateKey, dstChainID string,
+	txs []BridgingRequestStateKey, dstChainID string, batchID uint64,
 ) error {
 	if m.ReturnNil {
 		return nil
 	}
 
-	args := m.Called(txs, dstChainID)
+	args := m.Called(txs, dstChainID, batchID)
 
 	return args.Error(0)
 }
 
 // ExecutedOnDestination implements BridgingRequestStateUpdater.
 func (m *BridgingRequestStateUpdaterMock) ExecutedOnDestination(
-	txs []BridgingRequestStateKey, destinationTxHash Hash, dstChainID string,
+	txs []BridgingRequestStateKey, destinationTxHash Hash, dstChainID string, batchID uint64,
 ) error {
 	if m.ReturnNil {
 		return nil
 	}
 
-	args := m.Called(txs, destinationTxHash, dstChainID)
+	args := m.Called(txs, destinationTxHash, dstChainID, batchID)
 
 	return args.Error(0)
 }
@@ -255,23 +255,21 @@ func WaitForAmount(
 }
 
 func IsValidAddress(chainID string, addr string) bool {
-	switch chainID {
-	case ChainIDStrNexus:
+	if IsEVMChainID(chainID) {
 		return ethcommon.IsHexAddress(addr)
-	default:
-		addr, err := cardanowallet.NewCardanoAddressFromString(addr)
-
-		return err == nil && addr.GetInfo().AddressType != cardanowallet.RewardAddress
 	}
+
+	cardanoAddr, err := cardanowallet.NewCardanoAddressFromString(addr)
+
+	return err == nil && cardanoAddr.GetInfo().AddressType != cardanowallet.RewardAddress
 }
 
 func GetDfmAmount(chainID string, amount *big.Int) *big.Int {
-	switch chainID {
-	case ChainIDStrNexus:
+	if IsEVMChainID(chainID) {
 		return WeiToDfm(amount)
-	default:
-		return amount
 	}
+
+	return amount
 }
 
 func PackNumbersToBytes[Slice ~[]T, T constraints.Integer | constraints.Float](nums Slice) []byte {
@@ -42,16 +42,18 @@ type IBridgeSmartContract interface {
 type BridgeSmartContractImpl struct {
 	smartContractAddress ethcommon.Address
 	ethHelper            *EthHelperWrapper
+	contractsABI         contractbinding.ContractsABI
 }
 
 var _ IBridgeSmartContract = (*BridgeSmartContractImpl)(nil)
 
 func NewBridgeSmartContract(
-	smartContractAddress string, ethHelper *EthHelperWrapper,
+	smartContractAddress string, ethHelper *EthHelperWrapper, contractsABI contractbinding.ContractsABI,
 ) *BridgeSmartContractImpl {
 	return &BridgeSmartContractImpl{
 		smartContractAddress: common.HexToAddress(smartContractAddress),
 		ethHelper:            ethHelper,
+		contractsABI:         contractsABI,
 	}
 }
 
@@ -63,9 +65,10 @@ func (bsc *BridgeSmartContractImpl) GetConfirmedBatch(
 		return nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -88,9 +91,10 @@ func (bsc *BridgeSmartContractImpl) SubmitSignedBatch(
 		return fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -115,9 +119,10 @@ func (bsc *BridgeSmartContractImpl) SubmitSignedBatchEVM(
 		return fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -140,9 +145,10 @@ func (bsc *BridgeSmartContractImpl) ShouldCreateBatch(ctx context.Context, desti
 		return false, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return false, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -160,9 +166,10 @@ func (bsc *BridgeSmartContractImpl) GetConfirmedTransactions(
 		return nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -181,9 +188,10 @@ func (bsc *BridgeSmartContractImpl) GetLastObservedBlock(
 		return CardanoBlock{}, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return CardanoBlock{}, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -206,9 +214,10 @@ func (bsc *BridgeSmartContractImpl) GetValidatorsChainData(
 		return nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -224,9 +233,10 @@ func (bsc *BridgeSmartContractImpl) GetNextBatchID(ctx context.Context, destinat
 		return 0, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return 0, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -242,9 +252,10 @@ func (bsc *BridgeSmartContractImpl) GetAllRegisteredChains(ctx context.Context)
 		return nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -271,7 +282,7 @@ func (bsc *BridgeSmartContractImpl) GetBlockNumber(ctx context.Context) (uint64,
 func (bsc *BridgeSmartContractImpl) SetChainAdditionalData(
 	ctx context.Context, chainID, multisigAddr, feeAddr string,
 ) error {
-	parsedABI, err := contractbinding.BridgeContractMetaData.GetAbi()
+	parsedABI, err := bsc.contractsABI.BridgeContractMetaData().GetAbi()
 	if err != nil {
 		return err
 	}
@@ -281,9 +292,10 @@ func (bsc *BridgeSmartContractImpl) SetChainAdditionalData(
 		return fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -318,9 +330,10 @@ func (bsc *BridgeSmartContractImpl) GetBatchStatusAndTransactions(
 		return 0, nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return 0, nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -343,9 +356,10 @@ func (bsc *BridgeSmartContractImpl) GetBatchStatusAndType(
 		return 0, 0, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return 0, 0, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -366,9 +380,10 @@ func (bsc *BridgeSmartContractImpl) IsNewValidatorSetPending() (bool, error) {
 		return false, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return false, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -389,9 +404,10 @@ func (bsc *BridgeSmartContractImpl) GetPendingValidatorSetDelta() ([]ValidatorSe
 		return nil, nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -412,9 +428,10 @@ func (bsc *BridgeSmartContractImpl) GetAddressValidatorIndex(validatorAddr ethco
 		return 0, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return 0, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -435,9 +452,10 @@ func (bsc *BridgeSmartContractImpl) GetCurrentValidatorSetID(ctx context.Context
 		return nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
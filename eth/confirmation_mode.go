@@ -0,0 +1,46 @@
+package eth
+
+import (
+	"fmt"
+
+	"github.com/Ethernal-Tech/ethgo"
+)
+
+// ConfirmationMode defines when a block of an evm chain is considered confirmed
+type ConfirmationMode string
+
+const (
+	// ConfirmationModeDepth confirms a block once the configured number of blocks is built on top of it
+	ConfirmationModeDepth ConfirmationMode = "depth"
+	// ConfirmationModeSafe confirms every block up to the block tagged as safe by the node
+	ConfirmationModeSafe ConfirmationMode = "safe"
+	// ConfirmationModeFinalized confirms every block up to the block tagged as finalized by the node
+	ConfirmationModeFinalized ConfirmationMode = "finalized"
+)
+
+// Validate returns an error for an unknown mode. An empty mode is the depth mode
+func (m ConfirmationMode) Validate() error {
+	switch m {
+	case "", ConfirmationModeDepth, ConfirmationModeSafe, ConfirmationModeFinalized:
+		return nil
+	default:
+		return fmt.Errorf("unknown confirmation mode: %s", m)
+	}
+}
+
+// IsBlockTag returns true if the confirmed blocks are defined by a block tag of the node
+func (m ConfirmationMode) IsBlockTag() bool {
+	return m == ConfirmationModeSafe || m == ConfirmationModeFinalized
+}
+
+// BlockNumber returns the block tag of the last confirmed block, or latest for the depth mode
+func (m ConfirmationMode) BlockNumber() ethgo.BlockNumber {
+	switch m {
+	case ConfirmationModeSafe:
+		return ethgo.Safe
+	case ConfirmationModeFinalized:
+		return ethgo.Finalized
+	default:
+		return ethgo.Latest
+	}
+}
@@ -104,7 +104,13 @@ func (ct ConfirmedTransactionsWrapper) String() string {
 
 			sb.WriteString("(")
 			sb.WriteString(recv.DestinationAddress)
-			sb.WriteString(fmt.Sprintf(", %d)", recv.Amount))
+			sb.WriteString(fmt.Sprintf(", %d", recv.Amount))
+
+			if recv.TokenAmount != nil && recv.TokenAmount.Sign() > 0 {
+				sb.WriteString(fmt.Sprintf(", %d %s", recv.TokenAmount, recv.Token))
+			}
+
+			sb.WriteString(")")
 		}
 
 		sb.WriteString("]")
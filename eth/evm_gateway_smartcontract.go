@@ -30,13 +30,14 @@ type EVMGatewaySmartContractImpl struct {
 	gasPrice             *big.Int
 	gasFeeCap            *big.Int
 	gasTipCap            *big.Int
+	contractsABI         contractbinding.ContractsABI
 }
 
 var _ IEVMGatewaySmartContract = (*EVMGatewaySmartContractImpl)(nil)
 
 func NewEVMGatewaySmartContract(
 	smartContractAddress string, ethHelper *EthHelperWrapper, depositGasLimit uint64,
-	gasPrice, gasFeeCap, gasTipCap *big.Int, logger hclog.Logger,
+	gasPrice, gasFeeCap, gasTipCap *big.Int, contractsABI contractbinding.ContractsABI, logger hclog.Logger,
 ) (*EVMGatewaySmartContractImpl, error) {
 	return &EVMGatewaySmartContractImpl{
 		smartContractAddress: ethcommon.HexToAddress(smartContractAddress),
@@ -46,6 +47,7 @@ func NewEVMGatewaySmartContract(
 		gasPrice:             gasPrice,
 		gasFeeCap:            gasFeeCap,
 		gasTipCap:            gasTipCap,
+		contractsABI:         contractsABI,
 	}, nil
 }
 
@@ -79,7 +81,7 @@ func (bsc *EVMGatewaySmartContractImpl) sendTx(
 	data []byte,
 	txType toGatewayTxType,
 ) error {
-	parsedABI, err := contractbinding.GatewayMetaData.GetAbi()
+	parsedABI, err := bsc.contractsABI.GatewayMetaData().GetAbi()
 	if err != nil {
 		return fmt.Errorf("error while GatewayMetaData.GetAbi(): %w", err)
 	}
@@ -89,7 +91,8 @@ func (bsc *EVMGatewaySmartContractImpl) sendTx(
 		return fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewGateway(bsc.smartContractAddress, ethTxHelper.GetClient())
+	contract, err := contractbinding.NewGatewayWithABI(
+		bsc.smartContractAddress, ethTxHelper.GetClient(), bsc.contractsABI)
 	if err != nil {
 		return fmt.Errorf("error while NewGateway: %w", bsc.ethHelper.ProcessError(err))
 	}
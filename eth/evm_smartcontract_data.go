@@ -6,12 +6,51 @@ import (
 	"strings"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	goethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 var (
 	assetTransferTxAbi, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{
+			Name: "batchId",
+			Type: "uint64",
+		},
+		{
+			Name: "ttlExpired",
+			Type: "uint64",
+		},
+		{
+			Name: "feeAmount",
+			Type: "uint256",
+		},
+		{
+			Name:         "receivers",
+			Type:         "tuple[]",
+			InternalType: "struct IGatewayStructs.ReceiverDeposit[]",
+			Components: []abi.ArgumentMarshaling{
+				{
+					Name: "receiver",
+					Type: "address",
+				},
+				{
+					Name: "amount",
+					Type: "uint256",
+				},
+				{
+					Name: "token",
+					Type: "address",
+				},
+				{
+					Name: "tokenAmount",
+					Type: "uint256",
+				},
+			},
+		},
+	})
+	// legacyAssetTransferTxAbi is the batch layout of the gateway contracts released before the native tokens
+	legacyAssetTransferTxAbi, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
 		{
 			Name: "batchId",
 			Type: "uint64",
@@ -67,9 +106,13 @@ var (
 	})
 )
 
+// EVMSmartContractTransactionReceiver is a receiver of the batch. TokenAmount of the erc20 Token
+// is sent besides the currency Amount, the zero token address is used for the receivers without tokens
 type EVMSmartContractTransactionReceiver struct {
-	Address goethcommon.Address `json:"addr" abi:"receiver"`
-	Amount  *big.Int            `json:"amount" abi:"amount"`
+	Address     goethcommon.Address `json:"addr" abi:"receiver"`
+	Amount      *big.Int            `json:"amount" abi:"amount"`
+	Token       goethcommon.Address `json:"token" abi:"token"`
+	TokenAmount *big.Int            `json:"tokenAmount" abi:"tokenAmount"`
 }
 
 type EVMSmartContractTransaction struct {
@@ -79,8 +122,29 @@ type EVMSmartContractTransaction struct {
 	Receivers    []EVMSmartContractTransactionReceiver `json:"receivers" abi:"receivers"`
 }
 
-func NewEVMSmartContractTransaction(bytes []byte) (*EVMSmartContractTransaction, error) {
-	dt, err := abi.Arguments{{Type: assetTransferTxAbi}}.Unpack(bytes)
+type legacyEVMSmartContractTransactionReceiver struct {
+	Address goethcommon.Address `abi:"receiver"`
+	Amount  *big.Int            `abi:"amount"`
+}
+
+// legacyEVMSmartContractTransaction is the batch of the gateway contracts released before the native tokens
+type legacyEVMSmartContractTransaction struct {
+	BatchNonceID uint64                                      `abi:"batchId"`
+	TTL          uint64                                      `abi:"ttlExpired"`
+	FeeAmount    *big.Int                                    `abi:"feeAmount"`
+	Receivers    []legacyEVMSmartContractTransactionReceiver `abi:"receivers"`
+}
+
+// NewEVMSmartContractTransaction unpacks the batch in the layout of the gateway bound with contractsABI
+func NewEVMSmartContractTransaction(
+	bytes []byte, contractsABI contractbinding.ContractsABI,
+) (*EVMSmartContractTransaction, error) {
+	txAbi := assetTransferTxAbi
+	if contractsABI == contractbinding.LegacyContractsABI {
+		txAbi = legacyAssetTransferTxAbi
+	}
+
+	dt, err := abi.Arguments{{Type: txAbi}}.Unpack(bytes)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +154,26 @@ func NewEVMSmartContractTransaction(bytes []byte) (*EVMSmartContractTransaction,
 	return tx, nil
 }
 
-func (evmsctx *EVMSmartContractTransaction) Pack() ([]byte, error) {
+// Pack packs the batch in the layout of the gateway bound with contractsABI
+func (evmsctx *EVMSmartContractTransaction) Pack(contractsABI contractbinding.ContractsABI) ([]byte, error) {
+	if contractsABI == contractbinding.LegacyContractsABI {
+		legacyTx := legacyEVMSmartContractTransaction{
+			BatchNonceID: evmsctx.BatchNonceID,
+			TTL:          evmsctx.TTL,
+			FeeAmount:    evmsctx.FeeAmount,
+			Receivers:    make([]legacyEVMSmartContractTransactionReceiver, 0, len(evmsctx.Receivers)),
+		}
+
+		for _, receiver := range evmsctx.Receivers {
+			legacyTx.Receivers = append(legacyTx.Receivers, legacyEVMSmartContractTransactionReceiver{
+				Address: receiver.Address,
+				Amount:  receiver.Amount,
+			})
+		}
+
+		return abi.Arguments{{Type: legacyAssetTransferTxAbi}}.Pack(legacyTx)
+	}
+
 	return abi.Arguments{{Type: assetTransferTxAbi}}.Pack(evmsctx)
 }
 
@@ -114,6 +197,14 @@ func (evmsctx EVMSmartContractTransaction) String() string {
 		sb.WriteString(v.Address.String())
 		sb.WriteRune(',')
 		sb.WriteString(v.Amount.String())
+
+		if v.TokenAmount != nil && v.TokenAmount.Sign() > 0 {
+			sb.WriteRune(',')
+			sb.WriteString(v.Token.String())
+			sb.WriteRune(',')
+			sb.WriteString(v.TokenAmount.String())
+		}
+
 		sb.WriteRune(')')
 	}
 
@@ -157,7 +248,7 @@ func (t EVMValidatorSetChangeTx) String() string {
 	sb.WriteString(fmt.Sprintf("%s\n", t.ValidatorsSetNumber))
 	sb.WriteString("validator chain data = ")
 	sb.WriteString(
-		fmt.Sprintf("%s\n", GetChainValidatorsDataInfoString(common.ChainIDStrNexus, t.ValidatorsChainData)))
+		fmt.Sprintf("%s\n", getValidatorsDataInfoString(common.ChainTypeEVM, t.ValidatorsChainData)))
 
 	return sb.String()
 }
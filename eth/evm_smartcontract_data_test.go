@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,26 +16,84 @@ func TestEVMSmartContractTransaction(t *testing.T) {
 		FeeAmount:    big.NewInt(1),
 		Receivers: []EVMSmartContractTransactionReceiver{
 			{
-				Address: common.HexToAddress("0xFF00FF"),
-				Amount:  new(big.Int).SetUint64(100),
+				Address:     common.HexToAddress("0xFF00FF"),
+				Amount:      new(big.Int).SetUint64(100),
+				Token:       common.HexToAddress("0xAB"),
+				TokenAmount: big.NewInt(7),
 			},
 			{
-				Address: common.HexToAddress("0xFF0011"),
-				Amount:  new(big.Int).SetUint64(3),
+				Address:     common.HexToAddress("0xFF0011"),
+				Amount:      new(big.Int).SetUint64(3),
+				Token:       common.HexToAddress("0xAB"),
+				TokenAmount: big.NewInt(1),
 			},
 			{
-				Address: common.HexToAddress("0xFF0022"),
-				Amount:  new(big.Int).SetUint64(531),
+				Address:     common.HexToAddress("0xFF0022"),
+				Amount:      new(big.Int).SetUint64(531),
+				Token:       common.HexToAddress("0xCD"),
+				TokenAmount: big.NewInt(9),
 			},
 		},
 	}
 
-	bytes, err := obj.Pack()
+	bytes, err := obj.Pack(contractbinding.NativeTokensContractsABI)
 	require.NoError(t, err)
 
-	newObj, err := NewEVMSmartContractTransaction(bytes)
+	newObj, err := NewEVMSmartContractTransaction(bytes, contractbinding.NativeTokensContractsABI)
 	require.NoError(t, err)
 	require.Equal(t, obj, newObj)
+
+	t.Run("legacy layout", func(t *testing.T) {
+		legacyBytes, err := obj.Pack(contractbinding.LegacyContractsABI)
+		require.NoError(t, err)
+		require.Less(t, len(legacyBytes), len(bytes))
+
+		newObj, err := NewEVMSmartContractTransaction(legacyBytes, contractbinding.LegacyContractsABI)
+		require.NoError(t, err)
+		require.Len(t, newObj.Receivers, len(obj.Receivers))
+
+		for i, receiver := range newObj.Receivers {
+			require.Equal(t, obj.Receivers[i].Address, receiver.Address)
+			require.Equal(t, obj.Receivers[i].Amount, receiver.Amount)
+			require.Nil(t, receiver.TokenAmount)
+		}
+	})
+}
+
+func TestContractsABI(t *testing.T) {
+	nativeTokensABI, err := contractbinding.NativeTokensContractsABI.GatewayMetaData().GetAbi()
+	require.NoError(t, err)
+
+	legacyABI, err := contractbinding.LegacyContractsABI.GatewayMetaData().GetAbi()
+	require.NoError(t, err)
+
+	require.NotEqual(t, nativeTokensABI.Methods["withdraw"].ID, legacyABI.Methods["withdraw"].ID)
+	require.NotEqual(t, nativeTokensABI.Events["Withdraw"].ID, legacyABI.Events["Withdraw"].ID)
+	require.Equal(t, []byte{0x26, 0x02, 0x1e, 0x91}, legacyABI.Methods["withdraw"].ID)
+
+	// token fields of the receivers are ignored by the legacy abi
+	_, err = legacyABI.Pack("withdraw", uint8(1), []contractbinding.IGatewayStructsReceiverWithdraw{
+		{
+			Receiver:    "addr_test1vq",
+			Amount:      big.NewInt(100),
+			Token:       common.HexToAddress("0xAB"),
+			TokenAmount: big.NewInt(7),
+		},
+	}, big.NewInt(10))
+	require.NoError(t, err)
+
+	nativeSigs, err := GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
+	require.NoError(t, err)
+
+	legacySigs, err := GetNexusEventSignatures(contractbinding.LegacyContractsABI)
+	require.NoError(t, err)
+	require.Equal(t, nativeSigs[0], legacySigs[0])
+	require.NotEqual(t, nativeSigs[1], legacySigs[1])
+
+	legacyGateway, err := contractbinding.NewGatewayWithABI(
+		common.HexToAddress("0x01"), nil, contractbinding.LegacyContractsABI)
+	require.NoError(t, err)
+	require.NotNil(t, legacyGateway)
 }
 
 func TestEVMValidatorSetChangeTransaction(t *testing.T) {
@@ -28,21 +28,24 @@ type IOracleBridgeSmartContract interface {
 	SubmitClaims(ctx context.Context, claims Claims, submitOpts *SubmitOpts) (*types.Receipt, error)
 	SubmitLastObservedBlocks(ctx context.Context, chainID string, blocks []CardanoBlock) error
 	GetBatchStatusAndTransactions(ctx context.Context, chainID string, batchID uint64) (uint8, []TxDataInfo, error)
+	GetConfirmedTransactions(ctx context.Context, destinationChain string) ([]ConfirmedTransaction, error)
 }
 
 type OracleBridgeSmartContractImpl struct {
 	smartContractAddress ethcommon.Address
 	ethHelper            *EthHelperWrapper
+	contractsABI         contractbinding.ContractsABI
 }
 
 var _ IOracleBridgeSmartContract = (*OracleBridgeSmartContractImpl)(nil)
 
 func NewOracleBridgeSmartContract(
-	smartContractAddress string, ethHelper *EthHelperWrapper,
+	smartContractAddress string, ethHelper *EthHelperWrapper, contractsABI contractbinding.ContractsABI,
 ) *OracleBridgeSmartContractImpl {
 	return &OracleBridgeSmartContractImpl{
 		smartContractAddress: ethcommon.HexToAddress(smartContractAddress),
 		ethHelper:            ethHelper,
+		contractsABI:         contractsABI,
 	}
 }
 
@@ -54,9 +57,10 @@ func (bsc *OracleBridgeSmartContractImpl) GetLastObservedBlock(
 		return CardanoBlock{}, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return CardanoBlock{}, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -79,9 +83,10 @@ func (bsc *OracleBridgeSmartContractImpl) GetRawTransactionAndBatchTypeFromLastB
 		return nil, 0, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -104,9 +109,10 @@ func (bsc *OracleBridgeSmartContractImpl) SubmitClaims(
 		return nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -134,9 +140,10 @@ func (bsc *OracleBridgeSmartContractImpl) SubmitLastObservedBlocks(
 		return fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -159,9 +166,10 @@ func (bsc *OracleBridgeSmartContractImpl) GetBatchStatusAndTransactions(
 		return 0, nil, fmt.Errorf("error while GetEthHelper: %w", err)
 	}
 
-	contract, err := contractbinding.NewBridgeContract(
+	contract, err := contractbinding.NewBridgeContractWithABI(
 		bsc.smartContractAddress,
-		ethTxHelper.GetClient())
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
 	if err != nil {
 		return 0, nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
 	}
@@ -175,3 +183,29 @@ func (bsc *OracleBridgeSmartContractImpl) GetBatchStatusAndTransactions(
 
 	return result.Status, result.Txs, nil
 }
+
+func (bsc *OracleBridgeSmartContractImpl) GetConfirmedTransactions(
+	ctx context.Context, destinationChain string,
+) ([]ConfirmedTransaction, error) {
+	ethTxHelper, err := bsc.ethHelper.GetEthHelper()
+	if err != nil {
+		return nil, fmt.Errorf("error while GetEthHelper: %w", err)
+	}
+
+	contract, err := contractbinding.NewBridgeContractWithABI(
+		bsc.smartContractAddress,
+		ethTxHelper.GetClient(),
+		bsc.contractsABI)
+	if err != nil {
+		return nil, fmt.Errorf("error while NewBridgeContract: %w", bsc.ethHelper.ProcessError(err))
+	}
+
+	txs, err := contract.GetConfirmedTransactions(&bind.CallOpts{
+		Context: ctx,
+	}, common.ToNumChainID(destinationChain))
+	if err != nil {
+		return nil, fmt.Errorf("error while GetConfirmedTransactions: %w", bsc.ethHelper.ProcessError(err))
+	}
+
+	return txs, nil
+}
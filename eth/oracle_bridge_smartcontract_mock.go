@@ -73,4 +73,14 @@ func (m *OracleBridgeSmartContractMock) SubmitLastObservedBlocks(
 	return args.Error(0)
 }
 
+// GetConfirmedTransactions implements IOracleBridgeSmartContract.
+func (m *OracleBridgeSmartContractMock) GetConfirmedTransactions(
+	ctx context.Context, destinationChain string,
+) ([]ConfirmedTransaction, error) {
+	args := m.Called(ctx, destinationChain)
+	txs, _ := args.Get(0).([]ConfirmedTransaction)
+
+	return txs, args.Error(1)
+}
+
 var _ IOracleBridgeSmartContract = (*OracleBridgeSmartContractMock)(nil)
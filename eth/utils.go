@@ -103,8 +103,8 @@ func GetEventSignatures(abi *abi.ABI, events []string) ([]ethgo.Hash, error) {
 	return hashes, nil
 }
 
-func GetNexusEventSignatures() ([]ethgo.Hash, error) {
-	abi, err := contractbinding.GatewayMetaData.GetAbi()
+func GetNexusEventSignatures(contractsABI contractbinding.ContractsABI) ([]ethgo.Hash, error) {
+	abi, err := contractsABI.GatewayMetaData().GetAbi()
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +124,16 @@ func GetSubmitClaimsEventSignatures() ([]ethgo.Hash, error) {
 func GetChainValidatorsDataInfoString(
 	chainID string, data []ValidatorChainData,
 ) string {
+	chainType := uint8(common.ChainTypeCardano)
+	if common.IsEVMChainID(chainID) {
+		chainType = common.ChainTypeEVM
+	}
+
+	return getValidatorsDataInfoString(chainType, data)
+}
+
+// getValidatorsDataInfoString returns bls keys for evm chains and cardano keys for cardano chains
+func getValidatorsDataInfoString(chainType uint8, data []ValidatorChainData) string {
 	var sb strings.Builder
 
 	for i, x := range data {
@@ -131,11 +141,11 @@ func GetChainValidatorsDataInfoString(
 			sb.WriteString(", ")
 		}
 
-		switch chainID {
-		case common.ChainIDStrNexus:
+		switch chainType {
+		case common.ChainTypeEVM:
 			pub, err := bn256.UnmarshalPublicKeyFromBigInt(x.Key)
 			if err != nil {
-				return fmt.Sprintf("failed to unmarshal bls key for %s, error: %s", chainID, err)
+				return fmt.Sprintf("failed to unmarshal bls key, error: %s", err)
 			}
 
 			sb.WriteString(hex.EncodeToString(pub.Marshal()))
@@ -0,0 +1,137 @@
+package health
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ComponentStatus is the last known state of a long running component
+type ComponentStatus struct {
+	Name        string
+	LastTick    time.Time
+	LastError   string
+	LastErrorAt time.Time
+}
+
+// ChainSyncStatus is the last known bridge synchronization state of a chain
+type ChainSyncStatus struct {
+	ChainID   string
+	IsSynced  bool
+	UpdatedAt time.Time
+}
+
+type tracker struct {
+	lock       sync.RWMutex
+	components map[string]*ComponentStatus
+	chains     map[string]*ChainSyncStatus
+}
+
+// components report their progress here, similar to how metrics are reported to telemetry
+var defaultTracker = newTracker()
+
+func newTracker() *tracker {
+	return &tracker{
+		components: map[string]*ComponentStatus{},
+		chains:     map[string]*ChainSyncStatus{},
+	}
+}
+
+func (t *tracker) getComponent(name string) *ComponentStatus {
+	component, exists := t.components[name]
+	if !exists {
+		component = &ComponentStatus{Name: name}
+		t.components[name] = component
+	}
+
+	return component
+}
+
+// ComponentName builds component name from its parts, for example batcher.prime
+func ComponentName(parts ...string) string {
+	return strings.Join(parts, ".")
+}
+
+// RegisterComponent marks the component as expected. It is reported as unhealthy until its first tick
+func RegisterComponent(component string) {
+	defaultTracker.lock.Lock()
+	defer defaultTracker.lock.Unlock()
+
+	defaultTracker.getComponent(component)
+}
+
+// ReportTick should be called by a component after every successfully finished iteration
+func ReportTick(component string) {
+	defaultTracker.lock.Lock()
+	defer defaultTracker.lock.Unlock()
+
+	defaultTracker.getComponent(component).LastTick = time.Now().UTC()
+}
+
+// ReportError should be called by a component when an iteration fails
+func ReportError(component string, err error) {
+	if err == nil {
+		return
+	}
+
+	defaultTracker.lock.Lock()
+	defer defaultTracker.lock.Unlock()
+
+	status := defaultTracker.getComponent(component)
+	status.LastError = err.Error()
+	status.LastErrorAt = time.Now().UTC()
+}
+
+// ReportChainSync stores whether the bridge is synchronized with the chain
+func ReportChainSync(chainID string, isSynced bool) {
+	defaultTracker.lock.Lock()
+	defer defaultTracker.lock.Unlock()
+
+	defaultTracker.chains[chainID] = &ChainSyncStatus{
+		ChainID:   chainID,
+		IsSynced:  isSynced,
+		UpdatedAt: time.Now().UTC(),
+	}
+}
+
+// GetComponents returns the statuses of all the registered or reported components, sorted by name
+func GetComponents() []ComponentStatus {
+	defaultTracker.lock.RLock()
+	defer defaultTracker.lock.RUnlock()
+
+	result := make([]ComponentStatus, 0, len(defaultTracker.components))
+	for _, x := range defaultTracker.components {
+		result = append(result, *x)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// GetChainSync returns the last reported bridge sync status for the chain
+func GetChainSync(chainID string) (ChainSyncStatus, bool) {
+	defaultTracker.lock.RLock()
+	defer defaultTracker.lock.RUnlock()
+
+	status, exists := defaultTracker.chains[chainID]
+	if !exists {
+		return ChainSyncStatus{ChainID: chainID}, false
+	}
+
+	return *status, true
+}
+
+// Reset clears everything reported so far (used by tests)
+func Reset() {
+	newTracker := newTracker()
+
+	defaultTracker.lock.Lock()
+	defer defaultTracker.lock.Unlock()
+
+	defaultTracker.components = newTracker.components
+	defaultTracker.chains = newTracker.chains
+}
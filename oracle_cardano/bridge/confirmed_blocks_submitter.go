@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	"github.com/Ethernal-Tech/apex-bridge/health"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
 	oracleCommon "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	"github.com/Ethernal-Tech/apex-bridge/validatorobserver"
@@ -59,6 +60,8 @@ func NewConfirmedBlocksSubmitter(
 		}
 	}
 
+	health.RegisterComponent(health.ComponentName("confirmed_blocks_submitter", chainID))
+
 	return &ConfirmedBlocksSubmitterImpl{
 		bridgeSubmitter:      bridgeSubmitter,
 		appConfig:            appConfig,
@@ -82,6 +85,9 @@ func (bs *ConfirmedBlocksSubmitterImpl) Start(ctx context.Context) {
 			case <-time.After(waitTime):
 				if err := bs.execute(); err != nil {
 					bs.logger.Error("error while executing", "chainID", bs.chainID, "err", err)
+					health.ReportError(health.ComponentName("confirmed_blocks_submitter", bs.chainID), err)
+				} else {
+					health.ReportTick(health.ComponentName("confirmed_blocks_submitter", bs.chainID))
 				}
 			}
 		}
@@ -43,6 +43,10 @@ func NewCardanoChainObserver(
 	indexerDB indexer.Database,
 	logger hclog.Logger,
 ) (*CardanoChainObserverImpl, error) {
+	if err := config.ConfirmationTiers.Validate(); err != nil {
+		return nil, err
+	}
+
 	indexerConfig, syncerConfig := loadSyncerConfigs(config)
 
 	err := initOracleState(indexerDB,
@@ -15,6 +15,16 @@ type CardanoTx struct {
 	BatchTryCount  uint32    `json:"bf_count"`
 	RefundTryCount uint32    `json:"refund_try_count"`
 	LastTimeTried  time.Time `json:"last_time_tried"`
+	// try counts are retry counters of the claims, so they are never reset.
+	// Instead, an operator requeue sets the offsets and the limits apply only to the tries made after it
+	BatchTryCountOffset  uint32 `json:"bf_count_offset,omitempty"`
+	RefundTryCountOffset uint32 `json:"refund_try_count_offset,omitempty"`
+	// ForceRefund is set by an operator to hand the bridging request over to the refund processor
+	ForceRefund bool `json:"force_refund,omitempty"`
+	// TryTimes are the times of all failed tries, kept for the dead-letter txs
+	TryTimes []time.Time `json:"try_times,omitempty"`
+	// LastError is the reason of the last failed try
+	LastError string `json:"last_error,omitempty"`
 
 	indexer.Tx
 }
@@ -47,6 +57,8 @@ var _ cCore.BaseExpectedTx = (*BridgeExpectedCardanoTx)(nil)
 
 type CardanoUpdateTxsData = cCore.UpdateTxsData[*CardanoTx, *ProcessedCardanoTx, *BridgeExpectedCardanoTx]
 
+type CardanoDeadLetterTx = cCore.DeadLetterTx[*CardanoTx]
+
 // ChainID implements core.BaseTx.
 func (tx CardanoTx) GetChainID() string {
 	return tx.OriginChainID
@@ -67,11 +79,29 @@ func (tx *CardanoTx) SetLastTimeTried(lastTimeTried time.Time) {
 	tx.LastTimeTried = lastTimeTried
 }
 
+// AddTryTime implements core.BaseTx.
+func (tx *CardanoTx) AddTryTime(tryTime time.Time) {
+	tx.TryTimes = append(tx.TryTimes, tryTime)
+}
+
+// SetLastError implements core.BaseTx.
+func (tx *CardanoTx) SetLastError(lastError string) {
+	tx.LastError = lastError
+}
+
 // ResetSubmitTryCount implements core.BaseTx.
 func (tx *CardanoTx) ResetSubmitTryCount() {
 	tx.SubmitTryCount = 0
 }
 
+// ResetTryCounts gives the tx a fresh set of tries without changing the claims retry counters
+func (tx *CardanoTx) ResetTryCounts() {
+	tx.SubmitTryCount = 0
+	tx.LastTimeTried = time.Time{}
+	tx.BatchTryCountOffset = tx.BatchTryCount
+	tx.RefundTryCountOffset = tx.RefundTryCount
+}
+
 // IncrementBatchTryCount implements core.BaseTx.
 func (tx *CardanoTx) IncrementBatchTryCount() {
 	tx.BatchTryCount++
@@ -16,11 +16,18 @@ type BridgeExpectedCardanoTxsDB interface {
 type CardanoTxsDB interface {
 	GetUnprocessedTxs(chainID string, priority uint8, threshold int) ([]*CardanoTx, error)
 	GetAllUnprocessedTxs(chainID string, threshold int) ([]*CardanoTx, error)
+	GetAllPendingTxs(chainID string, threshold int) ([]*CardanoTx, error)
 	GetPendingTx(entityID cCore.DBTxID) (cCore.BaseTx, error)
+	GetAllProcessedTxs(chainID string, threshold int) ([]*ProcessedCardanoTx, error)
 	GetProcessedTx(entityID cCore.DBTxID) (*ProcessedCardanoTx, error)
 	GetUnprocessedBatchEvents(chainID string) ([]*cCore.DBBatchInfoEvent, error)
 	AddTxs(processedTxs []*ProcessedCardanoTx, unprocessedTxs []*CardanoTx) error
 	ClearAllTxs(chainID string) error
+	RequeueTx(chainID string, txHash []byte, update func(tx *CardanoTx) error) (*CardanoTx, error)
+	InvalidateTx(chainID string, txHash []byte) (*CardanoTx, error)
+	GetDeadLetterTxs(chainID string, threshold int) ([]*CardanoDeadLetterTx, error)
+	GetDeadLetterTxsCount(chainID string) (int, error)
+	ReplayDeadLetterTx(chainID string, txHash []byte, update func(tx *CardanoTx) error) (*CardanoTx, error)
 	MoveProcessedExpectedTxs(chainID string) error
 	UpdateTxs(data *CardanoUpdateTxsData) error
 }
@@ -29,6 +36,8 @@ type CardanoTxsProcessorDB interface {
 	CardanoTxsDB
 	BridgeExpectedCardanoTxsDB
 	cCore.BlockSubmitterDB
+	cCore.OutflowRecordsDB
+	cCore.ManualApprovalsDB
 }
 
 type Database interface {
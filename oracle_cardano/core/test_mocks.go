@@ -79,6 +79,45 @@ func (m *CardanoTxsProcessorDBMock) GetBlocksSubmitterInfo(chainID string) (cCor
 	return args.Get(0).(cCore.BlocksSubmitterInfo), args.Error(1) //nolint
 }
 
+// GetOutflowRecords implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) GetOutflowRecords() ([]*cCore.OutflowRecord, error) {
+	args := m.Called()
+
+	return args.Get(0).([]*cCore.OutflowRecord), args.Error(1) //nolint
+}
+
+// RemoveOutflowRecords implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) RemoveOutflowRecords(records []*cCore.OutflowRecord) error {
+	args := m.Called(records)
+
+	return args.Error(0)
+}
+
+// GetManualApproval implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) GetManualApproval(
+	chainID string, txHash common.Hash,
+) (*cCore.ManualApproval, error) {
+	args := m.Called(chainID, txHash)
+
+	return args.Get(0).(*cCore.ManualApproval), args.Error(1) //nolint
+}
+
+// GetManualApprovals implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) GetManualApprovals() ([]*cCore.ManualApproval, error) {
+	args := m.Called()
+
+	return args.Get(0).([]*cCore.ManualApproval), args.Error(1) //nolint
+}
+
+// ApproveManualApproval implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) ApproveManualApproval(
+	chainID string, txHash common.Hash, operator string,
+) (*cCore.ManualApproval, error) {
+	args := m.Called(chainID, txHash, operator)
+
+	return args.Get(0).(*cCore.ManualApproval), args.Error(1) //nolint
+}
+
 // SetBlocksSubmitterInfo implements CardanoTxsProcessorDB.
 func (m *CardanoTxsProcessorDBMock) SetBlocksSubmitterInfo(chainID string, info cCore.BlocksSubmitterInfo) error {
 	args := m.Called(chainID, info)
@@ -119,6 +158,65 @@ func (m *CardanoTxsProcessorDBMock) ClearAllTxs(chainID string) error {
 	return args.Error(0)
 }
 
+// RequeueTx implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) RequeueTx(
+	chainID string, txHash []byte, update func(tx *CardanoTx) error,
+) (*CardanoTx, error) {
+	args := m.Called(chainID, txHash, update)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).(*CardanoTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// InvalidateTx implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) InvalidateTx(chainID string, txHash []byte) (*CardanoTx, error) {
+	args := m.Called(chainID, txHash)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).(*CardanoTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// GetDeadLetterTxs implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) GetDeadLetterTxs(chainID string, threshold int) ([]*CardanoDeadLetterTx, error) {
+	args := m.Called(chainID, threshold)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).([]*CardanoDeadLetterTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// GetDeadLetterTxsCount implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) GetDeadLetterTxsCount(chainID string) (int, error) {
+	args := m.Called(chainID)
+
+	return args.Int(0), args.Error(1)
+}
+
+// ReplayDeadLetterTx implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) ReplayDeadLetterTx(
+	chainID string, txHash []byte, update func(tx *CardanoTx) error,
+) (*CardanoTx, error) {
+	args := m.Called(chainID, txHash, update)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).(*CardanoTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
 // GetAllExpectedTxs implements CardanoTxsProcessorDB.
 func (m *CardanoTxsProcessorDBMock) GetAllExpectedTxs(
 	chainID string, threshold int,
@@ -145,6 +243,30 @@ func (m *CardanoTxsProcessorDBMock) GetAllUnprocessedTxs(chainID string, thresho
 	return nil, args.Error(1)
 }
 
+// GetAllPendingTxs implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) GetAllPendingTxs(chainID string, threshold int) ([]*CardanoTx, error) {
+	args := m.Called(chainID, threshold)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).([]*CardanoTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// GetAllProcessedTxs implements CardanoTxsProcessorDB.
+func (m *CardanoTxsProcessorDBMock) GetAllProcessedTxs(chainID string, threshold int) ([]*ProcessedCardanoTx, error) {
+	args := m.Called(chainID, threshold)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).([]*ProcessedCardanoTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
 // GetExpectedTxs implements CardanoTxsProcessorDB.
 func (m *CardanoTxsProcessorDBMock) GetExpectedTxs(
 	chainID string, priority uint8, threshold int,
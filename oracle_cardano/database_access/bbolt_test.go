@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
@@ -12,6 +13,7 @@ import (
 	cDatabaseaccess "github.com/Ethernal-Tech/apex-bridge/oracle_common/database_access"
 	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
 )
 
 func TestBoltDatabase(t *testing.T) {
@@ -809,4 +811,188 @@ func TestBoltDatabase(t *testing.T) {
 		require.NotNil(t, txs)
 		require.Len(t, txs, 1)
 	})
+
+	t.Run("RequeueTx and InvalidateTx", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		db, err := createDB(filePath)
+		require.NoError(t, err)
+
+		const primeChainID = common.ChainIDStrPrime
+
+		unprocessedTx := &core.CardanoTx{
+			OriginChainID: primeChainID, Priority: 1, SubmitTryCount: 5, BatchTryCount: 2,
+			Tx: indexer.Tx{Hash: indexer.Hash{1}, BlockSlot: 10},
+		}
+		pendingTx := &core.CardanoTx{
+			OriginChainID: primeChainID, Priority: 1, BatchTryCount: 1,
+			Tx: indexer.Tx{Hash: indexer.Hash{2}, BlockSlot: 11},
+		}
+
+		require.NoError(t, db.AddTxs(nil, []*core.CardanoTx{unprocessedTx, pendingTx}))
+		require.NoError(t, db.UpdateTxs(&core.CardanoUpdateTxsData{
+			MoveUnprocessedToPending: []*core.CardanoTx{pendingTx},
+		}))
+
+		_, err = db.RequeueTx(common.ChainIDStrNexus, unprocessedTx.Hash[:], nil)
+		require.ErrorContains(t, err, "unsupported chain")
+
+		_, err = db.RequeueTx(primeChainID, []byte{3}, func(tx *core.CardanoTx) error { return nil })
+		require.ErrorContains(t, err, "not found")
+
+		tx, err := db.RequeueTx(primeChainID, unprocessedTx.Hash[:], func(tx *core.CardanoTx) error {
+			tx.ResetTryCounts()
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), tx.SubmitTryCount)
+		require.Equal(t, uint32(2), tx.BatchTryCountOffset)
+
+		tx, err = db.RequeueTx(primeChainID, pendingTx.Hash[:], func(tx *core.CardanoTx) error {
+			tx.ForceRefund = true
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.True(t, tx.ForceRefund)
+
+		pendingTxs, err := db.GetAllPendingTxs(primeChainID, 0)
+		require.NoError(t, err)
+		require.Empty(t, pendingTxs)
+
+		unprocessedTxs, err := db.GetAllUnprocessedTxs(primeChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, unprocessedTxs, 2)
+		require.Equal(t, uint32(2), unprocessedTxs[0].BatchTryCountOffset)
+		require.True(t, unprocessedTxs[1].ForceRefund)
+
+		_, err = db.InvalidateTx(primeChainID, unprocessedTx.Hash[:])
+		require.NoError(t, err)
+
+		processedTx, err := db.GetProcessedTx(cCore.DBTxID{ChainID: primeChainID, DBKey: unprocessedTx.Hash[:]})
+		require.NoError(t, err)
+		require.NotNil(t, processedTx)
+		require.True(t, processedTx.IsInvalid)
+
+		_, err = db.InvalidateTx(primeChainID, unprocessedTx.Hash[:])
+		require.ErrorContains(t, err, "already processed")
+
+		unprocessedTxs, err = db.GetAllUnprocessedTxs(primeChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, unprocessedTxs, 1)
+	})
+
+	t.Run("unprocessed txs index", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		db, err := createDB(filePath)
+		require.NoError(t, err)
+
+		const primeChainID = common.ChainIDStrPrime
+
+		txs := []*core.CardanoTx{
+			{OriginChainID: primeChainID, Priority: 1, Tx: indexer.Tx{Hash: indexer.Hash{1}, BlockSlot: 10}},
+			{OriginChainID: primeChainID, Priority: 0, Tx: indexer.Tx{Hash: indexer.Hash{2}, BlockSlot: 11}},
+			{OriginChainID: primeChainID, Priority: 1, Tx: indexer.Tx{Hash: indexer.Hash{3}, BlockSlot: 12}},
+		}
+
+		require.NoError(t, db.AddTxs(nil, txs))
+
+		// simulate a database created before the index existed
+		require.NoError(t, db.DB.Update(func(tx *bbolt.Tx) error {
+			return tx.DeleteBucket(cDatabaseaccess.ChainBucket(cDatabaseaccess.UnprocessedTxsByHashBucket, primeChainID))
+		}))
+		require.NoError(t, db.DB.Close())
+
+		db, err = createDB(filePath)
+		require.NoError(t, err)
+
+		tx, err := db.RequeueTx(primeChainID, txs[1].Hash[:], func(tx *core.CardanoTx) error { return nil })
+		require.NoError(t, err)
+		require.Equal(t, txs[1].Hash, tx.Hash)
+
+		require.NoError(t, db.UpdateTxs(&core.CardanoUpdateTxsData{
+			MoveUnprocessedToProcessed: []*core.ProcessedCardanoTx{txs[2].ToProcessedCardanoTx(false)},
+		}))
+
+		_, err = db.RequeueTx(primeChainID, txs[2].Hash[:], func(tx *core.CardanoTx) error { return nil })
+		require.ErrorContains(t, err, "already processed")
+
+		withdrawnTx, err := db.WithdrawTx(primeChainID, txs[0].Hash[:], func(_ *core.CardanoTx, isPending bool) bool {
+			return !isPending
+		})
+		require.NoError(t, err)
+		require.Equal(t, txs[0].Hash, withdrawnTx.Hash)
+
+		require.NoError(t, db.ClearAllTxs(primeChainID))
+
+		withdrawnTx, err = db.WithdrawTx(primeChainID, txs[1].Hash[:], func(*core.CardanoTx, bool) bool {
+			return true
+		})
+		require.NoError(t, err)
+		require.Nil(t, withdrawnTx)
+	})
+
+	t.Run("DeadLetterTxs", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		db, err := createDB(filePath)
+		require.NoError(t, err)
+
+		const primeChainID = common.ChainIDStrPrime
+
+		tryTime := time.Now().UTC().Truncate(time.Second)
+		tx := &core.CardanoTx{
+			OriginChainID: primeChainID, Priority: 1, RefundTryCount: 4, TryTimes: []time.Time{tryTime},
+			Tx: indexer.Tx{Hash: indexer.Hash{1}, BlockSlot: 10},
+		}
+
+		require.NoError(t, db.AddTxs(nil, []*core.CardanoTx{tx}))
+		require.NoError(t, db.UpdateTxs(&core.CardanoUpdateTxsData{
+			MoveUnprocessedToProcessed: []*core.ProcessedCardanoTx{tx.ToProcessedCardanoTx(true)},
+			AddDeadLetterTxs: []*core.CardanoDeadLetterTx{
+				{Tx: tx, LastError: "try count exceeded", CreatedAt: tryTime},
+			},
+		}))
+
+		deadLetterTxs, err := db.GetDeadLetterTxs(primeChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, deadLetterTxs, 1)
+		require.Equal(t, "try count exceeded", deadLetterTxs[0].LastError)
+		require.Equal(t, tx.Hash, deadLetterTxs[0].Tx.Hash)
+		require.Len(t, deadLetterTxs[0].Tx.TryTimes, 1)
+		require.True(t, tryTime.Equal(deadLetterTxs[0].Tx.TryTimes[0]))
+
+		cnt, err := db.GetDeadLetterTxsCount(primeChainID)
+		require.NoError(t, err)
+		require.Equal(t, 1, cnt)
+
+		_, err = db.GetDeadLetterTxsCount(common.ChainIDStrNexus)
+		require.ErrorContains(t, err, "unsupported chain")
+
+		_, err = db.ReplayDeadLetterTx(primeChainID, []byte{2}, func(tx *core.CardanoTx) error { return nil })
+		require.ErrorContains(t, err, "not found")
+
+		replayedTx, err := db.ReplayDeadLetterTx(primeChainID, tx.Hash[:], func(tx *core.CardanoTx) error {
+			tx.ResetTryCounts()
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(4), replayedTx.RefundTryCountOffset)
+
+		cnt, err = db.GetDeadLetterTxsCount(primeChainID)
+		require.NoError(t, err)
+		require.Equal(t, 0, cnt)
+
+		processedTx, err := db.GetProcessedTx(cCore.DBTxID{ChainID: primeChainID, DBKey: tx.Hash[:]})
+		require.NoError(t, err)
+		require.Nil(t, processedTx)
+
+		unprocessedTxs, err := db.GetAllUnprocessedTxs(primeChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, unprocessedTxs, 1)
+		require.Equal(t, uint32(4), unprocessedTxs[0].RefundTryCountOffset)
+	})
 }
@@ -48,6 +48,7 @@ func NewCardanoOracle(
 	indexerDbs map[string]indexer.Database,
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater,
 	validatorSetObserver validatorobserver.IValidatorSetObserver,
+	addressScreener cCore.AddressScreener,
 	logger hclog.Logger,
 ) (*OracleImpl, error) {
 	db := &databaseaccess.BBoltDatabase{}
@@ -82,7 +83,7 @@ func NewCardanoOracle(
 
 	successProcessors = append(successProcessors,
 		successtxprocessors.NewBatchExecutedProcessor(logger),
-		successtxprocessors.NewBridgingRequestedProcessor(refundRequestProcessor, logger),
+		successtxprocessors.NewBridgingRequestedProcessor(refundRequestProcessor, addressScreener, logger),
 		successtxprocessors.NewHotWalletIncrementProcessor(logger),
 	)
 
@@ -1,8 +1,11 @@
 package successtxprocessors
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 	"math/big"
+	"slices"
 	"strings"
 
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
@@ -11,6 +14,7 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/oracle_cardano/utils"
 	cCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	cUtils "github.com/Ethernal-Tech/apex-bridge/oracle_common/utils"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
 	goEthCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-hclog"
 )
@@ -19,15 +23,18 @@ var _ core.CardanoTxSuccessProcessor = (*BridgingRequestedProcessorImpl)(nil)
 
 type BridgingRequestedProcessorImpl struct {
 	refundRequestProcessor core.CardanoTxSuccessRefundProcessor
+	addressScreener        cCore.AddressScreener
 	logger                 hclog.Logger
 }
 
 func NewBridgingRequestedProcessor(
 	refundRequestProcessor core.CardanoTxSuccessRefundProcessor,
+	addressScreener cCore.AddressScreener,
 	logger hclog.Logger,
 ) *BridgingRequestedProcessorImpl {
 	return &BridgingRequestedProcessorImpl{
 		refundRequestProcessor: refundRequestProcessor,
+		addressScreener:        addressScreener,
 		logger:                 logger.Named("bridging_requested_processor"),
 	}
 }
@@ -57,16 +64,95 @@ func (p *BridgingRequestedProcessorImpl) ValidateAndAddClaim(
 	p.logger.Debug("Validating relevant tx", "txHash", tx.Hash, "metadata", metadata)
 
 	err = p.validate(tx, metadata, appConfig)
-	if err == nil {
-		p.addBridgingRequestClaim(claims, tx, metadata, appConfig)
-	} else {
+	if err != nil {
 		return p.refundRequestProcessor.HandleBridgingProcessorError(
 			claims, tx, appConfig, err, "validation failed for tx")
 	}
 
+	if decision := p.screenAddresses(tx, metadata, appConfig); decision != nil {
+		claims.ScreeningDecisions = append(claims.ScreeningDecisions, decision)
+
+		if decision.Policy == cCore.AddressScreeningPolicyHold {
+			return fmt.Errorf("%w: %s", cCore.ErrBridgingRequestHeld, decision)
+		}
+
+		return p.refundRequestProcessor.HandleBridgingProcessorError(
+			claims, tx, appConfig, errors.New(decision.String()), "address screening failed for tx")
+	}
+
+	p.addBridgingRequestClaim(claims, tx, metadata, appConfig)
+
 	return nil
 }
 
+// Validate runs the same checks as ValidateAndAddClaim without adding a claim or calling the refund
+// processor error handler, so the returned error is the exact reason the tx would be rejected
+func (p *BridgingRequestedProcessorImpl) Validate(tx *core.CardanoTx, appConfig *cCore.AppConfig) error {
+	metadata, err := common.UnmarshalMetadata[common.BridgingRequestMetadata](common.MetadataEncodingTypeCbor, tx.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	if common.BridgingTxType(metadata.BridgingTxType) != p.GetType() {
+		return fmt.Errorf("metadata bridging tx type is not %s", p.GetType())
+	}
+
+	if err := p.validate(tx, metadata, appConfig); err != nil {
+		return err
+	}
+
+	if decision := p.screenAddresses(tx, metadata, appConfig); decision != nil {
+		return errors.New(decision.String())
+	}
+
+	return nil
+}
+
+// screenAddresses returns the screening decision if the sender, one of the addresses the tx inputs are spent from
+// or one of the receivers (except the fee address) is blocked, or nil otherwise.
+// The sender in the metadata is set by the user, so the input addresses are screened as well
+func (p *BridgingRequestedProcessorImpl) screenAddresses(
+	tx *core.CardanoTx, metadata *common.BridgingRequestMetadata, appConfig *cCore.AppConfig,
+) *cCore.AddressScreeningDecision {
+	if p.addressScreener == nil {
+		return nil
+	}
+
+	feeAddress := common.EthZeroAddr
+	if cardanoDestConfig, _ := cUtils.GetChainConfig(appConfig, metadata.DestinationChainID); cardanoDestConfig != nil {
+		feeAddress = cardanoDestConfig.BridgingAddresses.FeeAddress
+	}
+
+	addrs := make([]string, 0, len(tx.Inputs)+len(metadata.Transactions)+1)
+	addrs = append(addrs, strings.Join(metadata.SenderAddr, ""))
+
+	for _, input := range tx.Inputs {
+		if !slices.Contains(addrs, input.Output.Address) {
+			addrs = append(addrs, input.Output.Address)
+		}
+	}
+
+	for _, receiver := range metadata.Transactions {
+		if receiverAddr := strings.Join(receiver.Address, ""); receiverAddr != feeAddress {
+			addrs = append(addrs, receiverAddr)
+		}
+	}
+
+	blockedAddr := p.addressScreener.GetBlockedAddress(addrs...)
+	if blockedAddr == "" {
+		return nil
+	}
+
+	p.logger.Warn("Bridging request with a blocked address", "txHash", tx.Hash, "addr", blockedAddr)
+
+	return &cCore.AddressScreeningDecision{
+		SourceChainID: tx.OriginChainID,
+		TxHash:        common.Hash(tx.Hash),
+		BlockedAddr:   blockedAddr,
+		Policy:        appConfig.BridgingSettings.AddressScreening.Policy,
+	}
+}
+
 func (p *BridgingRequestedProcessorImpl) addBridgingRequestClaim(
 	claims *cCore.BridgeClaims, tx *core.CardanoTx,
 	metadata *common.BridgingRequestMetadata, appConfig *cCore.AppConfig,
@@ -106,6 +192,8 @@ func (p *BridgingRequestedProcessorImpl) addBridgingRequestClaim(
 		receivers = append(receivers, cCore.BridgingRequestReceiver{
 			DestinationAddress: receiverAddr,
 			Amount:             receiverAmount,
+			TokenAmount:        new(big.Int).SetUint64(receiver.TokenAmount),
+			Token:              p.getDestinationToken(tx, metadata, receiver, appConfig),
 		})
 
 		totalAmount.Add(totalAmount, receiverAmount)
@@ -117,6 +205,7 @@ func (p *BridgingRequestedProcessorImpl) addBridgingRequestClaim(
 	receivers = append(receivers, cCore.BridgingRequestReceiver{
 		DestinationAddress: feeAddress,
 		Amount:             feeCurrencyDst,
+		TokenAmount:        big.NewInt(0),
 	})
 
 	claim := cCore.BridgingRequestClaim{
@@ -135,6 +224,21 @@ func (p *BridgingRequestedProcessorImpl) addBridgingRequestClaim(
 		"txHash", tx.Hash, "metadata", metadata, "claim", cCore.BridgingRequestClaimString(claim))
 }
 
+// getDestinationToken returns the token which represents the native token of the receiver on the destination chain
+func (p *BridgingRequestedProcessorImpl) getDestinationToken(
+	tx *core.CardanoTx, metadata *common.BridgingRequestMetadata,
+	receiver common.BridgingRequestMetadataTransaction, appConfig *cCore.AppConfig,
+) string {
+	if receiver.TokenAmount == 0 {
+		return ""
+	}
+
+	token, _ := appConfig.BridgingSettings.NativeTokens.GetDestinationToken(
+		tx.OriginChainID, strings.Join(receiver.Token, ""), metadata.DestinationChainID)
+
+	return token
+}
+
 func (p *BridgingRequestedProcessorImpl) validate(
 	tx *core.CardanoTx, metadata *common.BridgingRequestMetadata, appConfig *cCore.AppConfig,
 ) error {
@@ -151,10 +255,6 @@ func (p *BridgingRequestedProcessorImpl) validate(
 		return err
 	}
 
-	if err := utils.ValidateOutputsHaveTokens(tx, appConfig); err != nil {
-		return err
-	}
-
 	multisigUtxo, err := utils.ValidateTxOutputs(tx, appConfig, false)
 	if err != nil {
 		return err
@@ -170,6 +270,10 @@ func (p *BridgingRequestedProcessorImpl) validate(
 		return fmt.Errorf("destination chain not registered: %v", metadata.DestinationChainID)
 	}
 
+	if err := p.validateTokens(tx, metadata, multisigUtxo, cardanoDestConfig, appConfig); err != nil {
+		return err
+	}
+
 	if len(metadata.Transactions) > appConfig.BridgingSettings.MaxReceiversPerBridgingRequest {
 		return fmt.Errorf("number of receivers in metadata greater than maximum allowed - no: %v, max: %v, metadata: %v",
 			len(metadata.Transactions), appConfig.BridgingSettings.MaxReceiversPerBridgingRequest, metadata)
@@ -247,3 +351,50 @@ func (p *BridgingRequestedProcessorImpl) validate(
 
 	return nil
 }
+
+// validateTokens checks that every native token sent to the bridging address is mapped to a token
+// of the destination chain and that the token amounts of the receivers add up to the sent amounts
+func (p *BridgingRequestedProcessorImpl) validateTokens(
+	tx *core.CardanoTx, metadata *common.BridgingRequestMetadata,
+	multisigUtxo *indexer.TxOutput, cardanoDestConfig *cCore.CardanoChainConfig, appConfig *cCore.AppConfig,
+) error {
+	nativeTokens := appConfig.BridgingSettings.NativeTokens
+	receiverTokens := map[string]uint64{}
+	feeAddress := common.EthZeroAddr
+
+	if cardanoDestConfig != nil {
+		feeAddress = cardanoDestConfig.BridgingAddresses.FeeAddress
+	}
+
+	for _, receiver := range metadata.Transactions {
+		if receiver.TokenAmount == 0 {
+			continue
+		}
+
+		tokenName := strings.Join(receiver.Token, "")
+
+		if strings.Join(receiver.Address, "") == feeAddress {
+			return fmt.Errorf("native token %s can not be sent to the fee address", tokenName)
+		}
+
+		if _, exists := nativeTokens.GetDestinationToken(
+			tx.OriginChainID, tokenName, metadata.DestinationChainID); !exists {
+			return fmt.Errorf("native token %s can not be bridged to %s", tokenName, metadata.DestinationChainID)
+		}
+
+		receiverTokens[tokenName] += receiver.TokenAmount
+	}
+
+	multisigTokens := make(map[string]uint64, len(multisigUtxo.Tokens))
+
+	for _, token := range multisigUtxo.Tokens {
+		multisigTokens[token.TokenName()] += token.Amount
+	}
+
+	if !maps.Equal(receiverTokens, multisigTokens) {
+		return fmt.Errorf("multisig tokens are not equal to sum of receiver tokens: expected %v but got %v",
+			multisigTokens, receiverTokens)
+	}
+
+	return nil
+}
@@ -88,7 +88,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			"HandleBridgingProcessorError", claims, &core.CardanoTx{}, appConfig).Return(nil)
 
 		proc := NewBridgingRequestedProcessor(
-			refundRequestProcessorMock, hclog.NewNullLogger())
+			refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err := proc.ValidateAndAddClaim(claims, &core.CardanoTx{}, appConfig)
 		require.Error(t, err)
@@ -103,7 +103,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, &core.CardanoTx{}, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err := proc.ValidateAndAddClaim(claims, &core.CardanoTx{}, appConfig)
 		require.NoError(t, err)
@@ -118,7 +118,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			"HandleBridgingProcessorError", claims, &core.CardanoTx{}, appConfig).Return(
 			fmt.Errorf("test err"))
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err := proc.ValidateAndAddClaim(claims, &core.CardanoTx{}, appConfig)
 		require.Error(t, err)
@@ -139,7 +139,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, &core.CardanoTx{}, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, &core.CardanoTx{
 			Tx: indexer.Tx{
@@ -169,7 +169,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		refundRequestProcessorMock.On("ValidateAndAddClaim", claims, &core.CardanoTx{
 			Tx: indexer.Tx{
@@ -204,7 +204,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -234,7 +234,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.NoError(t, err)
@@ -245,7 +245,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: "invalid",
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, transactionDirectionNotSupportedMetadata)
@@ -276,7 +276,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -288,7 +288,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: testChainID,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, destinationChainNonRegisteredMetadata)
@@ -319,7 +319,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -331,7 +331,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrPrime,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, originChainNonRegisteredMetadata)
@@ -364,7 +364,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -376,7 +376,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, originChainNonRegisteredMetadata)
@@ -408,7 +408,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -420,7 +420,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrNexus,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, transactionDirectionNotSupportedMetadata)
@@ -451,7 +451,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -463,7 +463,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, bridgingAddrNotFoundInUtxosMetadata)
@@ -492,7 +492,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -505,7 +505,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, multipleUtxosToBridgingAddrMetadata)
@@ -534,7 +534,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -546,7 +546,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: 2},
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: 2},
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: 2},
@@ -579,7 +579,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -591,7 +591,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: utxoMinValue},
 			},
 			BridgingFee: minFeeForBridging - 1,
@@ -622,7 +622,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -634,7 +634,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: utxoMinValue},
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: minFeeForBridging},
 			},
@@ -664,7 +664,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.NoError(t, err)
@@ -675,7 +675,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: utxoMinValue},
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: 2},
 			},
@@ -706,7 +706,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -719,7 +719,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: utxoMinValue},
 				{Address: sendtx.AddrToMetaDataAddr(
 					"addr_test1vq6xsx99frfepnsjuhzac48vl9s2lc9awkvfknkgs89srqqslj661"), Amount: utxoMinValue},
@@ -751,7 +751,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -764,7 +764,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: utxoMinValue},
 				{Address: sendtx.AddrToMetaDataAddr(
 					"stake_test1urrzuuwrq6lfq82y9u642qzcwvkljshn0743hs0rpd5wz8s2pe23d"), Amount: utxoMinValue},
@@ -796,7 +796,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -808,7 +808,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: minFeeForBridging},
 				{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: utxoMinValue},
 			},
@@ -839,7 +839,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -851,7 +851,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: minFeeForBridging},
 				{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: utxoMinValue},
 			},
@@ -882,7 +882,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -894,7 +894,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
-			Transactions: []sendtx.BridgingRequestMetadataTransaction{
+			Transactions: []common.BridgingRequestMetadataTransaction{
 				{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: minFeeForBridging - 1},
 			},
 		})
@@ -924,7 +924,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -935,7 +935,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		const destinationChainID = common.ChainIDStrVector
 
 		txHash := [32]byte(common.NewHashFromHexString("0x2244FF"))
-		receivers := []sendtx.BridgingRequestMetadataTransaction{
+		receivers := []common.BridgingRequestMetadataTransaction{
 			{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: minFeeForBridging},
 			{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: maxAmountAllowedToBridge.Uint64() + 1},
 		}
@@ -973,7 +973,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.Error(t, err)
@@ -985,7 +985,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		const destinationChainID = common.ChainIDStrVector
 
 		txHash := [32]byte(common.NewHashFromHexString("0x2244FF"))
-		receivers := []sendtx.BridgingRequestMetadataTransaction{
+		receivers := []common.BridgingRequestMetadataTransaction{
 			{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: minFeeForBridging},
 			{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: utxoMinValue},
 		}
@@ -1020,7 +1020,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
 
-		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
 		require.NoError(t, err)
@@ -1036,4 +1036,159 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			claims.BridgingRequestClaims[0].Receivers[1].DestinationAddress)
 		require.Equal(t, feeAddrBridgingAmount, claims.BridgingRequestClaims[0].Receivers[1].Amount.Uint64())
 	})
+
+	t.Run("ValidateAndAddClaim blocked input address", func(t *testing.T) {
+		const blockedAddr = "addr_test1vq7vsmgan3adhntu2wdzwrrxqxv8rtmvmnsyfe7l8hwfmsq2w9ht0"
+
+		receivers := []common.BridgingRequestMetadataTransaction{
+			{Address: sendtx.AddrToMetaDataAddr(vectorBridgingFeeAddr), Amount: minFeeForBridging},
+			{Address: sendtx.AddrToMetaDataAddr(validTestAddress), Amount: utxoMinValue},
+		}
+
+		validMetadata, err := common.SimulateRealMetadata(common.MetadataEncodingTypeCbor, common.BridgingRequestMetadata{
+			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
+			DestinationChainID: common.ChainIDStrVector,
+			SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
+			Transactions:       receivers,
+		})
+		require.NoError(t, err)
+
+		cardanoTx := &core.CardanoTx{
+			Tx: indexer.Tx{
+				Hash:     [32]byte(common.NewHashFromHexString("0x2244FE")),
+				Metadata: validMetadata,
+				Inputs: []*indexer.TxInputOutput{
+					{Output: indexer.TxOutput{Address: blockedAddr}},
+					{Output: indexer.TxOutput{Address: blockedAddr}},
+				},
+				Outputs: []*indexer.TxOutput{
+					{Address: primeBridgingAddr, Amount: minFeeForBridging + utxoMinValue},
+				},
+			},
+			OriginChainID: common.ChainIDStrPrime,
+		}
+
+		addressScreenerMock := &cCore.AddressScreenerMock{}
+		addressScreenerMock.On("GetBlockedAddress", []string{"addr1", blockedAddr, validTestAddress}).
+			Return(blockedAddr)
+
+		appConfig := getAppConfig(false)
+		appConfig.BridgingSettings.AddressScreening.Policy = cCore.AddressScreeningPolicyHold
+
+		refundRequestProcessorMock := &core.CardanoTxSuccessRefundProcessorMock{
+			SuccessProc: &core.CardanoTxSuccessProcessorMock{},
+		}
+		refundRequestProcessorMock.On(
+			"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
+
+		proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, addressScreenerMock, hclog.NewNullLogger())
+
+		claims := &cCore.BridgeClaims{}
+		err = proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
+		require.ErrorIs(t, err, cCore.ErrBridgingRequestHeld)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, claims.ScreeningDecisions, 1)
+		require.Equal(t, blockedAddr, claims.ScreeningDecisions[0].BlockedAddr)
+		addressScreenerMock.AssertExpectations(t)
+	})
+
+	t.Run("ValidateAndAddClaim native tokens", func(t *testing.T) {
+		const (
+			destinationChainID = common.ChainIDStrNexus
+			erc20Address       = "0x1111111111111111111111111111111111111111"
+		)
+
+		token := wallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route3")
+		unknownToken := wallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route4")
+
+		appConfig := getAppConfig(false)
+		appConfig.EthChains = map[string]*cCore.EthChainConfig{
+			destinationChainID: {
+				MinFeeForBridging:     minFeeForBridging,
+				FeeAddrBridgingAmount: feeAddrBridgingAmount,
+			},
+		}
+		appConfig.BridgingSettings.NativeTokens = cCore.NativeTokens{
+			{
+				CardanoChainID: common.ChainIDStrPrime,
+				TokenName:      token.String(),
+				EthChainID:     destinationChainID,
+				ERC20Address:   erc20Address,
+			},
+		}
+
+		validateAndAddClaim := func(
+			t *testing.T, receiverToken wallet.Token, receiverTokenAmount uint64, multisigTokenAmount uint64,
+		) (*cCore.BridgeClaims, error) {
+			t.Helper()
+
+			metadata, err := common.SimulateRealMetadata(common.MetadataEncodingTypeCbor, common.BridgingRequestMetadata{
+				BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
+				DestinationChainID: destinationChainID,
+				SenderAddr:         sendtx.AddrToMetaDataAddr("addr1"),
+				Transactions: []common.BridgingRequestMetadataTransaction{
+					{Address: common.SplitString(common.EthZeroAddr, 40), Amount: minFeeForBridging},
+					{
+						Address:     common.SplitString(nexusBridgingAddr, 40),
+						Amount:      utxoMinValue,
+						Token:       common.SplitString(receiverToken.String(), 40),
+						TokenAmount: receiverTokenAmount,
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			cardanoTx := &core.CardanoTx{
+				Tx: indexer.Tx{
+					Hash:     [32]byte(common.NewHashFromHexString("0x2244FF")),
+					Metadata: metadata,
+					Outputs: []*indexer.TxOutput{
+						{
+							Address: primeBridgingAddr,
+							Amount:  minFeeForBridging + utxoMinValue,
+							Tokens: []indexer.TokenAmount{
+								{PolicyID: token.PolicyID, Name: token.Name, Amount: multisigTokenAmount},
+							},
+						},
+					},
+				},
+				OriginChainID: common.ChainIDStrPrime,
+			}
+
+			refundRequestProcessorMock := &core.CardanoTxSuccessRefundProcessorMock{
+				SuccessProc: &core.CardanoTxSuccessProcessorMock{},
+			}
+			refundRequestProcessorMock.On(
+				"HandleBridgingProcessorPreValidate", cardanoTx, appConfig).Return(nil)
+
+			claims := &cCore.BridgeClaims{}
+			proc := NewBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
+
+			return claims, proc.ValidateAndAddClaim(claims, cardanoTx, appConfig)
+		}
+
+		t.Run("token not mapped", func(t *testing.T) {
+			_, err := validateAndAddClaim(t, unknownToken, 10, 10)
+			require.ErrorContains(t, err, "can not be bridged")
+		})
+
+		t.Run("token amounts mismatch", func(t *testing.T) {
+			_, err := validateAndAddClaim(t, token, 9, 10)
+			require.ErrorContains(t, err, "multisig tokens are not equal to sum of receiver tokens")
+		})
+
+		t.Run("valid", func(t *testing.T) {
+			claims, err := validateAndAddClaim(t, token, 10, 10)
+			require.NoError(t, err)
+			require.Len(t, claims.BridgingRequestClaims, 1)
+
+			receivers := claims.BridgingRequestClaims[0].Receivers
+			require.Len(t, receivers, 2)
+			require.Equal(t, nexusBridgingAddr, receivers[0].DestinationAddress)
+			require.Equal(t, uint64(10), receivers[0].TokenAmount.Uint64())
+			require.Equal(t, strings.ToLower(erc20Address), receivers[0].Token)
+			require.Equal(t, common.EthZeroAddr, receivers[1].DestinationAddress)
+			require.Zero(t, receivers[1].TokenAmount.Sign())
+		})
+	})
 }
@@ -1,6 +1,7 @@
 package successtxprocessors
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -44,11 +45,17 @@ func (*RefundRequestProcessorImpl) PreValidate(tx *core.CardanoTx, appConfig *cC
 
 func (*RefundRequestProcessorImpl) HandleBridgingProcessorPreValidate(
 	tx *core.CardanoTx, appConfig *cCore.AppConfig) error {
-	if tx.BatchTryCount > appConfig.TryCountLimits.MaxBatchTryCount ||
+	if tx.ForceRefund {
+		return errors.New("refund forced by operator")
+	}
+
+	batchTryCount := tx.BatchTryCount - tx.BatchTryCountOffset
+
+	if batchTryCount > appConfig.TryCountLimits.MaxBatchTryCount ||
 		tx.SubmitTryCount > appConfig.TryCountLimits.MaxSubmitTryCount {
 		return fmt.Errorf(
-			"try count exceeded. BatchTryCount: (current, max)=(%d, %d), SubmitTryCount: (current, max)=(%d, %d)",
-			tx.BatchTryCount, appConfig.TryCountLimits.MaxBatchTryCount,
+			"%w. BatchTryCount: (current, max)=(%d, %d), SubmitTryCount: (current, max)=(%d, %d)",
+			cCore.ErrTryCountExceeded, batchTryCount, appConfig.TryCountLimits.MaxBatchTryCount,
 			tx.SubmitTryCount, appConfig.TryCountLimits.MaxSubmitTryCount)
 	}
 
@@ -123,9 +130,11 @@ func (p *RefundRequestProcessorImpl) addRefundRequestClaim(
 func (p *RefundRequestProcessorImpl) validate(
 	tx *core.CardanoTx, metadata *common.RefundBridgingRequestMetadata, appConfig *cCore.AppConfig,
 ) error {
-	if tx.RefundTryCount > appConfig.TryCountLimits.MaxRefundTryCount {
-		return fmt.Errorf("try count exceeded. RefundTryCount: (current, max)=(%d, %d)",
-			tx.RefundTryCount, appConfig.TryCountLimits.MaxRefundTryCount)
+	refundTryCount := tx.RefundTryCount - tx.RefundTryCountOffset
+
+	if refundTryCount > appConfig.TryCountLimits.MaxRefundTryCount {
+		return fmt.Errorf("%w. RefundTryCount: (current, max)=(%d, %d)",
+			cCore.ErrTryCountExceeded, refundTryCount, appConfig.TryCountLimits.MaxRefundTryCount)
 	}
 
 	chainConfig := appConfig.CardanoChains[tx.OriginChainID]
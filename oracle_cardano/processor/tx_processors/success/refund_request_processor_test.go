@@ -131,6 +131,7 @@ func TestRefundRequestedProcessor(t *testing.T) {
 		err := proc.HandleBridgingProcessorPreValidate(&core.CardanoTx{BatchTryCount: 1}, appConfig)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "try count exceeded")
+		require.ErrorIs(t, err, cCore.ErrTryCountExceeded)
 	})
 
 	t.Run("HandleBridgingProcessorPreValidate - submitTryCount over", func(t *testing.T) {
@@ -141,6 +142,22 @@ func TestRefundRequestedProcessor(t *testing.T) {
 		require.ErrorContains(t, err, "try count exceeded")
 	})
 
+	t.Run("HandleBridgingProcessorPreValidate - batchTryCount requeued", func(t *testing.T) {
+		appConfig := getAppConfig(false)
+
+		err := proc.HandleBridgingProcessorPreValidate(
+			&core.CardanoTx{BatchTryCount: 3, BatchTryCountOffset: 3}, appConfig)
+		require.NoError(t, err)
+	})
+
+	t.Run("HandleBridgingProcessorPreValidate - force refund", func(t *testing.T) {
+		appConfig := getAppConfig(false)
+
+		err := proc.HandleBridgingProcessorPreValidate(&core.CardanoTx{ForceRefund: true}, appConfig)
+		require.Error(t, err)
+		require.ErrorContains(t, err, "refund forced by operator")
+	})
+
 	t.Run("HandleBridgingProcessorError - empty ty", func(t *testing.T) {
 		appConfig := getAppConfig(false)
 
@@ -185,7 +202,7 @@ func TestRefundRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         []string{validPrimeTestAddress},
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, metadata)
@@ -218,7 +235,7 @@ func TestRefundRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         []string{"invalid_address"},
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, metadata)
@@ -251,7 +268,7 @@ func TestRefundRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         []string{validPrimeTestAddress},
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, metadata)
@@ -337,7 +354,7 @@ func TestRefundRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         []string{validPrimeTestAddress},
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, metadata)
@@ -380,7 +397,7 @@ func TestRefundRequestedProcessor(t *testing.T) {
 			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
 			DestinationChainID: common.ChainIDStrVector,
 			SenderAddr:         []string{validPrimeTestAddress},
-			Transactions:       []sendtx.BridgingRequestMetadataTransaction{},
+			Transactions:       []common.BridgingRequestMetadataTransaction{},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, validMetadata)
@@ -2,9 +2,12 @@ package processor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
@@ -38,6 +41,8 @@ type CardanoStateProcessor struct {
 	oracleBridgeSC eth.IOracleBridgeSmartContract
 
 	state *perTickState
+	// dead-letter txs count is refreshed on the first persist and whenever new dead-letter txs are added
+	isDeadLetterTelemetrySet bool
 }
 
 func NewCardanoStateProcessor(
@@ -65,7 +70,10 @@ func (sp *CardanoStateProcessor) GetChainType() string {
 }
 
 func (sp *CardanoStateProcessor) Reset() {
-	sp.state = &perTickState{updateData: &core.CardanoUpdateTxsData{}}
+	sp.state = &perTickState{
+		updateData:     &core.CardanoUpdateTxsData{},
+		invalidReasons: make(map[string]string),
+	}
 
 	sp.state.lastObservedPerChain = make(map[string]uint64)
 }
@@ -117,6 +125,15 @@ func (sp *CardanoStateProcessor) RunChecks(
 		return
 	}
 
+	// held txs are left out, so they do not block the txs from the later blocks.
+	// No tx is processed if the confirmations can not be checked, so a tx is never dropped for a tick
+	sp.state.unprocessedTxs, err = sp.holdUnconfirmedTxs(sp.state.unprocessedTxs)
+	if err != nil {
+		sp.logger.Error("Failed to hold unconfirmed txs", "err", err)
+
+		return
+	}
+
 	// needed for the guarantee that both unprocessedTxs and expectedTxs are processed in order of slot
 	// and prevent the situation when there are always enough unprocessedTxs to fill out claims,
 	// that all claims are filled only from unprocessedTxs and never from expectedTxs
@@ -191,6 +208,9 @@ func (sp *CardanoStateProcessor) processBatchExecutionInfoEvents(
 				tx.IncrementBatchTryCount()
 				tx.ResetSubmitTryCount()
 				tx.SetLastTimeTried(time.Time{})
+				tx.AddTryTime(time.Now().UTC())
+				tx.SetLastError(fmt.Sprintf("batch %d failed on chain %s",
+					event.BatchID, common.ToStrChainID(event.DstChainID)))
 
 				for _, batchTx := range event.TxHashes {
 					if common.ToStrChainID(batchTx.SourceChainID) == tx.GetChainID() &&
@@ -304,6 +324,9 @@ func (sp *CardanoStateProcessor) processNotEnoughFundsEvents(
 
 		txToUpdate.SubmitTryCount++
 		txToUpdate.LastTimeTried = now
+		txToUpdate.AddTryTime(now)
+		txToUpdate.LastError = fmt.Sprintf("bridge rejected %s claim, not enough funds. available amount: %s",
+			event.ClaimeType, event.AvailableAmount)
 		unprocessedToUpdate = append(unprocessedToUpdate, txToUpdate)
 
 		sp.logger.Debug("updated unprocessedTx TryCount and LastTimeTried", "tx", txToUpdate)
@@ -369,8 +392,29 @@ func (sp *CardanoStateProcessor) PersistNew() {
 
 		if err := sp.db.UpdateTxs(sp.state.updateData); err != nil {
 			sp.logger.Error("Failed to update txs", "err", err)
+
+			return
 		}
 	}
+
+	if !sp.isDeadLetterTelemetrySet || len(sp.state.updateData.AddDeadLetterTxs) > 0 {
+		sp.updateDeadLetterTelemetry()
+	}
+}
+
+func (sp *CardanoStateProcessor) updateDeadLetterTelemetry() {
+	for _, chain := range sp.appConfig.CardanoChains {
+		cnt, err := sp.db.GetDeadLetterTxsCount(chain.ChainID)
+		if err != nil {
+			sp.logger.Error("Failed to get dead-letter txs count", "chainID", chain.ChainID, "err", err)
+
+			return
+		}
+
+		telemetry.UpdateOracleDeadLetterTxsCount(chain.ChainID, cnt)
+	}
+
+	sp.isDeadLetterTelemetrySet = true
 }
 
 func (sp *CardanoStateProcessor) constructBridgeClaimsBlockInfo(
@@ -459,9 +503,23 @@ func (sp *CardanoStateProcessor) checkUnprocessedTxs(
 		invalidTxsCounter    int
 	)
 
-	onInvalidTx := func(tx *core.CardanoTx) {
+	onInvalidTx := func(tx *core.CardanoTx, err error) {
 		processedInvalidTxs = append(processedInvalidTxs, tx)
 		invalidTxsCounter++
+
+		sp.state.invalidReasons[string(tx.ToCardanoTxKey())] = err.Error()
+
+		if errors.Is(err, cCore.ErrTryCountExceeded) {
+			// the try count error only tells which limit was exceeded, keep the error of the last try too
+			lastError := tx.LastError
+			if lastError == "" {
+				lastError = err.Error()
+			}
+
+			sp.state.updateData.AddDeadLetterTxs = append(sp.state.updateData.AddDeadLetterTxs, &core.CardanoDeadLetterTx{
+				Tx: tx, LastError: lastError, Reason: err.Error(), CreatedAt: time.Now().UTC(),
+			})
+		}
 	}
 
 	directlyProcessTx := func(tx *core.CardanoTx) {
@@ -482,7 +540,7 @@ func (sp *CardanoStateProcessor) checkUnprocessedTxs(
 		if err != nil {
 			sp.logger.Error("Failed to get tx processor for unprocessed tx", "tx", unprocessedTx, "err", err)
 
-			onInvalidTx(unprocessedTx)
+			onInvalidTx(unprocessedTx, err)
 
 			continue
 		}
@@ -507,14 +565,35 @@ func (sp *CardanoStateProcessor) checkUnprocessedTxs(
 		// and automatically added to processedValidTxs
 		if unprocessedTx.BlockSlot > lastObservedSlot {
 			err = txProcessor.ValidateAndAddClaim(bridgeClaims, unprocessedTx, sp.appConfig)
-			if err != nil {
+			if errors.Is(err, cCore.ErrBridgingRequestHeld) {
+				sp.logger.Info("Bridging request held", "tx", unprocessedTx.Hash, "reason", err)
+
+				continue
+			} else if err != nil {
 				sp.logger.Error("Failed to ValidateAndAddClaim", "tx", unprocessedTx, "err", err)
 
-				onInvalidTx(unprocessedTx)
+				onInvalidTx(unprocessedTx, err)
 
 				continue
 			}
 
+			if txProcessor.GetType() == common.BridgingTxTypeBridgingRequest {
+				isHeld, err := sp.checkManualApproval(bridgeClaims, txProcessor, unprocessedTx)
+				if err == nil && !isHeld {
+					isHeld, err = sp.checkOutflowLimits(bridgeClaims, txProcessor, unprocessedTx)
+				}
+
+				if err != nil {
+					sp.logger.Error("Failed to ValidateAndAddClaim", "tx", unprocessedTx, "err", err)
+
+					onInvalidTx(unprocessedTx, err)
+
+					continue
+				} else if isHeld {
+					continue
+				}
+			}
+
 			if txProcessor.GetType() == common.BridgingTxTypeBridgingRequest ||
 				txProcessor.GetType() == common.TxTypeRefundRequest {
 				pendingTxs = append(pendingTxs, unprocessedTx)
@@ -683,6 +762,17 @@ func (sp *CardanoStateProcessor) UpdateBridgingRequestStates(
 	bridgeClaims *cCore.BridgeClaims,
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater,
 ) {
+	for _, x := range bridgeClaims.ScreeningDecisions {
+		err := bridgingRequestStateUpdater.AddressScreened(
+			common.NewBridgingRequestStateKey(x.SourceChainID, x.TxHash, false), x.String())
+		if err != nil {
+			sp.logger.Error(
+				"error while updating a bridging request state with the screening decision",
+				"sourceChainId", x.SourceChainID,
+				"sourceTxHash", x.TxHash, "err", err)
+		}
+	}
+
 	if len(bridgeClaims.BridgingRequestClaims) > 0 || len(bridgeClaims.RefundRequestClaims) > 0 {
 		notRejectedMap := make(map[string]bool, len(sp.state.updateData.MoveUnprocessedToPending))
 		for _, tx := range sp.state.updateData.MoveUnprocessedToPending {
@@ -729,7 +819,7 @@ func (sp *CardanoStateProcessor) UpdateBridgingRequestStates(
 		} else if txProcessor.GetType() == common.BridgingTxTypeBridgingRequest ||
 			txProcessor.GetType() == common.TxTypeRefundRequest {
 			err := bridgingRequestStateUpdater.Invalid(common.NewBridgingRequestStateKey(
-				tx.OriginChainID, common.Hash(tx.Hash), false))
+				tx.OriginChainID, common.Hash(tx.Hash), false), sp.state.invalidReasons[string(tx.ToCardanoTxKey())])
 
 			if err != nil {
 				sp.logger.Error(
@@ -739,6 +829,339 @@ func (sp *CardanoStateProcessor) UpdateBridgingRequestStates(
 			}
 		}
 	}
+
+	for _, tx := range sp.state.awaitingApprovalTxs {
+		err := bridgingRequestStateUpdater.AwaitingApproval(
+			common.NewBridgingRequestStateKey(tx.OriginChainID, common.Hash(tx.Hash), false))
+		if err != nil {
+			sp.logger.Error(
+				"error while updating a bridging request state to AwaitingApproval",
+				"sourceChainId", tx.OriginChainID,
+				"sourceTxHash", tx.Hash, "err", err)
+		}
+	}
+
+	for _, x := range sp.state.awaitingConfirmationsTxs {
+		err := bridgingRequestStateUpdater.AwaitingConfirmations(common.NewBridgingRequestStateKey(
+			x.tx.OriginChainID, common.Hash(x.tx.Hash), false), x.requiredConfirmations)
+		if err != nil {
+			sp.logger.Error(
+				"error while updating a bridging request state with the required confirmations",
+				"srcChainId", x.tx.OriginChainID,
+				"srcTxHash", x.tx.Hash, "err", err)
+		}
+	}
+}
+
+// holdUnconfirmedTxs returns the txs without the bridging requests
+// which have not reached the confirmation depth of their amount tier yet.
+// It fails if the confirmations of any tx can not be checked, so no tx is skipped
+func (sp *CardanoStateProcessor) holdUnconfirmedTxs(txs []*core.CardanoTx) ([]*core.CardanoTx, error) {
+	result := make([]*core.CardanoTx, 0, len(txs))
+
+	awaitingCnt := len(sp.state.awaitingConfirmationsTxs)
+
+	for _, tx := range txs {
+		requiredConfirmations, pendingConfirmations, err := sp.getPendingConfirmations(tx)
+		if err != nil {
+			// the txs are checked again on the next tick
+			sp.state.awaitingConfirmationsTxs = sp.state.awaitingConfirmationsTxs[:awaitingCnt]
+
+			return nil, fmt.Errorf("failed to get pending confirmations for tx %s: %w", tx.Hash, err)
+		}
+
+		if pendingConfirmations > 0 {
+			sp.logger.Debug("Holding tx until its confirmation tier depth is reached",
+				"tx", tx.Hash, "required", requiredConfirmations, "pending", pendingConfirmations)
+
+			sp.state.awaitingConfirmationsTxs = append(sp.state.awaitingConfirmationsTxs, &awaitingConfirmationsTx{
+				tx:                    tx,
+				requiredConfirmations: requiredConfirmations,
+			})
+
+			continue
+		}
+
+		result = append(result, tx)
+	}
+
+	return result, nil
+}
+
+// getPendingConfirmations returns the confirmation depth required by the amount tier of the bridging request
+// and the number of blocks still missing to reach it
+func (sp *CardanoStateProcessor) getPendingConfirmations(tx *core.CardanoTx) (uint64, uint64, error) {
+	chainConfig := sp.appConfig.CardanoChains[tx.OriginChainID]
+	if chainConfig == nil || len(chainConfig.ConfirmationTiers) == 0 {
+		return 0, 0, nil
+	}
+
+	// txs which can not be processed are left to the checks to be marked as invalid
+	txProcessor, err := sp.txProcessors.getSuccess(tx, sp.appConfig)
+	if err != nil || txProcessor.GetType() != common.BridgingTxTypeBridgingRequest {
+		return 0, 0, nil
+	}
+
+	amount := uint64(0)
+
+	for _, output := range tx.Outputs {
+		if output.Address == chainConfig.BridgingAddresses.BridgingAddress {
+			amount += output.Amount
+		}
+	}
+
+	defaultConfirmations := uint64(chainConfig.ConfirmationBlockCount)
+
+	requiredConfirmations := chainConfig.ConfirmationTiers.GetConfirmationBlocks(new(big.Int).SetUint64(amount))
+	if requiredConfirmations <= defaultConfirmations {
+		return 0, 0, nil
+	}
+
+	indexerDB := sp.indexerDbs[tx.OriginChainID]
+	if indexerDB == nil {
+		return 0, 0, fmt.Errorf("failed to get cardano chain observer db for chain: %s", tx.OriginChainID)
+	}
+
+	// the block of the tx is already confirmed by the default depth
+	// and each confirmed block after it adds one more confirmation
+	neededBlocks := requiredConfirmations - defaultConfirmations + 1
+
+	blocks, err := indexerDB.GetConfirmedBlocksFrom(tx.BlockSlot, int(neededBlocks)) //nolint:gosec
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if uint64(len(blocks)) >= neededBlocks {
+		return requiredConfirmations, 0, nil
+	}
+
+	return requiredConfirmations, neededBlocks - uint64(len(blocks)), nil
+}
+
+// checkManualApproval holds the bridging request claim of the tx until enough operators approve it,
+// if its amount requires a manual approval. A request which is not approved before the source chain reaches
+// the review deadline is refunded, never bridged
+func (sp *CardanoStateProcessor) checkManualApproval(
+	bridgeClaims *cCore.BridgeClaims, txProcessor core.CardanoTxSuccessProcessor, tx *core.CardanoTx,
+) (bool, error) {
+	settings := sp.appConfig.BridgingSettings.ManualApproval
+	claimsCnt := len(bridgeClaims.BridgingRequestClaims)
+
+	// the request might have been already handed over to the refund processor
+	if claimsCnt == 0 || bridgeClaims.BridgingRequestClaims[claimsCnt-1].ObservedTransactionHash != tx.Hash ||
+		!settings.IsRequired(bridgeClaims.BridgingRequestClaims[claimsCnt-1].TotalAmountDst) {
+		return false, nil
+	}
+
+	claim := bridgeClaims.BridgingRequestClaims[claimsCnt-1]
+
+	approval, err := sp.db.GetManualApproval(tx.OriginChainID, common.Hash(tx.Hash))
+	if err != nil {
+		sp.logger.Error("Failed to get manual approval, holding the tx", "tx", tx.Hash, "err", err)
+
+		bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+		return true, nil
+	} else if approval == nil {
+		approval = &cCore.ManualApproval{
+			SourceChainID:      tx.OriginChainID,
+			TxHash:             common.Hash(tx.Hash),
+			DestinationChainID: common.ToStrChainID(claim.DestinationChainId),
+			Amount:             claim.TotalAmountDst,
+			HeldAtBlock:        tx.BlockSlot,
+			DeadlineBlock:      tx.BlockSlot + settings.ReviewTimeoutBlocks[tx.OriginChainID],
+		}
+
+		sp.state.updateData.AddManualApprovals = append(sp.state.updateData.AddManualApprovals, approval)
+	}
+
+	if approval.IsApproved(settings.RequiredApprovals) {
+		return false, nil
+	}
+
+	bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+	latestSlot, err := sp.getLatestSlot(tx.OriginChainID)
+	if err != nil {
+		sp.logger.Error("Failed to get the latest slot of the source chain, holding the tx", "tx", tx.Hash, "err", err)
+
+		sp.state.awaitingApprovalTxs = append(sp.state.awaitingApprovalTxs, tx)
+
+		return true, nil
+	}
+
+	if !approval.IsDeadlineReached(latestSlot) {
+		sp.state.awaitingApprovalTxs = append(sp.state.awaitingApprovalTxs, tx)
+
+		return true, nil
+	}
+
+	sp.logger.Info("Review deadline of the bridging request has passed, refunding it",
+		"tx", tx.Hash, "approvals", len(approval.Approvals), "deadline", approval.DeadlineBlock, "slot", latestSlot)
+
+	if err := sp.refundTx(bridgeClaims, txProcessor, tx, "the review deadline has passed"); err != nil {
+		return false, err
+	}
+
+	sp.state.updateData.ExpireManualApprovals = append(sp.state.updateData.ExpireManualApprovals, approval)
+
+	return false, nil
+}
+
+// getLatestSlot returns the slot of the latest confirmed block of the chain
+func (sp *CardanoStateProcessor) getLatestSlot(chainID string) (uint64, error) {
+	indexerDB := sp.indexerDbs[chainID]
+	if indexerDB == nil {
+		return 0, fmt.Errorf("failed to get cardano chain observer db for chain: %s", chainID)
+	}
+
+	blockPoint, err := indexerDB.GetLatestBlockPoint()
+	if err != nil {
+		return 0, err
+	} else if blockPoint == nil {
+		return 0, fmt.Errorf("no confirmed block for chain: %s", chainID)
+	}
+
+	return blockPoint.BlockSlot, nil
+}
+
+// checkOutflowLimits counts the bridging request claim of the tx in the outflow limits.
+// A request which would exceed a limit is either deferred or handed over to the refund processor
+func (sp *CardanoStateProcessor) checkOutflowLimits(
+	bridgeClaims *cCore.BridgeClaims, txProcessor core.CardanoTxSuccessProcessor, tx *core.CardanoTx,
+) (bool, error) {
+	limits := sp.appConfig.BridgingSettings.OutflowLimits
+	claimsCnt := len(bridgeClaims.BridgingRequestClaims)
+
+	// the request might have been already handed over to the refund processor
+	if !limits.IsEnabled() || claimsCnt == 0 ||
+		bridgeClaims.BridgingRequestClaims[claimsCnt-1].ObservedTransactionHash != tx.Hash {
+		return false, nil
+	}
+
+	claim := bridgeClaims.BridgingRequestClaims[claimsCnt-1]
+
+	usage, err := sp.getOutflowUsage()
+	if err != nil {
+		sp.logger.Error("Failed to get outflow usage, deferring the tx", "tx", tx.Hash, "err", err)
+
+		bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+		return true, nil
+	}
+
+	record := &cCore.OutflowRecord{
+		SourceChainID:      tx.OriginChainID,
+		DestinationChainID: common.ToStrChainID(claim.DestinationChainId),
+		SenderAddr:         getOutflowSenderAddr(tx),
+		TxHash:             common.Hash(tx.Hash),
+		Amount:             claim.TotalAmountDst,
+		BlockSlot:          tx.BlockSlot,
+	}
+
+	if err := limits.Check(usage, record); err == nil {
+		usage.Add(record)
+
+		sp.state.updateData.AddOutflowRecords = append(sp.state.updateData.AddOutflowRecords, record)
+
+		return false, nil
+	} else {
+		sp.logger.Info("Bridging request exceeds the outflow limits",
+			"tx", tx.Hash, "policy", limits.Policy, "reason", err)
+	}
+
+	telemetry.UpdateOracleOutflowLimitExceededCounter(tx.OriginChainID, string(limits.Policy), 1)
+
+	bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+	if limits.Policy == cCore.OutflowLimitPolicyDefer {
+		return true, nil
+	}
+
+	return false, sp.refundTx(bridgeClaims, txProcessor, tx, "the outflow limits are exceeded")
+}
+
+// refundTx hands the bridging request over to the refund processor.
+// The tx is rejected if it can not be refunded, so the request is never bridged instead
+func (sp *CardanoStateProcessor) refundTx(
+	bridgeClaims *cCore.BridgeClaims, txProcessor core.CardanoTxSuccessProcessor, tx *core.CardanoTx, reason string,
+) error {
+	if !sp.appConfig.RefundEnabled {
+		return fmt.Errorf("%s and refunds are disabled", reason)
+	}
+
+	claimsCnt := len(bridgeClaims.BridgingRequestClaims)
+	tx.ForceRefund = true
+
+	if err := txProcessor.ValidateAndAddClaim(bridgeClaims, tx, sp.appConfig); err != nil {
+		return err
+	}
+
+	if len(bridgeClaims.BridgingRequestClaims) != claimsCnt {
+		bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt]
+
+		return fmt.Errorf("%s and the tx was not handed over to the refund processor", reason)
+	}
+
+	return nil
+}
+
+// getOutflowUsage returns the usage of the current outflow limits window. Records out of the window are removed
+func (sp *CardanoStateProcessor) getOutflowUsage() (*cCore.OutflowUsage, error) {
+	if sp.state.outflowUsage != nil {
+		return sp.state.outflowUsage, nil
+	}
+
+	limits := sp.appConfig.BridgingSettings.OutflowLimits
+
+	records, err := sp.db.GetOutflowRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	var inWindowRecords, expiredRecords []*cCore.OutflowRecord
+
+	for _, record := range records {
+		// the window ends at the last block observed by the bridge, so it is the same for all the validators
+		lastObservedBlock, err := sp.getLastObservedBlock(record.SourceChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		if limits.IsInWindow(record, lastObservedBlock) {
+			inWindowRecords = append(inWindowRecords, record)
+		} else {
+			expiredRecords = append(expiredRecords, record)
+		}
+	}
+
+	if len(expiredRecords) > 0 {
+		if err := sp.db.RemoveOutflowRecords(expiredRecords); err != nil {
+			return nil, err
+		}
+	}
+
+	sp.state.outflowUsage = cCore.NewOutflowUsage(inWindowRecords)
+
+	for chainID, amount := range sp.state.outflowUsage.PerSourceChain {
+		telemetry.UpdateOracleOutflowUsage("source", chainID, amount)
+	}
+
+	for chainID, amount := range sp.state.outflowUsage.PerDestinationChain {
+		telemetry.UpdateOracleOutflowUsage("destination", chainID, amount)
+	}
+
+	return sp.state.outflowUsage, nil
+}
+
+func getOutflowSenderAddr(tx *core.CardanoTx) string {
+	metadata, err := common.UnmarshalMetadata[common.BridgingRequestMetadata](
+		common.MetadataEncodingTypeCbor, tx.Metadata)
+	if err != nil {
+		return ""
+	}
+
+	return strings.Join(metadata.SenderAddr, "")
 }
 
 func (sp *CardanoStateProcessor) getLastObservedBlock(chainID string) (uint64, error) {
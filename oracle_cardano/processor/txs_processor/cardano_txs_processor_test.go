@@ -2,6 +2,7 @@ package processor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -358,6 +359,85 @@ func TestCardanoTxsProcessor(t *testing.T) {
 		processedTx, _ := oracleDB.GetProcessedTx(cCore.DBTxID{ChainID: originChainID, DBKey: txHash[:]})
 		require.NotNil(t, processedTx)
 		require.True(t, processedTx.IsInvalid)
+
+		deadLetterTxs, err := oracleDB.GetDeadLetterTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Empty(t, deadLetterTxs)
+	})
+
+	t.Run("Start - unprocessedTxs - try count exceeded", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		oracleDB, primeDB, vectorDB, err := createDbs()
+		require.NoError(t, err)
+
+		validTxProc := &core.CardanoTxSuccessProcessorMock{Type: "test"}
+		validTxProc.On("ValidateAndAddClaim", mock.Anything, mock.Anything, mock.Anything).Return(
+			fmt.Errorf("%w. test err", cCore.ErrTryCountExceeded))
+
+		bridgeDataFetcher := &core.CardanoBridgeDataFetcherMock{}
+		bridgeDataFetcher.On("GetBatchTransactions", mock.Anything, mock.Anything).Return([]eth.TxDataInfo{}, nil)
+
+		bridgeSubmitter := &core.BridgeSubmitterMock{}
+		bridgeSubmitter.On("Dispose").Return(nil)
+		bridgeSubmitter.On("SubmitClaims", mock.Anything, mock.Anything).Return(&types.Receipt{}, nil)
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+
+		proc, rec := newValidProcessor(
+			ctx,
+			appConfig, oracleDB,
+			validTxProc, nil, bridgeDataFetcher, bridgeSubmitter,
+			map[string]indexer.Database{common.ChainIDStrPrime: primeDB, common.ChainIDStrVector: vectorDB},
+			&common.BridgingRequestStateUpdaterMock{ReturnNil: true},
+			validatorSetObserver,
+		)
+
+		require.NotNil(t, proc)
+
+		const (
+			originChainID = common.ChainIDStrPrime
+		)
+
+		txHash := indexer.Hash(common.NewHashFromHexString("0x89FF"))
+
+		metadata, err := common.SimulateRealMetadata(
+			common.MetadataEncodingTypeCbor, common.BaseMetadata{BridgingTxType: "test"})
+		require.NoError(t, err)
+
+		require.NoError(t, rec.NewUnprocessedTxs(originChainID, []*indexer.Tx{
+			{Hash: txHash, Metadata: metadata, BlockSlot: 1},
+		}))
+
+		// error of the previous failed try
+		unprocessedTxs, _ := oracleDB.GetAllUnprocessedTxs(originChainID, 0)
+		require.Len(t, unprocessedTxs, 1)
+
+		unprocessedTxs[0].LastError = "batch 2 failed on chain vector"
+
+		require.NoError(t, oracleDB.UpdateTxs(&core.CardanoUpdateTxsData{UpdateUnprocessed: unprocessedTxs}))
+
+		go func() {
+			<-time.After(time.Millisecond * processingWaitTimeMs)
+			cancelFunc()
+		}()
+
+		proc.TickTime = 1
+		proc.Start()
+
+		unprocessedTxs, _ = oracleDB.GetAllUnprocessedTxs(originChainID, 0)
+		require.Nil(t, unprocessedTxs)
+
+		processedTx, _ := oracleDB.GetProcessedTx(cCore.DBTxID{ChainID: originChainID, DBKey: txHash[:]})
+		require.NotNil(t, processedTx)
+		require.True(t, processedTx.IsInvalid)
+
+		deadLetterTxs, err := oracleDB.GetDeadLetterTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, deadLetterTxs, 1)
+		require.Equal(t, txHash, deadLetterTxs[0].Tx.Hash)
+		require.Equal(t, "batch 2 failed on chain vector", deadLetterTxs[0].LastError)
+		require.Contains(t, deadLetterTxs[0].Reason, "try count exceeded")
 	})
 
 	t.Run("Start - unprocessedTxs - submit claims failed", func(t *testing.T) {
@@ -1882,3 +1962,90 @@ var (
 		{Name: "availableAmount", Type: abi.Type{T: abi.UintTy, Size: 256}},
 	}
 )
+
+func TestCardanoStateProcessor_HoldUnconfirmedTxs(t *testing.T) {
+	const bridgingAddr = "addr_bridging"
+
+	appConfig := &cCore.AppConfig{
+		CardanoChains: map[string]*cCore.CardanoChainConfig{
+			common.ChainIDStrPrime: {
+				BridgingAddresses:      cCore.BridgingAddresses{BridgingAddress: bridgingAddr},
+				ConfirmationBlockCount: 10,
+				ConfirmationTiers: cCore.ConfirmationTiers{
+					{MinAmount: big.NewInt(1_000), ConfirmationBlocks: 5},
+					{MinAmount: big.NewInt(1_000_000), ConfirmationBlocks: 20},
+				},
+			},
+		},
+	}
+
+	metadata, err := common.SimulateRealMetadata(
+		common.MetadataEncodingTypeCbor, common.BaseMetadata{BridgingTxType: common.BridgingTxTypeBridgingRequest})
+	require.NoError(t, err)
+
+	newTx := func(hash string, amount uint64) *core.CardanoTx {
+		return &core.CardanoTx{
+			OriginChainID: common.ChainIDStrPrime,
+			Tx: indexer.Tx{
+				Hash:      indexer.Hash(common.NewHashFromHexString(hash)),
+				BlockSlot: 100,
+				Metadata:  metadata,
+				Outputs: []*indexer.TxOutput{
+					{Address: bridgingAddr, Amount: amount},
+					{Address: "addr_other", Amount: 5_000_000},
+				},
+			},
+		}
+	}
+
+	smallTx := newTx("0x01", 100_000)
+	largeTx := newTx("0x02", 2_000_000)
+
+	primeDB := &indexer.DatabaseMock{}
+	primeDB.On("GetConfirmedBlocksFrom", uint64(100), 11).Return([]*indexer.CardanoBlock{
+		{Slot: 100}, {Slot: 101}, {Slot: 102},
+	}, nil).Once()
+
+	sp := NewCardanoStateProcessor(
+		context.Background(), appConfig, nil,
+		NewTxProcessorsCollection([]core.CardanoTxSuccessProcessor{
+			&core.CardanoTxSuccessProcessorMock{Type: common.BridgingTxTypeBridgingRequest},
+		}, nil),
+		map[string]indexer.Database{common.ChainIDStrPrime: primeDB}, hclog.NewNullLogger(), nil)
+
+	sp.Reset()
+
+	txs, err := sp.holdUnconfirmedTxs([]*core.CardanoTx{smallTx, largeTx})
+	require.NoError(t, err)
+	require.Equal(t, []*core.CardanoTx{smallTx}, txs)
+	require.Len(t, sp.state.awaitingConfirmationsTxs, 1)
+	require.Equal(t, largeTx, sp.state.awaitingConfirmationsTxs[0].tx)
+	require.Equal(t, uint64(20), sp.state.awaitingConfirmationsTxs[0].requiredConfirmations)
+
+	stateUpdater := &common.BridgingRequestStateUpdaterMock{}
+	stateUpdater.On("AwaitingConfirmations", common.NewBridgingRequestStateKey(
+		common.ChainIDStrPrime, common.Hash(largeTx.Hash), false), uint64(20)).Return(nil).Once()
+
+	sp.UpdateBridgingRequestStates(&cCore.BridgeClaims{}, stateUpdater)
+
+	stateUpdater.AssertExpectations(t)
+
+	primeDB.On("GetConfirmedBlocksFrom", uint64(100), 11).Return(make([]*indexer.CardanoBlock, 11), nil).Once()
+
+	sp.Reset()
+
+	txs, err = sp.holdUnconfirmedTxs([]*core.CardanoTx{smallTx, largeTx})
+	require.NoError(t, err)
+	require.Equal(t, []*core.CardanoTx{smallTx, largeTx}, txs)
+	require.Empty(t, sp.state.awaitingConfirmationsTxs)
+
+	primeDB.On("GetConfirmedBlocksFrom", uint64(100), 11).
+		Return([]*indexer.CardanoBlock(nil), errors.New("db failed")).Once()
+
+	sp.Reset()
+
+	_, err = sp.holdUnconfirmedTxs([]*core.CardanoTx{largeTx, smallTx})
+	require.ErrorContains(t, err, "db failed")
+	require.Empty(t, sp.state.awaitingConfirmationsTxs)
+	primeDB.AssertExpectations(t)
+}
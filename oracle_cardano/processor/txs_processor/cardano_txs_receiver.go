@@ -1,6 +1,8 @@
 package processor
 
 import (
+	"strings"
+
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
 	cCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
@@ -67,13 +69,7 @@ func (r *CardanoTxsReceiverImpl) NewUnprocessedTxs(originChainID string, txs []*
 
 		if txProcessorType == common.BridgingTxTypeBridgingRequest ||
 			txProcessorType == common.TxTypeRefundRequest {
-			bridgingRequests = append(
-				bridgingRequests,
-				&common.NewBridgingRequestStateModel{
-					SourceTxHash: common.Hash(tx.Hash),
-					IsRefund:     txProcessorType == common.TxTypeRefundRequest,
-				},
-			)
+			bridgingRequests = append(bridgingRequests, newBridgingRequestStateModel(cardanoTx, txProcessorType))
 		}
 	}
 
@@ -102,3 +98,39 @@ func (r *CardanoTxsReceiverImpl) NewUnprocessedTxs(originChainID string, txs []*
 
 	return nil
 }
+
+// newBridgingRequestStateModel fills the searchable data of a new bridging request state from tx metadata
+func newBridgingRequestStateModel(
+	tx *core.CardanoTx, txProcessorType common.BridgingTxType,
+) *common.NewBridgingRequestStateModel {
+	model := &common.NewBridgingRequestStateModel{
+		SourceTxHash: common.Hash(tx.Hash),
+		IsRefund:     txProcessorType == common.TxTypeRefundRequest,
+	}
+
+	if model.IsRefund {
+		metadata, err := common.UnmarshalMetadata[common.RefundBridgingRequestMetadata](
+			common.MetadataEncodingTypeCbor, tx.Metadata)
+		if err == nil {
+			model.SenderAddr = strings.Join(metadata.SenderAddr, "")
+		}
+
+		return model
+	}
+
+	metadata, err := common.UnmarshalMetadata[common.BridgingRequestMetadata](
+		common.MetadataEncodingTypeCbor, tx.Metadata)
+	if err != nil {
+		return model
+	}
+
+	model.SenderAddr = strings.Join(metadata.SenderAddr, "")
+	model.DestinationChainID = metadata.DestinationChainID
+	model.ReceiverAddrs = make([]string, len(metadata.Transactions))
+
+	for i, x := range metadata.Transactions {
+		model.ReceiverAddrs[i] = strings.Join(x.Address, "")
+	}
+
+	return model
+}
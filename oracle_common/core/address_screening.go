@@ -0,0 +1,67 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+)
+
+// AddressScreeningPolicy defines what happens with the bridging requests from or to a blocked address
+type AddressScreeningPolicy string
+
+const (
+	// AddressScreeningPolicyHold keeps the request in unprocessed txs until the address is removed from the blocklist
+	AddressScreeningPolicyHold AddressScreeningPolicy = "hold"
+	// AddressScreeningPolicyRefund hands the request over to the refund processor
+	AddressScreeningPolicyRefund AddressScreeningPolicy = "refund"
+)
+
+// ErrBridgingRequestHeld is returned by tx processors for bridging requests
+// which should stay in unprocessed txs without being marked as invalid
+var ErrBridgingRequestHeld = errors.New("bridging request held")
+
+// AddressScreeningSettings configure the screening of the sender and receiver addresses of bridging requests.
+// The blocklist file contains one address per line, lines starting with # are ignored.
+// All validators must screen against the same blocklist, so the file is pinned by the hex encoded sha256
+// of its content and a file with a different hash is never loaded
+type AddressScreeningSettings struct {
+	BlocklistPath   string                 `json:"blocklistPath,omitempty"`
+	BlocklistSHA256 string                 `json:"blocklistSha256,omitempty"`
+	Policy          AddressScreeningPolicy `json:"policy,omitempty"`
+}
+
+func (s AddressScreeningSettings) IsEnabled() bool {
+	return s.BlocklistPath != ""
+}
+
+func (s AddressScreeningSettings) Validate() error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	if hash, err := hex.DecodeString(s.BlocklistSHA256); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("invalid address blocklist sha256: %s", s.BlocklistSHA256)
+	}
+
+	switch s.Policy {
+	case AddressScreeningPolicyHold, AddressScreeningPolicyRefund:
+		return nil
+	default:
+		return fmt.Errorf("unknown address screening policy: %s", s.Policy)
+	}
+}
+
+// AddressScreeningDecision is the outcome of the screening of a bridging request with a blocked address
+type AddressScreeningDecision struct {
+	SourceChainID string
+	TxHash        common.Hash
+	BlockedAddr   string
+	Policy        AddressScreeningPolicy
+}
+
+func (d *AddressScreeningDecision) String() string {
+	return fmt.Sprintf("address %s is blocked, policy: %s", d.BlockedAddr, d.Policy)
+}
@@ -26,6 +26,8 @@ type HotWalletIncrementClaim = contractbinding.IBridgeStructsHotWalletIncrementC
 
 type BridgeClaims struct {
 	ContractClaims
+	// bridging requests with a blocked address, they are not submitted as claims
+	ScreeningDecisions []*AddressScreeningDecision
 }
 
 func (bc *BridgeClaims) Count() int {
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
+	"github.com/Ethernal-Tech/apex-bridge/eth"
 	"github.com/Ethernal-Tech/cardano-infrastructure/logger"
 )
 
@@ -26,11 +28,15 @@ type CardanoChainConfigUtxo struct {
 }
 
 type EthChainConfig struct {
-	ChainID                 string               `json:"-"`
+	ChainID string `json:"-"`
+	// ChainIDNum is the numeric id of the chain on the bridge contract. The contract does not know the chain ids,
+	// so every registered chain other than prime, vector and nexus must be configured with it
+	ChainIDNum              uint8                `json:"chainIdNum,omitempty"`
 	BridgingAddresses       EthBridgingAddresses `json:"-"`
 	NodeURL                 string               `json:"nodeUrl"`
 	SyncBatchSize           uint64               `json:"syncBatchSize"`
 	NumBlockConfirmations   uint64               `json:"numBlockConfirmations"`
+	ConfirmationMode        eth.ConfirmationMode `json:"confirmationMode,omitempty"`
 	StartBlockNumber        uint64               `json:"startBlockNumber"`
 	PoolIntervalMiliseconds time.Duration        `json:"poolIntervalMs"`
 	TTLBlockNumberInc       uint64               `json:"ttlBlockNumberInc"`
@@ -41,11 +47,18 @@ type EthChainConfig struct {
 	MinFeeForBridging       uint64               `json:"minFeeForBridging"`
 	RestartTrackerPullCheck time.Duration        `json:"restartTrackerPullCheck"`
 	FeeAddrBridgingAmount   uint64               `json:"feeAddressBridgingAmount"`
+	// bridging requests reaching a tier are held until its depth is reached
+	ConfirmationTiers ConfirmationTiers `json:"confirmationTiers,omitempty"`
+	// the gateway of a chain deployed before the native tokens release is bound with the legacy ABI
+	LegacyContractsABI bool `json:"legacyContractsAbi,omitempty"`
 }
 
 type CardanoChainConfig struct {
 	cardanotx.CardanoChainConfig
-	ChainID                  string                   `json:"-"`
+	ChainID string `json:"-"`
+	// ChainIDNum is the numeric id of the chain on the bridge contract. The contract does not know the chain ids,
+	// so every registered chain other than prime, vector and nexus must be configured with it
+	ChainIDNum               uint8                    `json:"chainIdNum,omitempty"`
 	BridgingAddresses        BridgingAddresses        `json:"-"`
 	NetworkAddress           string                   `json:"networkAddress"`
 	StartBlockHash           string                   `json:"startBlockHash"`
@@ -55,6 +68,8 @@ type CardanoChainConfig struct {
 	InitialUtxos             []CardanoChainConfigUtxo `json:"initialUtxos"`
 	MinFeeForBridging        uint64                   `json:"minFeeForBridging"`
 	FeeAddrBridgingAmount    uint64                   `json:"feeAddressBridgingAmount"`
+	// bridging requests reaching a tier are held until its depth is reached
+	ConfirmationTiers ConfirmationTiers `json:"confirmationTiers,omitempty"`
 }
 
 type SubmitConfig struct {
@@ -70,6 +85,8 @@ type BridgeConfig struct {
 	BridgeSmartContractAddress string       `json:"bridgeSCAddress"`
 	AdminSmartContractAddress  string       `json:"adminSCAddress"`
 	SubmitConfig               SubmitConfig `json:"submitConfig"`
+	// the bridge contract deployed before the native tokens release is bound with the legacy ABI
+	LegacyContractsABI bool `json:"legacyContractsAbi,omitempty"`
 }
 
 type AppSettings struct {
@@ -78,10 +95,14 @@ type AppSettings struct {
 }
 
 type BridgingSettings struct {
-	MaxAmountAllowedToBridge       *big.Int            `json:"maxAmountAllowedToBridge"`
-	MaxReceiversPerBridgingRequest int                 `json:"maxReceiversPerBridgingRequest"`
-	MaxBridgingClaimsToGroup       int                 `json:"maxBridgingClaimsToGroup"`
-	AllowedDirections              map[string][]string `json:"allowedDirections"`
+	MaxAmountAllowedToBridge       *big.Int                 `json:"maxAmountAllowedToBridge"`
+	MaxReceiversPerBridgingRequest int                      `json:"maxReceiversPerBridgingRequest"`
+	MaxBridgingClaimsToGroup       int                      `json:"maxBridgingClaimsToGroup"`
+	AllowedDirections              map[string][]string      `json:"allowedDirections"`
+	OutflowLimits                  OutflowLimitsSettings    `json:"outflowLimits"`
+	AddressScreening               AddressScreeningSettings `json:"addressScreening"`
+	ManualApproval                 ManualApprovalSettings   `json:"manualApproval"`
+	NativeTokens                   NativeTokens             `json:"nativeTokens"`
 }
 
 type RetryUnprocessedSettings struct {
@@ -117,3 +138,10 @@ func (appConfig *AppConfig) FillOut() {
 		ethChainConfig.ChainID = chainID
 	}
 }
+
+// GetGatewayContractsABI returns the ABI the gateway of the evm chain is bound with
+func (appConfig *AppConfig) GetGatewayContractsABI(chainID string) contractbinding.ContractsABI {
+	chainConfig, exists := appConfig.EthChains[chainID]
+
+	return contractbinding.GetContractsABI(exists && chainConfig.LegacyContractsABI)
+}
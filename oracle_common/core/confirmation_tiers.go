@@ -0,0 +1,50 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ConfirmationTier is the confirmation depth required for the bridging requests of at least MinAmount
+type ConfirmationTier struct {
+	MinAmount          *big.Int `json:"minAmount"`
+	ConfirmationBlocks uint64   `json:"confirmationBlocks"`
+}
+
+type ConfirmationTiers []ConfirmationTier
+
+// Validate returns an error if a tier has no valid amount or if more tiers have the same amount
+func (t ConfirmationTiers) Validate() error {
+	amounts := make(map[string]bool, len(t))
+
+	for _, tier := range t {
+		if tier.MinAmount == nil || tier.MinAmount.Sign() < 0 {
+			return errors.New("confirmation tier min amount must be set and not negative")
+		}
+
+		if amounts[tier.MinAmount.String()] {
+			return fmt.Errorf("duplicate confirmation tier min amount: %s", tier.MinAmount)
+		}
+
+		amounts[tier.MinAmount.String()] = true
+	}
+
+	return nil
+}
+
+// GetConfirmationBlocks returns the highest depth of all the tiers reached by the amount,
+// or zero if the amount does not reach any tier
+func (t ConfirmationTiers) GetConfirmationBlocks(amount *big.Int) (result uint64) {
+	if amount == nil {
+		return 0
+	}
+
+	for _, tier := range t {
+		if tier.MinAmount != nil && amount.Cmp(tier.MinAmount) >= 0 && tier.ConfirmationBlocks > result {
+			result = tier.ConfirmationBlocks
+		}
+	}
+
+	return result
+}
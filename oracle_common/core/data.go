@@ -2,6 +2,7 @@ package core
 
 import (
 	"encoding/binary"
+	"errors"
 	"math"
 	"reflect"
 	"time"
@@ -16,6 +17,9 @@ const (
 	LastProcessingPriority = uint8(1)
 )
 
+// ErrTryCountExceeded is returned by tx processors for txs that exhausted their try count limits
+var ErrTryCountExceeded = errors.New("try count exceeded")
+
 type DBTxID struct {
 	ChainID string
 	DBKey   []byte
@@ -63,6 +67,10 @@ type UpdateTxsData[
 	MovePendingToProcessed     []BaseProcessedTx // for bec txs
 	AddBatchInfoEvents         []*DBBatchInfoEvent
 	RemoveBatchInfoEvents      []*DBBatchInfoEvent
+	AddDeadLetterTxs           []*DeadLetterTx[TTx] // invalid txs that exhausted their try counts
+	AddOutflowRecords          []*OutflowRecord     // accepted brc counted in the outflow limits
+	AddManualApprovals         []*ManualApproval    // brc held for the review of the operators
+	ExpireManualApprovals      []*ManualApproval    // brc refunded because their review deadline was reached
 }
 
 func (d *UpdateTxsData[TTx, TProcessedTx, TExpectedTx]) Count() int {
@@ -74,7 +82,21 @@ func (d *UpdateTxsData[TTx, TProcessedTx, TExpectedTx]) Count() int {
 		len(d.MovePendingToUnprocessed) +
 		len(d.MovePendingToProcessed) +
 		len(d.AddBatchInfoEvents) +
-		len(d.RemoveBatchInfoEvents)
+		len(d.RemoveBatchInfoEvents) +
+		len(d.AddDeadLetterTxs) +
+		len(d.AddOutflowRecords) +
+		len(d.AddManualApprovals) +
+		len(d.ExpireManualApprovals)
+}
+
+// DeadLetterTx is a tx that was marked as invalid because it exhausted its try count limits.
+// The original tx is kept so an operator can replay it after fixing the underlying problem
+// LastError is the error of the last failed try and Reason is the try count limit which was exceeded
+type DeadLetterTx[TTx BaseTx] struct {
+	Tx        TTx       `json:"tx"`
+	LastError string    `json:"last_error"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type DBBatchTx struct {
@@ -14,6 +14,8 @@ type BaseTx interface {
 	GetTxHash() []byte
 	UnprocessedDBKey() []byte
 	SetLastTimeTried(lastTimeTried time.Time)
+	AddTryTime(tryTime time.Time)
+	SetLastError(lastError string)
 	ResetSubmitTryCount()
 	IncrementBatchTryCount()
 	IncrementRefundTryCount()
@@ -90,3 +92,24 @@ type BlockSubmitterDB interface {
 	GetBlocksSubmitterInfo(chainID string) (BlocksSubmitterInfo, error)
 	SetBlocksSubmitterInfo(chainID string, info BlocksSubmitterInfo) error
 }
+
+// OutflowRecordsDB keeps the records of all the chains in a single bucket
+type OutflowRecordsDB interface {
+	// GetOutflowRecords returns all the records. The ones out of the window are removed by the txs processors
+	GetOutflowRecords() ([]*OutflowRecord, error)
+	RemoveOutflowRecords(records []*OutflowRecord) error
+}
+
+// ManualApprovalsDB keeps the bridging requests held for the review of the operators.
+// Requests of all the chains are stored in a single bucket
+type ManualApprovalsDB interface {
+	GetManualApproval(chainID string, txHash common.Hash) (*ManualApproval, error)
+	GetManualApprovals() ([]*ManualApproval, error)
+	// ApproveManualApproval adds the approval of the operator to the held request before its deadline passes
+	ApproveManualApproval(chainID string, txHash common.Hash, operator string) (*ManualApproval, error)
+}
+
+type AddressScreener interface {
+	// GetBlockedAddress returns the first of the addresses which is in the blocklist, or an empty string
+	GetBlockedAddress(addrs ...string) string
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+)
+
+// ManualApprovalSettings hold the bridging requests of at least MinAmount (in dfm) until RequiredApprovals
+// operators approve them. Requests which are not approved before the review timeout passes are refunded.
+// The review timeout is counted on the source chain from the block of the tx, in slots for cardano chains
+// and in blocks for evm chains, so all validators see the deadline at the same point of the chain
+type ManualApprovalSettings struct {
+	MinAmount           *big.Int          `json:"minAmount,omitempty"`
+	RequiredApprovals   int               `json:"requiredApprovals,omitempty"`
+	ReviewTimeoutBlocks map[string]uint64 `json:"reviewTimeoutBlocks,omitempty"`
+}
+
+func (s ManualApprovalSettings) IsEnabled() bool {
+	return s.MinAmount != nil && s.MinAmount.Sign() > 0
+}
+
+func (s ManualApprovalSettings) IsRequired(amount *big.Int) bool {
+	return s.IsEnabled() && amount != nil && amount.Cmp(s.MinAmount) >= 0
+}
+
+func (s ManualApprovalSettings) Validate(refundEnabled bool, chainIDs []string) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	// the requests which are not approved in time can only be refunded
+	if !refundEnabled {
+		return errors.New("manual approval requires refunds to be enabled")
+	}
+
+	if s.RequiredApprovals <= 0 {
+		return errors.New("required approvals must be greater than zero")
+	}
+
+	for _, chainID := range chainIDs {
+		if s.ReviewTimeoutBlocks[chainID] == 0 {
+			return fmt.Errorf("review timeout must be greater than zero for chain: %s", chainID)
+		}
+	}
+
+	return nil
+}
+
+// ManualApprovalVote is given by the operator authenticated by its admin api key
+type ManualApprovalVote struct {
+	Operator   string    `json:"operator"`
+	ApprovedAt time.Time `json:"approved_at"`
+}
+
+// ManualApproval is a bridging request held for the review of the operators.
+// HeldAtBlock is the slot or block of the tx on the source chain and DeadlineBlock is the first one
+// at which the request is refunded. IsExpired is set once the request is refunded
+type ManualApproval struct {
+	SourceChainID      string                `json:"src_chain_id"`
+	TxHash             common.Hash           `json:"tx_hash"`
+	DestinationChainID string                `json:"dst_chain_id"`
+	Amount             *big.Int              `json:"amount"`
+	HeldAtBlock        uint64                `json:"held_at_block"`
+	DeadlineBlock      uint64                `json:"deadline_block"`
+	IsExpired          bool                  `json:"expired"`
+	Approvals          []*ManualApprovalVote `json:"approvals"`
+}
+
+func (a *ManualApproval) DBKey() []byte {
+	return common.ToBridgingRequestStateDBKey(a.SourceChainID, a.TxHash)
+}
+
+// IsApproved returns true if at least requiredApprovals distinct operators approved the request
+func (a *ManualApproval) IsApproved(requiredApprovals int) bool {
+	operators := make(map[string]bool, len(a.Approvals))
+
+	for _, x := range a.Approvals {
+		if x.Operator != "" {
+			operators[x.Operator] = true
+		}
+	}
+
+	return len(operators) >= requiredApprovals
+}
+
+// IsDeadlineReached returns true if the source chain reached the deadline of the review
+func (a *ManualApproval) IsDeadlineReached(latestBlock uint64) bool {
+	return latestBlock >= a.DeadlineBlock
+}
+
+func (a *ManualApproval) HasApproved(operator string) bool {
+	for _, x := range a.Approvals {
+		if x.Operator == operator {
+			return true
+		}
+	}
+
+	return false
+}
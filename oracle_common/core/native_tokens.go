@@ -0,0 +1,100 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	goEthCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// NativeToken pairs a Cardano native token with the ERC-20 token which represents it on an evm chain.
+// Amounts are bridged one to one in the smallest units of both tokens
+type NativeToken struct {
+	CardanoChainID string `json:"cardanoChainId"`
+	// TokenName is the policy id and the hex encoded asset name separated by a dot
+	TokenName    string `json:"tokenName"`
+	EthChainID   string `json:"ethChainId"`
+	ERC20Address string `json:"erc20Address"`
+}
+
+type NativeTokens []NativeToken
+
+func (t NativeTokens) Validate() error {
+	tokens := make(map[string]bool, len(t))
+
+	for _, token := range t {
+		if token.CardanoChainID == "" || token.EthChainID == "" {
+			return fmt.Errorf("chains of native token %s are not set", token.TokenName)
+		}
+
+		if _, err := cardanowallet.NewTokenWithFullName(token.TokenName, true); err != nil {
+			return fmt.Errorf("invalid native token name %s: %w", token.TokenName, err)
+		}
+
+		if !goEthCommon.IsHexAddress(token.ERC20Address) {
+			return fmt.Errorf("invalid erc20 address %s of native token %s", token.ERC20Address, token.TokenName)
+		}
+
+		cardanoKey := token.CardanoChainID + "_" + token.TokenName + "_" + token.EthChainID
+		ethKey := token.EthChainID + "_" + normalizeERC20Address(token.ERC20Address) + "_" + token.CardanoChainID
+
+		if tokens[cardanoKey] || tokens[ethKey] {
+			return fmt.Errorf("native token %s is mapped more than once", token.TokenName)
+		}
+
+		tokens[cardanoKey] = true
+		tokens[ethKey] = true
+	}
+
+	return nil
+}
+
+// ValidateContracts checks that the native tokens are not bridged through the contracts bound with the legacy ABI
+func (t NativeTokens) ValidateContracts(bridge BridgeConfig, ethChains map[string]*EthChainConfig) error {
+	if len(t) > 0 && bridge.LegacyContractsABI {
+		return fmt.Errorf("native tokens can not be bridged through the bridge contract with the legacy ABI")
+	}
+
+	for _, token := range t {
+		if chainConfig, exists := ethChains[token.EthChainID]; exists && chainConfig.LegacyContractsABI {
+			return fmt.Errorf("native token %s can not be bridged through the %s gateway with the legacy ABI",
+				token.TokenName, token.EthChainID)
+		}
+	}
+
+	return nil
+}
+
+// GetDestinationToken returns the token which represents the token of the source chain on the destination chain.
+// Cardano tokens are identified by their full names and evm tokens by their erc20 addresses
+func (t NativeTokens) GetDestinationToken(srcChainID, srcToken, dstChainID string) (string, bool) {
+	for _, token := range t {
+		switch {
+		case token.CardanoChainID == srcChainID && token.EthChainID == dstChainID && token.TokenName == srcToken:
+			return normalizeERC20Address(token.ERC20Address), true
+		case token.EthChainID == srcChainID && token.CardanoChainID == dstChainID &&
+			normalizeERC20Address(token.ERC20Address) == normalizeERC20Address(srcToken):
+			return token.TokenName, true
+		}
+	}
+
+	return "", false
+}
+
+// GetCardanoTokenNames returns the full names of all the native tokens of the cardano chain
+func (t NativeTokens) GetCardanoTokenNames(chainID string) []string {
+	var result []string
+
+	for _, token := range t {
+		if token.CardanoChainID == chainID {
+			result = append(result, token.TokenName)
+		}
+	}
+
+	return result
+}
+
+func normalizeERC20Address(addr string) string {
+	return strings.ToLower(goEthCommon.HexToAddress(addr).String())
+}
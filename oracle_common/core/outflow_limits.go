@@ -0,0 +1,187 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+)
+
+// OutflowLimitPolicy defines what happens with the bridging requests which would exceed an outflow limit
+type OutflowLimitPolicy string
+
+const (
+	// OutflowLimitPolicyDefer keeps the request in unprocessed txs until the window has enough room for it
+	OutflowLimitPolicyDefer OutflowLimitPolicy = "defer"
+	// OutflowLimitPolicyRefund hands the request over to the refund processor
+	OutflowLimitPolicyRefund OutflowLimitPolicy = "refund"
+)
+
+// defaultOutflowBlockTimeMilis is the slot length of the cardano chains
+const defaultOutflowBlockTimeMilis = 1_000
+
+// OutflowLimitsSettings are the caps of the amounts (in dfm) bridged within a rolling window.
+// A chain without a cap or a zero cap is not limited.
+// The window is measured in the blocks (slots) of the source chains instead of the local time,
+// so all the validators count the same requests
+type OutflowLimitsSettings struct {
+	WindowMilis uint64             `json:"windowMs"`
+	Policy      OutflowLimitPolicy `json:"policy"`
+	// BlockTimeMilis is the block time (slot length) per source chain. defaultOutflowBlockTimeMilis if not set
+	BlockTimeMilis      map[string]uint64   `json:"blockTimeMs,omitempty"`
+	PerSourceChain      map[string]*big.Int `json:"perSourceChain,omitempty"`
+	PerDestinationChain map[string]*big.Int `json:"perDestinationChain,omitempty"`
+	PerSender           *big.Int            `json:"perSender,omitempty"`
+}
+
+// IsEnabled returns true if the window is set and there is at least one cap
+func (s OutflowLimitsSettings) IsEnabled() bool {
+	return s.WindowMilis > 0 && (len(s.PerSourceChain) > 0 || len(s.PerDestinationChain) > 0 || isLimitSet(s.PerSender))
+}
+
+func (s OutflowLimitsSettings) Validate(refundEnabled bool) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	switch s.Policy {
+	case OutflowLimitPolicyDefer:
+	case OutflowLimitPolicyRefund:
+		if !refundEnabled {
+			return errors.New("outflow limit policy refund requires refunds to be enabled")
+		}
+	default:
+		return fmt.Errorf("unknown outflow limit policy: %s", s.Policy)
+	}
+
+	for chainID, blockTime := range s.BlockTimeMilis {
+		if blockTime == 0 {
+			return fmt.Errorf("outflow limits block time for chain %s must be positive", chainID)
+		}
+	}
+
+	for _, limits := range []map[string]*big.Int{s.PerSourceChain, s.PerDestinationChain} {
+		for chainID, limit := range limits {
+			if limit == nil || limit.Sign() < 0 {
+				return fmt.Errorf("outflow limit for chain %s must be set and not negative", chainID)
+			}
+		}
+	}
+
+	if s.PerSender != nil && s.PerSender.Sign() < 0 {
+		return errors.New("outflow limit per sender must not be negative")
+	}
+
+	return nil
+}
+
+// WindowBlocks returns the length of the window in the blocks (slots) of the chain
+func (s OutflowLimitsSettings) WindowBlocks(chainID string) uint64 {
+	blockTime, exists := s.BlockTimeMilis[chainID]
+	if !exists {
+		blockTime = defaultOutflowBlockTimeMilis
+	}
+
+	return max(s.WindowMilis/blockTime, 1)
+}
+
+// IsInWindow returns true if the record is still counted, given the last block (slot) of its source chain
+// observed by the bridge
+func (s OutflowLimitsSettings) IsInWindow(record *OutflowRecord, lastObservedBlock uint64) bool {
+	return record.BlockSlot+s.WindowBlocks(record.SourceChainID) > lastObservedBlock
+}
+
+// Check returns an error describing the first limit which would be exceeded by adding the record to the usage
+func (s OutflowLimitsSettings) Check(usage *OutflowUsage, record *OutflowRecord) error {
+	check := func(name, key string, limit *big.Int, used map[string]*big.Int) error {
+		if !isLimitSet(limit) {
+			return nil
+		}
+
+		total := new(big.Int).Add(record.Amount, getAmount(used, key))
+		if total.Cmp(limit) > 0 {
+			return fmt.Errorf("%s %s outflow limit exceeded: %s + %s > %s",
+				name, key, getAmount(used, key), record.Amount, limit)
+		}
+
+		return nil
+	}
+
+	if err := check("source chain", record.SourceChainID,
+		s.PerSourceChain[record.SourceChainID], usage.PerSourceChain); err != nil {
+		return err
+	}
+
+	if err := check("destination chain", record.DestinationChainID,
+		s.PerDestinationChain[record.DestinationChainID], usage.PerDestinationChain); err != nil {
+		return err
+	}
+
+	if record.SenderAddr == "" {
+		return nil
+	}
+
+	return check("sender", record.SenderAddr, s.PerSender, usage.PerSender)
+}
+
+// OutflowRecord is a bridging request which is counted in the outflow limits
+type OutflowRecord struct {
+	SourceChainID      string      `json:"src_chain_id"`
+	DestinationChainID string      `json:"dst_chain_id"`
+	SenderAddr         string      `json:"sender_addr"`
+	TxHash             common.Hash `json:"tx_hash"`
+	Amount             *big.Int    `json:"amount"`
+	// BlockSlot is the slot of the cardano block or the number of the evm block which includes the tx
+	BlockSlot uint64 `json:"block_slot"`
+}
+
+func (r *OutflowRecord) DBKey() []byte {
+	return common.ToBridgingRequestStateDBKey(r.SourceChainID, r.TxHash)
+}
+
+// OutflowUsage are the amounts bridged within the window
+type OutflowUsage struct {
+	PerSourceChain      map[string]*big.Int
+	PerDestinationChain map[string]*big.Int
+	PerSender           map[string]*big.Int
+}
+
+func NewOutflowUsage(records []*OutflowRecord) *OutflowUsage {
+	usage := &OutflowUsage{
+		PerSourceChain:      map[string]*big.Int{},
+		PerDestinationChain: map[string]*big.Int{},
+		PerSender:           map[string]*big.Int{},
+	}
+
+	for _, record := range records {
+		usage.Add(record)
+	}
+
+	return usage
+}
+
+func (u *OutflowUsage) Add(record *OutflowRecord) {
+	add := func(used map[string]*big.Int, key string) {
+		used[key] = new(big.Int).Add(getAmount(used, key), record.Amount)
+	}
+
+	add(u.PerSourceChain, record.SourceChainID)
+	add(u.PerDestinationChain, record.DestinationChainID)
+
+	if record.SenderAddr != "" {
+		add(u.PerSender, record.SenderAddr)
+	}
+}
+
+func isLimitSet(limit *big.Int) bool {
+	return limit != nil && limit.Sign() > 0
+}
+
+func getAmount(amounts map[string]*big.Int, key string) *big.Int {
+	if amount, exists := amounts[key]; exists {
+		return amount
+	}
+
+	return big.NewInt(0)
+}
@@ -21,3 +21,16 @@ func (m *ExpectedTxsFetcherMock) Start() {
 }
 
 var _ ExpectedTxsFetcher = (*ExpectedTxsFetcherMock)(nil)
+
+type AddressScreenerMock struct {
+	mock.Mock
+}
+
+// GetBlockedAddress implements AddressScreener.
+func (m *AddressScreenerMock) GetBlockedAddress(addrs ...string) string {
+	args := m.Called(addrs)
+
+	return args.String(0)
+}
+
+var _ AddressScreener = (*AddressScreenerMock)(nil)
@@ -1,8 +1,11 @@
 package databaseaccess
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
@@ -92,6 +95,40 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetAllUnprocessedTxs(
 	return result, nil
 }
 
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetAllPendingTxs(
+	chainID string, threshold int,
+) ([]TTx, error) {
+	var result []TTx
+
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return nil, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err := bd.DB.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(ChainBucket(PendingTxsBucket, chainID)).Cursor()
+
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			var chainTx TTx
+
+			if err := json.Unmarshal(v, &chainTx); err != nil {
+				return err
+			}
+
+			result = append(result, chainTx)
+			if threshold > 0 && len(result) == threshold {
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetPendingTx(
 	entityID core.DBTxID,
 ) (result core.BaseTx, err error) {
@@ -131,6 +168,40 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetProcessedTx(
 	return result, err
 }
 
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetAllProcessedTxs(
+	chainID string, threshold int,
+) ([]TProcessedTx, error) {
+	var result []TProcessedTx
+
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return nil, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err := bd.DB.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(ChainBucket(ProcessedTxsBucket, chainID)).Cursor()
+
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			var processedTx TProcessedTx
+
+			if err := json.Unmarshal(v, &processedTx); err != nil {
+				return err
+			}
+
+			result = append(result, processedTx)
+			if threshold > 0 && len(result) == threshold {
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetProcessedTxByInnerActionTxHash(
 	chainID string, innerActionTxHash []byte,
 ) (result TProcessedTx, err error) {
@@ -185,14 +256,8 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) AddTxs(
 				return fmt.Errorf("unsupported chain: %s", unprocessedTx.GetChainID())
 			}
 
-			unprocessedBucket := tx.Bucket(ChainBucket(UnprocessedTxsBucket, unprocessedTx.GetChainID()))
-			bytes, err := json.Marshal(unprocessedTx)
-			if err != nil {
-				return fmt.Errorf("could not marshal unprocessed tx: %w", err)
-			}
-
-			if err = unprocessedBucket.Put(unprocessedTx.UnprocessedDBKey(), bytes); err != nil {
-				return fmt.Errorf("unprocessed tx write error: %w", err)
+			if err := bd.putUnprocessedTx(tx, unprocessedTx.GetChainID(), unprocessedTx); err != nil {
+				return err
 			}
 		}
 
@@ -219,6 +284,11 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) ClearAllTxs(chainID strin
 			if err != nil {
 				return err
 			}
+
+			err = tx.Bucket(ChainBucket(UnprocessedTxsByHashBucket, chainID)).Delete(unprocessedTx.GetTxHash())
+			if err != nil {
+				return err
+			}
 		}
 
 		cursor = tx.Bucket(ChainBucket(PendingTxsBucket, chainID)).Cursor()
@@ -427,6 +497,122 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) SetBlocksSubmitterInfo(
 	})
 }
 
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetOutflowRecords() (
+	result []*core.OutflowRecord, err error,
+) {
+	err = bd.DB.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(OutflowRecordsBucket)).ForEach(func(_, v []byte) error {
+			var record *core.OutflowRecord
+
+			if err := json.Unmarshal(v, &record); err != nil {
+				return fmt.Errorf("could not unmarshal outflow record: %w", err)
+			}
+
+			result = append(result, record)
+
+			return nil
+		})
+	})
+
+	return result, err
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) RemoveOutflowRecords(records []*core.OutflowRecord) error {
+	return bd.DB.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(OutflowRecordsBucket))
+
+		for _, record := range records {
+			if err := bucket.Delete(record.DBKey()); err != nil {
+				return fmt.Errorf("could not remove outflow record: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetManualApproval(
+	chainID string, txHash common.Hash,
+) (result *core.ManualApproval, err error) {
+	err = bd.DB.View(func(tx *bbolt.Tx) error {
+		if data := tx.Bucket([]byte(ManualApprovalsBucket)).Get(
+			common.ToBridgingRequestStateDBKey(chainID, txHash)); len(data) > 0 {
+			if err := json.Unmarshal(data, &result); err != nil {
+				return fmt.Errorf("could not unmarshal manual approval: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetManualApprovals() (result []*core.ManualApproval, err error) {
+	err = bd.DB.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(ManualApprovalsBucket)).ForEach(func(_, v []byte) error {
+			var approval *core.ManualApproval
+
+			if err := json.Unmarshal(v, &approval); err != nil {
+				return fmt.Errorf("could not unmarshal manual approval: %w", err)
+			}
+
+			result = append(result, approval)
+
+			return nil
+		})
+	})
+
+	return result, err
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) ApproveManualApproval(
+	chainID string, txHash common.Hash, operator string,
+) (result *core.ManualApproval, err error) {
+	err = bd.DB.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(ManualApprovalsBucket))
+		key := common.ToBridgingRequestStateDBKey(chainID, txHash)
+
+		data := bucket.Get(key)
+		if len(data) == 0 {
+			return fmt.Errorf("tx %s is not held for approval", txHash)
+		}
+
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("could not unmarshal manual approval: %w", err)
+		}
+
+		if result.IsExpired {
+			return fmt.Errorf("review deadline of tx %s has passed", txHash)
+		}
+
+		if result.HasApproved(operator) {
+			return fmt.Errorf("tx %s is already approved by operator %s", txHash, operator)
+		}
+
+		result.Approvals = append(result.Approvals, &core.ManualApprovalVote{
+			Operator:   operator,
+			ApprovedAt: time.Now().UTC(),
+		})
+
+		bytes, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("could not marshal manual approval: %w", err)
+		}
+
+		if err := bucket.Put(key, bytes); err != nil {
+			return fmt.Errorf("manual approval write error: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) UpdateTxs(
 	data *core.UpdateTxsData[TTx, TProcessedTx, TExpectedTx],
 ) error {
@@ -473,6 +659,22 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) UpdateTxs(
 			return err
 		}
 
+		if err := bd.addDeadLetterTxs(tx, data.AddDeadLetterTxs); err != nil {
+			return err
+		}
+
+		if err := bd.addOutflowRecords(tx, data.AddOutflowRecords); err != nil {
+			return err
+		}
+
+		if err := bd.addManualApprovals(tx, data.AddManualApprovals); err != nil {
+			return err
+		}
+
+		if err := bd.expireManualApprovals(tx, data.ExpireManualApprovals); err != nil {
+			return err
+		}
+
 		err = bd.handleInnerActionLink(tx, data.MoveUnprocessedToProcessed, data.MovePendingToProcessed)
 		if err != nil {
 			return err
@@ -482,6 +684,425 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) UpdateTxs(
 	})
 }
 
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetDeadLetterTxs(
+	chainID string, threshold int,
+) ([]*core.DeadLetterTx[TTx], error) {
+	var result []*core.DeadLetterTx[TTx]
+
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return nil, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err := bd.DB.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(ChainBucket(DeadLetterTxsBucket, chainID)).Cursor()
+
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			var deadLetterTx *core.DeadLetterTx[TTx]
+
+			if err := json.Unmarshal(v, &deadLetterTx); err != nil {
+				return err
+			}
+
+			result = append(result, deadLetterTx)
+			if threshold > 0 && len(result) == threshold {
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) GetDeadLetterTxsCount(chainID string) (int, error) {
+	var result int
+
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return 0, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err := bd.DB.View(func(tx *bbolt.Tx) error {
+		result = tx.Bucket(ChainBucket(DeadLetterTxsBucket, chainID)).Stats().KeyN
+
+		return nil
+	})
+
+	return result, err
+}
+
+// ReplayDeadLetterTx removes the dead-letter tx together with its invalid processed tx,
+// updates it and stores it as unprocessed so it is processed again
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) ReplayDeadLetterTx(
+	chainID string, txHash []byte, update func(tx TTx) error,
+) (result TTx, err error) {
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return result, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err = bd.DB.Update(func(tx *bbolt.Tx) error {
+		deadLetterBucket := tx.Bucket(ChainBucket(DeadLetterTxsBucket, chainID))
+
+		data := deadLetterBucket.Get(txHash)
+		if len(data) == 0 {
+			return fmt.Errorf("dead-letter tx %s not found", hex.EncodeToString(txHash))
+		}
+
+		var deadLetterTx *core.DeadLetterTx[TTx]
+
+		if err := json.Unmarshal(data, &deadLetterTx); err != nil {
+			return err
+		}
+
+		if err := deadLetterBucket.Delete(txHash); err != nil {
+			return fmt.Errorf("could not remove dead-letter tx: %w", err)
+		}
+
+		if err := bd.removeProcessedTx(tx, chainID, txHash); err != nil {
+			return err
+		}
+
+		if err := update(deadLetterTx.Tx); err != nil {
+			return err
+		}
+
+		if err := bd.putUnprocessedTx(tx, chainID, deadLetterTx.Tx); err != nil {
+			return err
+		}
+
+		result = deadLetterTx.Tx
+
+		return nil
+	})
+
+	return result, err
+}
+
+// RequeueTx updates the tx found in the unprocessed or pending bucket and stores it as unprocessed
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) RequeueTx(
+	chainID string, txHash []byte, update func(tx TTx) error,
+) (result TTx, err error) {
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return result, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err = bd.DB.Update(func(tx *bbolt.Tx) error {
+		chainTx, err := bd.removeStuckTx(tx, chainID, txHash)
+		if err != nil {
+			return err
+		}
+
+		if err := update(chainTx); err != nil {
+			return err
+		}
+
+		if err := bd.putUnprocessedTx(tx, chainID, chainTx); err != nil {
+			return err
+		}
+
+		result = chainTx
+
+		return nil
+	})
+
+	return result, err
+}
+
+// InvalidateTx moves the tx found in the unprocessed or pending bucket to the processed bucket as invalid
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) InvalidateTx(
+	chainID string, txHash []byte,
+) (result TTx, err error) {
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return result, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err = bd.DB.Update(func(tx *bbolt.Tx) error {
+		chainTx, err := bd.removeStuckTx(tx, chainID, txHash)
+		if err != nil {
+			return err
+		}
+
+		processedTx := chainTx.ToProcessed(true)
+
+		bytes, err := json.Marshal(processedTx)
+		if err != nil {
+			return fmt.Errorf("could not marshal processed tx: %w", err)
+		}
+
+		processedBucket := tx.Bucket(ChainBucket(ProcessedTxsBucket, chainID))
+		if err = processedBucket.Put(processedTx.GetTxHash(), bytes); err != nil {
+			return fmt.Errorf("processed tx write error: %w", err)
+		}
+
+		if err := bd.handleInnerActionLink(tx, nil, []core.BaseProcessedTx{processedTx}); err != nil {
+			return err
+		}
+
+		result = chainTx
+
+		return nil
+	})
+
+	return result, err
+}
+
+// WithdrawTx removes the tx from the unprocessed or pending bucket if shouldWithdraw returns true for it
+// and returns the removed tx. If the tx is not in these buckets or should not be withdrawn,
+// the zero value is returned
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) WithdrawTx(
+	chainID string, txHash []byte, shouldWithdraw func(tx TTx, isPending bool) bool,
+) (result TTx, err error) {
+	if supported := bd.SupportedChains[chainID]; !supported {
+		return result, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+
+	err = bd.DB.Update(func(tx *bbolt.Tx) error {
+		chainTx, isPending, key, err := bd.findStuckTx(tx, chainID, txHash)
+		if err != nil || key == nil || !shouldWithdraw(chainTx, isPending) {
+			return err
+		}
+
+		if err := bd.deleteStuckTx(tx, chainID, isPending, key, txHash); err != nil {
+			return err
+		}
+
+		result = chainTx
+
+		return nil
+	})
+
+	return result, err
+}
+
+// removeStuckTx removes the tx from the pending or unprocessed bucket and returns it
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) removeStuckTx(
+	tx *bbolt.Tx, chainID string, txHash []byte,
+) (result TTx, err error) {
+	result, isPending, key, err := bd.findStuckTx(tx, chainID, txHash)
+	if err != nil {
+		return result, err
+	}
+
+	if key == nil {
+		if data := tx.Bucket(ChainBucket(ProcessedTxsBucket, chainID)).Get(txHash); len(data) > 0 {
+			return result, fmt.Errorf("tx %s is already processed", hex.EncodeToString(txHash))
+		}
+
+		return result, fmt.Errorf("tx %s not found in unprocessed and pending txs", hex.EncodeToString(txHash))
+	}
+
+	return result, bd.deleteStuckTx(tx, chainID, isPending, key, txHash)
+}
+
+// findStuckTx returns the tx from the pending or unprocessed bucket and the key it is stored under.
+// The key is nil if the tx is in neither of them
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) findStuckTx(
+	tx *bbolt.Tx, chainID string, txHash []byte,
+) (result TTx, isPending bool, key []byte, err error) {
+	if data := tx.Bucket(ChainBucket(PendingTxsBucket, chainID)).Get(txHash); len(data) > 0 {
+		if err := json.Unmarshal(data, &result); err != nil {
+			return result, false, nil, err
+		}
+
+		return result, true, txHash, nil
+	}
+
+	unprocessedKey := tx.Bucket(ChainBucket(UnprocessedTxsByHashBucket, chainID)).Get(txHash)
+	if len(unprocessedKey) == 0 {
+		return result, false, nil, nil
+	}
+
+	data := tx.Bucket(ChainBucket(UnprocessedTxsBucket, chainID)).Get(unprocessedKey)
+	if len(data) == 0 {
+		return result, false, nil, fmt.Errorf(
+			"unprocessed tx %s is indexed but not stored", hex.EncodeToString(txHash))
+	}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, false, nil, err
+	}
+
+	return result, false, slices.Clone(unprocessedKey), nil
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) deleteStuckTx(
+	tx *bbolt.Tx, chainID string, isPending bool, key, txHash []byte,
+) error {
+	if isPending {
+		if err := tx.Bucket(ChainBucket(PendingTxsBucket, chainID)).Delete(key); err != nil {
+			return fmt.Errorf("could not remove from pending txs: %w", err)
+		}
+
+		return nil
+	}
+
+	return bd.deleteUnprocessedTx(tx, chainID, key, txHash)
+}
+
+// putUnprocessedTx stores the tx in the unprocessed bucket and indexes it by its hash
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) putUnprocessedTx(
+	tx *bbolt.Tx, chainID string, unprocessedTx core.BaseTx,
+) error {
+	bytes, err := json.Marshal(unprocessedTx)
+	if err != nil {
+		return fmt.Errorf("could not marshal unprocessed tx: %w", err)
+	}
+
+	key := unprocessedTx.UnprocessedDBKey()
+
+	if err = tx.Bucket(ChainBucket(UnprocessedTxsBucket, chainID)).Put(key, bytes); err != nil {
+		return fmt.Errorf("unprocessed tx write error: %w", err)
+	}
+
+	if err = tx.Bucket(ChainBucket(UnprocessedTxsByHashBucket, chainID)).Put(unprocessedTx.GetTxHash(), key); err != nil {
+		return fmt.Errorf("unprocessed tx index write error: %w", err)
+	}
+
+	return nil
+}
+
+// deleteUnprocessedTx removes the tx from the unprocessed bucket together with its index entry
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) deleteUnprocessedTx(
+	tx *bbolt.Tx, chainID string, key, txHash []byte,
+) error {
+	if err := tx.Bucket(ChainBucket(UnprocessedTxsBucket, chainID)).Delete(key); err != nil {
+		return fmt.Errorf("could not remove from unprocessed txs: %w", err)
+	}
+
+	if err := tx.Bucket(ChainBucket(UnprocessedTxsByHashBucket, chainID)).Delete(txHash); err != nil {
+		return fmt.Errorf("could not remove from unprocessed txs index: %w", err)
+	}
+
+	return nil
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) removeProcessedTx(
+	tx *bbolt.Tx, chainID string, txHash []byte,
+) error {
+	processedBucket := tx.Bucket(ChainBucket(ProcessedTxsBucket, chainID))
+
+	data := processedBucket.Get(txHash)
+	if len(data) == 0 {
+		return nil
+	}
+
+	var processedTx TProcessedTx
+
+	if err := json.Unmarshal(data, &processedTx); err != nil {
+		return err
+	}
+
+	if processedTx.HasInnerActionTxHash() {
+		innerActionBucket := tx.Bucket(ChainBucket(ProcessedTxsByInnerActionBucket, chainID))
+		if err := innerActionBucket.Delete(processedTx.GetInnerActionTxHash()); err != nil {
+			return fmt.Errorf("could not remove processed tx by inner action: %w", err)
+		}
+	}
+
+	if err := processedBucket.Delete(txHash); err != nil {
+		return fmt.Errorf("could not remove processed tx: %w", err)
+	}
+
+	return nil
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) addDeadLetterTxs(
+	tx *bbolt.Tx, deadLetterTxs []*core.DeadLetterTx[TTx],
+) error {
+	for _, deadLetterTx := range deadLetterTxs {
+		bytes, err := json.Marshal(deadLetterTx)
+		if err != nil {
+			return fmt.Errorf("could not marshal dead-letter tx: %w", err)
+		}
+
+		bucket := tx.Bucket(ChainBucket(DeadLetterTxsBucket, deadLetterTx.Tx.GetChainID()))
+		if err = bucket.Put(deadLetterTx.Tx.GetTxHash(), bytes); err != nil {
+			return fmt.Errorf("dead-letter tx write error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) addOutflowRecords(
+	tx *bbolt.Tx, records []*core.OutflowRecord,
+) error {
+	bucket := tx.Bucket([]byte(OutflowRecordsBucket))
+
+	for _, record := range records {
+		bytes, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("could not marshal outflow record: %w", err)
+		}
+
+		// a retried request overwrites its previous record, so it is not counted twice
+		if err = bucket.Put(record.DBKey(), bytes); err != nil {
+			return fmt.Errorf("outflow record write error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) addManualApprovals(
+	tx *bbolt.Tx, approvals []*core.ManualApproval,
+) error {
+	bucket := tx.Bucket([]byte(ManualApprovalsBucket))
+
+	for _, approval := range approvals {
+		// the approvals given in the meantime must not be overwritten
+		if bucket.Get(approval.DBKey()) != nil {
+			continue
+		}
+
+		bytes, err := json.Marshal(approval)
+		if err != nil {
+			return fmt.Errorf("could not marshal manual approval: %w", err)
+		}
+
+		if err = bucket.Put(approval.DBKey(), bytes); err != nil {
+			return fmt.Errorf("manual approval write error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) expireManualApprovals(
+	tx *bbolt.Tx, approvals []*core.ManualApproval,
+) error {
+	bucket := tx.Bucket([]byte(ManualApprovalsBucket))
+
+	for _, x := range approvals {
+		var approval *core.ManualApproval
+
+		// the stored approval keeps the votes given in the meantime
+		if data := bucket.Get(x.DBKey()); len(data) > 0 {
+			if err := json.Unmarshal(data, &approval); err != nil {
+				return fmt.Errorf("could not unmarshal manual approval: %w", err)
+			}
+		} else {
+			approval = x
+		}
+
+		approval.IsExpired = true
+
+		bytes, err := json.Marshal(approval)
+		if err != nil {
+			return fmt.Errorf("could not marshal manual approval: %w", err)
+		}
+
+		if err = bucket.Put(approval.DBKey(), bytes); err != nil {
+			return fmt.Errorf("manual approval write error: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) markAndMoveExpectedTxs(
 	tx *bbolt.Tx, expectedTxs []TExpectedTx, markFunc func(expectedTx TExpectedTx),
 ) error {
@@ -533,15 +1154,8 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) updateUnprocessed(
 			return fmt.Errorf("unsupported chain: %s", unprocessedTx.GetChainID())
 		}
 
-		unprocessedBucket := tx.Bucket(ChainBucket(UnprocessedTxsBucket, unprocessedTx.GetChainID()))
-
-		bytes, err := json.Marshal(unprocessedTx)
-		if err != nil {
-			return fmt.Errorf("could not marshal unprocessed tx: %w", err)
-		}
-
-		if err = unprocessedBucket.Put(unprocessedTx.UnprocessedDBKey(), bytes); err != nil {
-			return fmt.Errorf("unprocessed tx write error: %w", err)
+		if err := bd.putUnprocessedTx(tx, unprocessedTx.GetChainID(), unprocessedTx); err != nil {
+			return err
 		}
 	}
 
@@ -556,7 +1170,6 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) moveUnprocessedToPending(
 			return fmt.Errorf("unsupported chain: %s", unprocessedTx.GetChainID())
 		}
 
-		unprocessedBucket := tx.Bucket(ChainBucket(UnprocessedTxsBucket, unprocessedTx.GetChainID()))
 		pendingBucket := tx.Bucket(ChainBucket(PendingTxsBucket, unprocessedTx.GetChainID()))
 
 		bytes, err := json.Marshal(unprocessedTx)
@@ -568,8 +1181,10 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) moveUnprocessedToPending(
 			return fmt.Errorf("pending tx write error: %w", err)
 		}
 
-		if err := unprocessedBucket.Delete(unprocessedTx.UnprocessedDBKey()); err != nil {
-			return fmt.Errorf("could not remove from unprocessed txs: %w", err)
+		err = bd.deleteUnprocessedTx(
+			tx, unprocessedTx.GetChainID(), unprocessedTx.UnprocessedDBKey(), unprocessedTx.GetTxHash())
+		if err != nil {
+			return err
 		}
 	}
 
@@ -584,7 +1199,6 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) moveUnprocessedToProcesse
 			return fmt.Errorf("unsupported chain: %s", unprocessedTx.GetChainID())
 		}
 
-		unprocessedBucket := tx.Bucket(ChainBucket(UnprocessedTxsBucket, unprocessedTx.GetChainID()))
 		processedBucket := tx.Bucket(ChainBucket(ProcessedTxsBucket, unprocessedTx.GetChainID()))
 
 		bytes, err := json.Marshal(unprocessedTx)
@@ -596,8 +1210,10 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) moveUnprocessedToProcesse
 			return fmt.Errorf("processed tx write error: %w", err)
 		}
 
-		if err := unprocessedBucket.Delete(unprocessedTx.UnprocessedDBKey()); err != nil {
-			return fmt.Errorf("could not remove from unprocessed txs: %w", err)
+		err = bd.deleteUnprocessedTx(
+			tx, unprocessedTx.GetChainID(), unprocessedTx.UnprocessedDBKey(), unprocessedTx.GetTxHash())
+		if err != nil {
+			return err
 		}
 	}
 
@@ -608,16 +1224,10 @@ func (bd *BBoltDBBase[TTx, TProcessedTx, TExpectedTx]) movePendingToUnprocessed(
 	tx *bbolt.Tx, pendingTxs []core.BaseTx,
 ) error {
 	for _, pendingTx := range pendingTxs {
-		unprocessedBucket := tx.Bucket(ChainBucket(UnprocessedTxsBucket, pendingTx.GetChainID()))
 		pendingBucket := tx.Bucket(ChainBucket(PendingTxsBucket, pendingTx.GetChainID()))
 
-		bytes, err := json.Marshal(pendingTx)
-		if err != nil {
-			return fmt.Errorf("could not marshal unprocessed tx: %w", err)
-		}
-
-		if err = unprocessedBucket.Put(pendingTx.UnprocessedDBKey(), bytes); err != nil {
-			return fmt.Errorf("unprocessed tx write error: %w", err)
+		if err := bd.putUnprocessedTx(tx, pendingTx.GetChainID(), pendingTx); err != nil {
+			return err
 		}
 
 		if err := pendingBucket.Delete(pendingTx.GetTxHash()); err != nil {
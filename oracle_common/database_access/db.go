@@ -3,6 +3,7 @@ package databaseaccess
 import (
 	"fmt"
 	"path/filepath"
+	"slices"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
@@ -11,6 +12,7 @@ import (
 
 var (
 	UnprocessedTxsBucket            = "UnprocessedTxs"
+	UnprocessedTxsByHashBucket      = "UnprocessedTxsByHash"
 	PendingTxsBucket                = "PendingTxs"
 	ProcessedTxsBucket              = "ProcessedTxs"
 	ExpectedTxsBucket               = "ExpectedTxs"
@@ -18,6 +20,9 @@ var (
 	UnprocessedBatchEventsBucket    = "UnprocessedBatchEvents"
 	ProcessedTxsByInnerActionBucket = "ProcessedTxsByInnerAction"
 	BlocksSubmitterBucket           = "BlocksSubmitterBucket"
+	DeadLetterTxsBucket             = "DeadLetterTxs"
+	OutflowRecordsBucket            = "OutflowRecords"  // shared by all the chains
+	ManualApprovalsBucket           = "ManualApprovals" // shared by all the chains
 )
 
 func NewDatabase(pathToFile string, appConfig *core.AppConfig) (*bbolt.DB, error) {
@@ -38,9 +43,12 @@ func initDB(filePath string, appConfig *core.AppConfig) (*bbolt.DB, error) {
 		return nil, fmt.Errorf("could not open db: %w", err)
 	}
 
-	var allBuckets [][]byte
+	allBuckets := [][]byte{[]byte(OutflowRecordsBucket), []byte(ManualApprovalsBucket)}
+	chainIDs := make([]string, 0, len(appConfig.CardanoChains)+len(appConfig.EthChains))
+
 	for _, chain := range appConfig.CardanoChains {
 		allBuckets = append(allBuckets, defaultChainBuckets(chain.ChainID)...)
+		chainIDs = append(chainIDs, chain.ChainID)
 	}
 
 	for _, chain := range appConfig.EthChains {
@@ -48,9 +56,19 @@ func initDB(filePath string, appConfig *core.AppConfig) (*bbolt.DB, error) {
 			append(allBuckets, defaultChainBuckets(chain.ChainID)...),
 			ChainBucket(ProcessedTxsByInnerActionBucket, chain.ChainID),
 		)
+		chainIDs = append(chainIDs, chain.ChainID)
 	}
 
 	err = db.Update(func(tx *bbolt.Tx) error {
+		// databases created before the index was introduced must have it built from the existing txs
+		var chainsWithoutIndex []string
+
+		for _, chainID := range chainIDs {
+			if tx.Bucket(ChainBucket(UnprocessedTxsByHashBucket, chainID)) == nil {
+				chainsWithoutIndex = append(chainsWithoutIndex, chainID)
+			}
+		}
+
 		for _, bn := range allBuckets {
 			_, err := tx.CreateBucketIfNotExists(bn)
 			if err != nil {
@@ -58,6 +76,12 @@ func initDB(filePath string, appConfig *core.AppConfig) (*bbolt.DB, error) {
 			}
 		}
 
+		for _, chainID := range chainsWithoutIndex {
+			if err := buildUnprocessedTxsIndex(tx, chainID); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 
@@ -71,11 +95,36 @@ func initDB(filePath string, appConfig *core.AppConfig) (*bbolt.DB, error) {
 func defaultChainBuckets(chainID string) [][]byte {
 	return [][]byte{
 		ChainBucket(UnprocessedTxsBucket, chainID),
+		ChainBucket(UnprocessedTxsByHashBucket, chainID),
 		ChainBucket(PendingTxsBucket, chainID),
 		ChainBucket(ProcessedTxsBucket, chainID),
 		ChainBucket(ExpectedTxsBucket, chainID),
 		ChainBucket(ProcessedExpectedTxsBucket, chainID),
 		ChainBucket(UnprocessedBatchEventsBucket, chainID),
 		ChainBucket(BlocksSubmitterBucket, chainID),
+		ChainBucket(DeadLetterTxsBucket, chainID),
+	}
+}
+
+// buildUnprocessedTxsIndex indexes all the unprocessed txs of the chain by their hash.
+// Unprocessed tx keys of all the chains are priority | block | tx hash
+func buildUnprocessedTxsIndex(tx *bbolt.Tx, chainID string) error {
+	const txHashOffset = 9
+
+	indexBucket := tx.Bucket(ChainBucket(UnprocessedTxsByHashBucket, chainID))
+	cursor := tx.Bucket(ChainBucket(UnprocessedTxsBucket, chainID)).Cursor()
+
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		if len(k) <= txHashOffset {
+			return fmt.Errorf("invalid unprocessed tx key %x for chain %s", k, chainID)
+		}
+
+		key := slices.Clone(k)
+
+		if err := indexBucket.Put(key[txHashOffset:], key); err != nil {
+			return fmt.Errorf("unprocessed tx index write error: %w", err)
+		}
 	}
+
+	return nil
 }
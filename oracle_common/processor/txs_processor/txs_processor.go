@@ -9,6 +9,7 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	"github.com/Ethernal-Tech/apex-bridge/health"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	"github.com/Ethernal-Tech/apex-bridge/telemetry"
 	"github.com/Ethernal-Tech/apex-bridge/validatorobserver"
@@ -43,6 +44,8 @@ func NewTxsProcessorImpl(
 	validatorSetObserver validatorobserver.IValidatorSetObserver,
 	logger hclog.Logger,
 ) *TxsProcessorImpl {
+	health.RegisterComponent(health.ComponentName("txs_processor", stateProcessor.GetChainType()))
+
 	return &TxsProcessorImpl{
 		ctx:                         ctx,
 		stateProcessor:              stateProcessor,
@@ -72,6 +75,8 @@ func (p *TxsProcessorImpl) Start() {
 			}
 
 			p.processAllStartingWithChain(key)
+
+			health.ReportTick(health.ComponentName("txs_processor", p.stateProcessor.GetChainType()))
 		}
 	}
 }
@@ -305,9 +310,10 @@ func (p *TxsProcessorImpl) updateBridgingStateForBatch(
 		dstChainID := common.ToStrChainID(event.DstChainID)
 
 		if event.IsFailedClaim {
-			err = bridgingRequestStateUpdater.FailedToExecuteOnDestination(stateKeys, dstChainID)
+			err = bridgingRequestStateUpdater.FailedToExecuteOnDestination(stateKeys, dstChainID, event.BatchID)
 		} else {
-			err = bridgingRequestStateUpdater.ExecutedOnDestination(stateKeys, event.DstTxHash, dstChainID)
+			err = bridgingRequestStateUpdater.ExecutedOnDestination(
+				stateKeys, event.DstTxHash, dstChainID, event.BatchID)
 		}
 
 		if err != nil {
@@ -0,0 +1,126 @@
+package screening
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+// FileAddressScreener screens addresses against a locally maintained blocklist file pinned by its sha256 hash.
+// The file is reloaded whenever its modification time or size changes, but only if its hash still matches
+type FileAddressScreener struct {
+	path    string
+	hash    []byte
+	logger  hclog.Logger
+	lock    sync.Mutex
+	blocked map[string]bool
+	modTime time.Time
+	size    int64
+}
+
+var _ core.AddressScreener = (*FileAddressScreener)(nil)
+
+func NewFileAddressScreener(path string, hashStr string, logger hclog.Logger) (*FileAddressScreener, error) {
+	hash, err := hex.DecodeString(hashStr)
+	if err != nil || len(hash) != sha256.Size {
+		return nil, fmt.Errorf("invalid address blocklist sha256: %s", hashStr)
+	}
+
+	screener := &FileAddressScreener{
+		path:   path,
+		hash:   hash,
+		logger: logger,
+	}
+
+	if err := screener.reload(); err != nil {
+		return nil, err
+	}
+
+	return screener, nil
+}
+
+// GetBlockedAddress implements core.AddressScreener.
+func (s *FileAddressScreener) GetBlockedAddress(addrs ...string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// the previous blocklist stays in use if the file can not be reloaded
+	if err := s.reload(); err != nil {
+		s.logger.Error("Failed to reload address blocklist", "path", s.path, "err", err)
+	}
+
+	for _, addr := range addrs {
+		if s.blocked[normalizeAddress(addr)] {
+			return addr
+		}
+	}
+
+	return ""
+}
+
+func (s *FileAddressScreener) reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat address blocklist: %w", err)
+	}
+
+	if s.blocked != nil && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read address blocklist: %w", err)
+	}
+
+	if hash := sha256.Sum256(content); !bytes.Equal(hash[:], s.hash) {
+		// the changed file is not read again until it changes once more
+		s.modTime = info.ModTime()
+		s.size = info.Size()
+
+		return fmt.Errorf("address blocklist sha256 mismatch: expected %x, got %x", s.hash, hash)
+	}
+
+	blocked := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		blocked[normalizeAddress(line)] = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read address blocklist: %w", err)
+	}
+
+	s.blocked = blocked
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+
+	s.logger.Info("Address blocklist loaded", "path", s.path, "addresses", len(blocked))
+
+	return nil
+}
+
+// normalizeAddress makes the evm addresses case insensitive, cardano addresses are compared as they are
+func normalizeAddress(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if strings.HasPrefix(addr, "0x") || strings.HasPrefix(addr, "0X") {
+		return strings.ToLower(addr)
+	}
+
+	return addr
+}
@@ -0,0 +1,59 @@
+package screening
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileAddressScreener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	content := []byte("# sanctioned\naddr_test1\n\n  0xAbCd  \n")
+	hash := sha256.Sum256(content)
+
+	_, err := NewFileAddressScreener(path, hex.EncodeToString(hash[:]), hclog.NewNullLogger())
+	require.ErrorContains(t, err, "failed to stat address blocklist")
+
+	require.NoError(t, os.WriteFile(path, content, 0600))
+
+	_, err = NewFileAddressScreener(path, "0x1", hclog.NewNullLogger())
+	require.ErrorContains(t, err, "invalid address blocklist sha256")
+
+	_, err = NewFileAddressScreener(path, hex.EncodeToString(make([]byte, sha256.Size)), hclog.NewNullLogger())
+	require.ErrorContains(t, err, "address blocklist sha256 mismatch")
+
+	screener, err := NewFileAddressScreener(path, hex.EncodeToString(hash[:]), hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	require.Equal(t, "", screener.GetBlockedAddress("addr_test2", "0xabce"))
+	require.Equal(t, "0xABCD", screener.GetBlockedAddress("addr_test2", "0xABCD"))
+	require.Equal(t, "addr_test1", screener.GetBlockedAddress("addr_test1"))
+	require.Equal(t, "", screener.GetBlockedAddress("ADDR_TEST1"))
+
+	t.Run("reloaded on change with the same hash", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, content, 0600))
+		require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+		require.Equal(t, "addr_test1", screener.GetBlockedAddress("addr_test1"))
+	})
+
+	t.Run("previous blocklist kept on hash mismatch", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("addr_test2\n"), 0600))
+		require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute*2)))
+
+		require.Equal(t, "addr_test1", screener.GetBlockedAddress("addr_test1"))
+		require.Equal(t, "", screener.GetBlockedAddress("addr_test2"))
+	})
+
+	t.Run("previous blocklist kept on failure", func(t *testing.T) {
+		require.NoError(t, os.Remove(path))
+
+		require.Equal(t, "addr_test1", screener.GetBlockedAddress("addr_test1"))
+	})
+}
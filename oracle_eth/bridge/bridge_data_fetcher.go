@@ -9,6 +9,7 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/batcher/batcher"
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	oCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
 	"github.com/Ethernal-Tech/ethgo"
 	"github.com/hashicorp/go-hclog"
@@ -19,9 +20,10 @@ const (
 )
 
 type EthBridgeDataFetcherImpl struct {
-	ctx      context.Context
-	bridgeSC eth.IOracleBridgeSmartContract
-	logger   hclog.Logger
+	ctx       context.Context
+	bridgeSC  eth.IOracleBridgeSmartContract
+	appConfig *oCore.AppConfig
+	logger    hclog.Logger
 }
 
 var _ core.EthBridgeDataFetcher = (*EthBridgeDataFetcherImpl)(nil)
@@ -29,12 +31,14 @@ var _ core.EthBridgeDataFetcher = (*EthBridgeDataFetcherImpl)(nil)
 func NewEthBridgeDataFetcher(
 	ctx context.Context,
 	bridgeSC eth.IOracleBridgeSmartContract,
+	appConfig *oCore.AppConfig,
 	logger hclog.Logger,
 ) *EthBridgeDataFetcherImpl {
 	return &EthBridgeDataFetcherImpl{
-		ctx:      ctx,
-		bridgeSC: bridgeSC,
-		logger:   logger,
+		ctx:       ctx,
+		bridgeSC:  bridgeSC,
+		appConfig: appConfig,
+		logger:    logger,
 	}
 }
 
@@ -53,6 +57,29 @@ func (df *EthBridgeDataFetcherImpl) GetBatchTransactions(
 	return txs, nil
 }
 
+// IsBridgingRequestConfirmed checks if the bridge already confirmed the bridging request tx,
+// i.e. the tx is waiting to be included in a batch for the destination chain
+func (df *EthBridgeDataFetcherImpl) IsBridgingRequestConfirmed(
+	sourceChainID, destinationChainID string, txHash ethgo.Hash,
+) (bool, error) {
+	txs, err := df.bridgeSC.GetConfirmedTransactions(df.ctx, destinationChainID)
+	if err != nil {
+		df.logger.Error("Failed to retrieve confirmed transactions", "chainID", destinationChainID, "err", err)
+
+		return false, err
+	}
+
+	sourceChainIDNum := common.ToNumChainID(sourceChainID)
+
+	for _, tx := range txs {
+		if tx.SourceChainId == sourceChainIDNum && tx.ObservedTransactionHash == txHash {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (df *EthBridgeDataFetcherImpl) FetchExpectedTx(chainID string) (*core.BridgeExpectedEthTx, error) {
 	for retries := 1; retries <= MaxRetries; retries++ {
 		lastBatchRawTx, batchType, err := df.bridgeSC.GetRawTransactionAndBatchTypeFromLastBatch(df.ctx, chainID)
@@ -68,7 +95,8 @@ func (df *EthBridgeDataFetcherImpl) FetchExpectedTx(chainID string) (*core.Bridg
 
 			switch batchType {
 			case uint8(batcher.Normal):
-				tx, err := eth.NewEVMSmartContractTransaction(lastBatchRawTx)
+				tx, err := eth.NewEVMSmartContractTransaction(
+					lastBatchRawTx, df.appConfig.GetGatewayContractsABI(chainID))
 				if err != nil {
 					df.logger.Error("Failed to parse evm tx", "rawTx", hex.EncodeToString(lastBatchRawTx), "err", err)
 
@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	oCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
 	"github.com/Ethernal-Tech/ethgo"
 	goEthCommon "github.com/ethereum/go-ethereum/common"
@@ -17,9 +19,11 @@ import (
 )
 
 func TestEthBridgeDataFetcher(t *testing.T) {
+	appConfig := &oCore.AppConfig{}
+
 	t.Run("NewBridgeDataFetcher", func(t *testing.T) {
 		bridgeSC := &eth.OracleBridgeSmartContractMock{}
-		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, hclog.NewNullLogger())
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, appConfig, hclog.NewNullLogger())
 
 		require.NotNil(t, bridgeDataFetcher)
 	})
@@ -29,7 +33,7 @@ func TestEthBridgeDataFetcher(t *testing.T) {
 		bridgeSC.On("GetBatchStatusAndTransactions", mock.Anything, mock.Anything, mock.Anything).
 			Return(uint8(0), nil, fmt.Errorf("test err"))
 
-		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, hclog.NewNullLogger())
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, appConfig, hclog.NewNullLogger())
 		require.NotNil(t, bridgeDataFetcher)
 
 		_, err := bridgeDataFetcher.GetBatchTransactions(common.ChainIDStrPrime, 1)
@@ -42,7 +46,7 @@ func TestEthBridgeDataFetcher(t *testing.T) {
 		bridgeSC.On("GetBatchStatusAndTransactions", mock.Anything, mock.Anything, mock.Anything).
 			Return(uint8(0), []eth.TxDataInfo{{}}, nil)
 
-		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, hclog.NewNullLogger())
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, appConfig, hclog.NewNullLogger())
 		require.NotNil(t, bridgeDataFetcher)
 
 		batchTxs, err := bridgeDataFetcher.GetBatchTransactions(common.ChainIDStrPrime, 1)
@@ -53,7 +57,7 @@ func TestEthBridgeDataFetcher(t *testing.T) {
 	t.Run("FetchExpectedTx nil", func(t *testing.T) {
 		bridgeSC := &eth.OracleBridgeSmartContractMock{}
 		bridgeSC.On("GetRawTransactionAndBatchTypeFromLastBatch").Return(nil, nil)
-		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, hclog.NewNullLogger())
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, appConfig, hclog.NewNullLogger())
 
 		require.NotNil(t, bridgeDataFetcher)
 
@@ -65,7 +69,7 @@ func TestEthBridgeDataFetcher(t *testing.T) {
 	t.Run("FetchExpectedTx err", func(t *testing.T) {
 		bridgeSC := &eth.OracleBridgeSmartContractMock{}
 		bridgeSC.On("GetRawTransactionAndBatchTypeFromLastBatch").Return(nil, fmt.Errorf("test err"))
-		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, hclog.NewNullLogger())
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, appConfig, hclog.NewNullLogger())
 
 		require.NotNil(t, bridgeDataFetcher)
 
@@ -78,7 +82,7 @@ func TestEthBridgeDataFetcher(t *testing.T) {
 	t.Run("FetchExpectedTx parse tx fail", func(t *testing.T) {
 		bridgeSC := &eth.OracleBridgeSmartContractMock{}
 		bridgeSC.On("GetRawTransactionAndBatchTypeFromLastBatch").Return([]byte{12, 33}, nil)
-		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, hclog.NewNullLogger())
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, appConfig, hclog.NewNullLogger())
 
 		require.NotNil(t, bridgeDataFetcher)
 
@@ -96,20 +100,21 @@ func TestEthBridgeDataFetcher(t *testing.T) {
 			FeeAmount:    big.NewInt(1),
 			Receivers: []eth.EVMSmartContractTransactionReceiver{
 				{
-					Address: goEthCommon.Address([20]byte{1, 2}),
-					Amount:  big.NewInt(1),
+					Address:     goEthCommon.Address([20]byte{1, 2}),
+					Amount:      big.NewInt(1),
+					TokenAmount: big.NewInt(0),
 				},
 			},
 		}
 
-		ethTxBytes, err := ethTx.Pack()
+		ethTxBytes, err := ethTx.Pack(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		txHash, err := common.Keccak256(ethTxBytes)
 		require.NoError(t, err)
 
 		bridgeSC.On("GetRawTransactionAndBatchTypeFromLastBatch").Return(ethTxBytes, nil)
-		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, hclog.NewNullLogger())
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, appConfig, hclog.NewNullLogger())
 
 		require.NotNil(t, bridgeDataFetcher)
 
@@ -125,4 +130,36 @@ func TestEthBridgeDataFetcher(t *testing.T) {
 		require.Equal(t, ethTx.BatchNonceID, metadata.BatchNonceID)
 		require.Equal(t, common.BridgingTxTypeBatchExecution, metadata.BridgingTxType)
 	})
+	t.Run("FetchExpectedTx legacy gateway", func(t *testing.T) {
+		bridgeSC := &eth.OracleBridgeSmartContractMock{}
+
+		ethTx := eth.EVMSmartContractTransaction{
+			BatchNonceID: 3,
+			TTL:          4,
+			FeeAmount:    big.NewInt(1),
+			Receivers: []eth.EVMSmartContractTransactionReceiver{
+				{
+					Address: goEthCommon.Address([20]byte{1, 2}),
+					Amount:  big.NewInt(1),
+				},
+			},
+		}
+
+		ethTxBytes, err := ethTx.Pack(contractbinding.LegacyContractsABI)
+		require.NoError(t, err)
+
+		legacyConfig := &oCore.AppConfig{
+			EthChains: map[string]*oCore.EthChainConfig{
+				common.ChainIDStrNexus: {LegacyContractsABI: true},
+			},
+		}
+
+		bridgeSC.On("GetRawTransactionAndBatchTypeFromLastBatch").Return(ethTxBytes, nil)
+		bridgeDataFetcher := NewEthBridgeDataFetcher(context.Background(), bridgeSC, legacyConfig, hclog.NewNullLogger())
+
+		expectedTx, err := bridgeDataFetcher.FetchExpectedTx(common.ChainIDStrNexus)
+		require.NoError(t, err)
+		require.NotNil(t, expectedTx)
+		require.Equal(t, ethTx.TTL, expectedTx.TTL)
+	})
 }
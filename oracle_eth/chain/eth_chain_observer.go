@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	oCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
@@ -32,6 +33,14 @@ func NewEthChainObserver(
 	indexerDB eventTrackerStore.EventTrackerStore,
 	logger hclog.Logger,
 ) (*EthChainObserverImpl, error) {
+	if err := config.ConfirmationMode.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := config.ConfirmationTiers.Validate(); err != nil {
+		return nil, err
+	}
+
 	err := initOracleState(indexerDB, oracleDB, config.StartBlockNumber, config.ChainID, logger)
 	if err != nil {
 		return nil, err
@@ -124,7 +133,7 @@ func loadTrackerConfigs(config *oCore.EthChainConfig, txsReceiver ethOracleCore.
 	bridgingAddress := config.BridgingAddresses.BridgingAddress
 	scAddress := ethgo.HexToAddress(bridgingAddress)
 
-	eventSigs, err := eth.GetNexusEventSignatures()
+	eventSigs, err := eth.GetNexusEventSignatures(contractbinding.GetContractsABI(config.LegacyContractsABI))
 	if err != nil {
 		logger.Error("failed to get nexus event signatures", "err", err)
 
@@ -135,7 +144,7 @@ func loadTrackerConfigs(config *oCore.EthChainConfig, txsReceiver ethOracleCore.
 		scAddress: eventSigs,
 	}
 
-	return &eventTracker.EventTrackerConfig{
+	trackerConfig := &eventTracker.EventTrackerConfig{
 		RPCEndpoint:            config.NodeURL,
 		PollInterval:           config.PoolIntervalMiliseconds * time.Millisecond,
 		SyncBatchSize:          config.SyncBatchSize,
@@ -151,6 +160,21 @@ func loadTrackerConfigs(config *oCore.EthChainConfig, txsReceiver ethOracleCore.
 		// add timestamp to the logger to differentiate between multiple instances
 		Logger: logger.Named(time.Now().UTC().String()),
 	}
+
+	if config.ConfirmationMode.IsBlockTag() {
+		// the tracker keeps NumBlockConfirmations as a fallback in case it uses its own plain provider.
+		// RPCClient is not set, so the tracker never closes the connection of the tagged provider
+		blockProvider, err := newTaggedBlockProvider(config.NodeURL, config.ConfirmationMode.BlockNumber())
+		if err != nil {
+			logger.Error("failed to create tagged block provider", "err", err)
+
+			return nil
+		}
+
+		trackerConfig.BlockProvider = blockProvider
+	}
+
+	return trackerConfig
 }
 
 type confirmedEventHandler struct {
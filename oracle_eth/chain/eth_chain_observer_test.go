@@ -2,12 +2,15 @@ package chain
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"math/big"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	oCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
@@ -64,6 +67,16 @@ func TestEthChainObserver(t *testing.T) {
 		require.Nil(t, co)
 	})
 
+	t.Run("chain observer - invalid confirmation mode", func(t *testing.T) {
+		invalidConfig := *config
+		invalidConfig.ConfirmationMode = "latest"
+
+		co, err := NewEthChainObserver(
+			&invalidConfig, &core.EthTxsReceiverMock{}, &core.EthTxsProcessorDBMock{}, &core.EventStoreMock{}, logger)
+		require.ErrorContains(t, err, "unknown confirmation mode")
+		require.Nil(t, co)
+	})
+
 	t.Run("chain observer - NewEthChainObserver OK", func(t *testing.T) {
 		txsReceiverMock := &core.EthTxsReceiverMock{}
 		oracleDB := &core.EthTxsProcessorDBMock{}
@@ -246,7 +259,7 @@ func Test_LoadTrackerConfig(t *testing.T) {
 
 	scAddress := ethgo.HexToAddress("0x00")
 
-	eventSigs, err := eth.GetNexusEventSignatures()
+	eventSigs, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 	require.NoError(t, err)
 
 	logFilter := map[ethgo.Address][]ethgo.Hash{
@@ -340,3 +353,105 @@ func TestEthChainObserver_Dispose(t *testing.T) {
 	require.NoError(t, chainObserver.Start())
 	require.NoError(t, chainObserver.Dispose())
 }
+
+func TestLoadTrackerConfigs(t *testing.T) {
+	config := &oCore.EthChainConfig{
+		ChainID:               "nexus",
+		NodeURL:               ethNodeURL,
+		NumBlockConfirmations: 5,
+	}
+
+	trackerConfig := loadTrackerConfigs(config, &core.EthTxsReceiverMock{}, hclog.NewNullLogger())
+	require.Equal(t, uint64(5), trackerConfig.NumBlockConfirmations)
+	require.Nil(t, trackerConfig.BlockProvider)
+
+	config.ConfirmationMode = eth.ConfirmationModeFinalized
+
+	trackerConfig = loadTrackerConfigs(config, &core.EthTxsReceiverMock{}, hclog.NewNullLogger())
+	require.Equal(t, uint64(5), trackerConfig.NumBlockConfirmations)
+	require.Nil(t, trackerConfig.RPCClient)
+	require.IsType(t, &taggedBlockProvider{}, trackerConfig.BlockProvider)
+	require.Equal(t, ethgo.Finalized, trackerConfig.BlockProvider.(*taggedBlockProvider).blockTag)
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+type blockProviderMock struct {
+	eventTracker.BlockProvider
+	requested []ethgo.BlockNumber
+	err       error
+	closed    bool
+}
+
+func (m *blockProviderMock) GetBlockByNumber(i ethgo.BlockNumber, _ bool) (*ethgo.Block, error) {
+	m.requested = append(m.requested, i)
+
+	return &ethgo.Block{}, m.err
+}
+
+func (m *blockProviderMock) Close() error {
+	m.closed = true
+
+	return nil
+}
+
+func TestTaggedBlockProvider(t *testing.T) {
+	var connections []*blockProviderMock
+
+	provider, err := newTaggedBlockProviderWithConnect(ethgo.Safe,
+		func() (eventTracker.BlockProvider, io.Closer, error) {
+			connection := &blockProviderMock{}
+			connections = append(connections, connection)
+
+			return connection, connection, nil
+		})
+	require.NoError(t, err)
+
+	_, err = provider.GetBlockByNumber(ethgo.Latest, false)
+	require.NoError(t, err)
+
+	_, err = provider.GetBlockByNumber(ethgo.BlockNumber(10), false)
+	require.NoError(t, err)
+
+	require.Len(t, connections, 1)
+	require.Equal(t, []ethgo.BlockNumber{ethgo.Safe, 10}, connections[0].requested)
+
+	connections[0].err = errors.New("test error")
+
+	_, err = provider.GetBlockByNumber(ethgo.Latest, false)
+	require.ErrorContains(t, err, "test error")
+	require.Len(t, connections, 1)
+
+	// timeout recreates the connection and the latest block is still the tagged one
+	connections[0].err = fmt.Errorf("wrapped: %w", timeoutError{})
+
+	_, err = provider.GetBlockByNumber(ethgo.Latest, false)
+	require.ErrorIs(t, err, timeoutError{})
+	require.Len(t, connections, 2)
+	require.True(t, connections[0].closed)
+
+	_, err = provider.GetBlockByNumber(ethgo.Latest, false)
+	require.NoError(t, err)
+	require.Equal(t, []ethgo.BlockNumber{ethgo.Safe}, connections[1].requested)
+
+	// failed reconnect keeps the old connection
+	connections[1].err = timeoutError{}
+
+	_, err = newTaggedBlockProviderWithConnect(ethgo.Safe, func() (eventTracker.BlockProvider, io.Closer, error) {
+		return nil, nil, errors.New("connect error")
+	})
+	require.ErrorContains(t, err, "connect error")
+
+	provider.connect = func() (eventTracker.BlockProvider, io.Closer, error) {
+		return nil, nil, errors.New("connect error")
+	}
+
+	_, err = provider.GetBlockByNumber(ethgo.Latest, false)
+	require.ErrorContains(t, err, "connect error")
+	require.False(t, connections[1].closed)
+	require.Equal(t, connections[1], provider.getProvider())
+}
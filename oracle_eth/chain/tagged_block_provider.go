@@ -0,0 +1,117 @@
+package chain
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"sync"
+
+	eventTracker "github.com/Ethernal-Tech/blockchain-event-tracker/tracker"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/jsonrpc"
+)
+
+type blockProviderConnectFn func() (eventTracker.BlockProvider, io.Closer, error)
+
+// taggedBlockProvider reports the block with the given tag (safe or finalized) as the latest block,
+// so the event tracker processes only the blocks up to it.
+// The provider owns its connection and recreates it after a timeout by itself, because the event tracker
+// replaces its own block provider with a plain json rpc one in that case
+type taggedBlockProvider struct {
+	blockTag ethgo.BlockNumber
+	connect  blockProviderConnectFn
+
+	provider eventTracker.BlockProvider
+	closer   io.Closer
+	lock     sync.RWMutex
+}
+
+var _ eventTracker.BlockProvider = (*taggedBlockProvider)(nil)
+
+func newTaggedBlockProvider(nodeURL string, blockTag ethgo.BlockNumber) (*taggedBlockProvider, error) {
+	return newTaggedBlockProviderWithConnect(blockTag, func() (eventTracker.BlockProvider, io.Closer, error) {
+		client, err := jsonrpc.NewClient(nodeURL)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return client.Eth(), client, nil
+	})
+}
+
+func newTaggedBlockProviderWithConnect(
+	blockTag ethgo.BlockNumber, connect blockProviderConnectFn,
+) (*taggedBlockProvider, error) {
+	provider, closer, err := connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return &taggedBlockProvider{
+		blockTag: blockTag,
+		connect:  connect,
+		provider: provider,
+		closer:   closer,
+	}, nil
+}
+
+func (p *taggedBlockProvider) GetBlockByHash(hash ethgo.Hash, full bool) (*ethgo.Block, error) {
+	block, err := p.getProvider().GetBlockByHash(hash, full)
+
+	return block, p.handleError(err)
+}
+
+func (p *taggedBlockProvider) GetBlockByNumber(i ethgo.BlockNumber, full bool) (*ethgo.Block, error) {
+	if i == ethgo.Latest {
+		i = p.blockTag
+	}
+
+	block, err := p.getProvider().GetBlockByNumber(i, full)
+
+	return block, p.handleError(err)
+}
+
+func (p *taggedBlockProvider) GetLogs(filter *ethgo.LogFilter) ([]*ethgo.Log, error) {
+	logs, err := p.getProvider().GetLogs(filter)
+
+	return logs, p.handleError(err)
+}
+
+func (p *taggedBlockProvider) ChainID() (*big.Int, error) {
+	chainID, err := p.getProvider().ChainID()
+
+	return chainID, p.handleError(err)
+}
+
+func (p *taggedBlockProvider) getProvider() eventTracker.BlockProvider {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.provider
+}
+
+// handleError recreates the connection on a timeout, the same way the event tracker does for its own provider
+func (p *taggedBlockProvider) handleError(err error) error {
+	var netErr net.Error
+
+	if err == nil || !errors.As(err, &netErr) || !netErr.Timeout() {
+		return err
+	}
+
+	provider, closer, cerr := p.connect()
+	if cerr != nil {
+		return errors.Join(err, cerr)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.closer != nil {
+		_ = p.closer.Close() // try to close the previous connection
+	}
+
+	p.provider, p.closer = provider, closer
+
+	return err
+}
@@ -16,6 +16,16 @@ type EthTx struct {
 	BatchTryCount  uint32    `json:"bf_count"`
 	RefundTryCount uint32    `json:"refund_try_count"`
 	LastTimeTried  time.Time `json:"last_time_tried"`
+	// try counts are retry counters of the claims, so they are never reset.
+	// Instead, an operator requeue sets the offsets and the limits apply only to the tries made after it
+	BatchTryCountOffset  uint32 `json:"bf_count_offset,omitempty"`
+	RefundTryCountOffset uint32 `json:"refund_try_count_offset,omitempty"`
+	// ForceRefund is set by an operator to hand the bridging request over to the refund processor
+	ForceRefund bool `json:"force_refund,omitempty"`
+	// TryTimes are the times of all failed tries, kept for the dead-letter txs
+	TryTimes []time.Time `json:"try_times,omitempty"`
+	// LastError is the reason of the last failed try
+	LastError string `json:"last_error,omitempty"`
 
 	BlockNumber     uint64        `json:"block_number"`
 	BlockHash       ethgo.Hash    `json:"block_hash"`
@@ -58,6 +68,8 @@ var _ cCore.BaseExpectedTx = (*BridgeExpectedEthTx)(nil)
 
 type EthUpdateTxsData = cCore.UpdateTxsData[*EthTx, *ProcessedEthTx, *BridgeExpectedEthTx]
 
+type EthDeadLetterTx = cCore.DeadLetterTx[*EthTx]
+
 type BridgeClaimsBlockInfo struct {
 	ChainID string
 	Number  uint64
@@ -95,11 +107,29 @@ func (tx *EthTx) SetLastTimeTried(lastTimeTried time.Time) {
 	tx.LastTimeTried = lastTimeTried
 }
 
+// AddTryTime implements core.BaseTx.
+func (tx *EthTx) AddTryTime(tryTime time.Time) {
+	tx.TryTimes = append(tx.TryTimes, tryTime)
+}
+
+// SetLastError implements core.BaseTx.
+func (tx *EthTx) SetLastError(lastError string) {
+	tx.LastError = lastError
+}
+
 // ResetSubmitTryCount implements core.BaseTx.
 func (tx *EthTx) ResetSubmitTryCount() {
 	tx.SubmitTryCount = 0
 }
 
+// ResetTryCounts gives the tx a fresh set of tries without changing the claims retry counters
+func (tx *EthTx) ResetTryCounts() {
+	tx.SubmitTryCount = 0
+	tx.LastTimeTried = time.Time{}
+	tx.BatchTryCountOffset = tx.BatchTryCount
+	tx.RefundTryCountOffset = tx.RefundTryCount
+}
+
 // IncrementBatchTryCount implements core.BaseTx.
 func (tx *EthTx) IncrementBatchTryCount() {
 	tx.BatchTryCount++
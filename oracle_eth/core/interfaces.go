@@ -16,10 +16,18 @@ type BridgeExpectedEthTxsDB interface {
 type EthTxsDB interface {
 	GetUnprocessedTxs(chainID string, priority uint8, threshold int) ([]*EthTx, error)
 	GetAllUnprocessedTxs(chainID string, threshold int) ([]*EthTx, error)
+	GetAllPendingTxs(chainID string, threshold int) ([]*EthTx, error)
 	GetPendingTx(entityID oCore.DBTxID) (oCore.BaseTx, error)
+	GetAllProcessedTxs(chainID string, threshold int) ([]*ProcessedEthTx, error)
 	GetProcessedTx(entityID oCore.DBTxID) (*ProcessedEthTx, error)
 	GetProcessedTxByInnerActionTxHash(chainID string, innerActionTxHash []byte) (*ProcessedEthTx, error)
 	ClearAllTxs(chainID string) error
+	RequeueTx(chainID string, txHash []byte, update func(tx *EthTx) error) (*EthTx, error)
+	InvalidateTx(chainID string, txHash []byte) (*EthTx, error)
+	WithdrawTx(chainID string, txHash []byte, shouldWithdraw func(tx *EthTx, isPending bool) bool) (*EthTx, error)
+	GetDeadLetterTxs(chainID string, threshold int) ([]*EthDeadLetterTx, error)
+	GetDeadLetterTxsCount(chainID string) (int, error)
+	ReplayDeadLetterTx(chainID string, txHash []byte, update func(tx *EthTx) error) (*EthTx, error)
 	MoveProcessedExpectedTxs(chainID string) error
 	GetUnprocessedBatchEvents(chainID string) ([]*oCore.DBBatchInfoEvent, error)
 	AddTxs(processedTxs []*ProcessedEthTx, unprocessedTxs []*EthTx) error
@@ -30,6 +38,8 @@ type EthTxsProcessorDB interface {
 	EthTxsDB
 	BridgeExpectedEthTxsDB
 	oCore.BlockSubmitterDB
+	oCore.OutflowRecordsDB
+	oCore.ManualApprovalsDB
 }
 
 type Database interface {
@@ -78,4 +88,5 @@ type EthChainObserver interface {
 type EthBridgeDataFetcher interface {
 	oCore.BridgeDataFetcher
 	FetchExpectedTx(chainID string) (*BridgeExpectedEthTx, error)
+	IsBridgingRequestConfirmed(sourceChainID, destinationChainID string, txHash ethgo.Hash) (bool, error)
 }
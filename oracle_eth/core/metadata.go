@@ -8,9 +8,13 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/common"
 )
 
+// BridgingRequestEthMetadataTransaction is a receiver of the gateway withdraw.
+// Token is the address of the erc20 token sent to the receiver, if any
 type BridgingRequestEthMetadataTransaction struct {
-	Address string   `json:"a"`
-	Amount  *big.Int `json:"m"`
+	Address     string   `json:"a"`
+	Amount      *big.Int `json:"m"`
+	Token       string   `json:"tk,omitempty"`
+	TokenAmount *big.Int `json:"tm,omitempty"`
 }
 
 type BaseEthMetadata struct {
@@ -53,6 +53,15 @@ func (m *EthBridgeDataFetcherMock) FetchExpectedTx(chainID string) (*BridgeExpec
 	return nil, args.Error(1)
 }
 
+// IsBridgingRequestConfirmed implements EthBridgeDataFetcher.
+func (m *EthBridgeDataFetcherMock) IsBridgingRequestConfirmed(
+	sourceChainID, destinationChainID string, txHash ethgo.Hash,
+) (bool, error) {
+	args := m.Called(sourceChainID, destinationChainID, txHash)
+
+	return args.Bool(0), args.Error(1)
+}
+
 var _ EthBridgeDataFetcher = (*EthBridgeDataFetcherMock)(nil)
 
 type EthTxsProcessorDBMock struct {
@@ -66,6 +75,45 @@ func (m *EthTxsProcessorDBMock) GetBlocksSubmitterInfo(chainID string) (oCore.Bl
 	return args.Get(0).(oCore.BlocksSubmitterInfo), args.Error(1) //nolint
 }
 
+// GetOutflowRecords implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) GetOutflowRecords() ([]*oCore.OutflowRecord, error) {
+	args := m.Called()
+
+	return args.Get(0).([]*oCore.OutflowRecord), args.Error(1) //nolint
+}
+
+// RemoveOutflowRecords implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) RemoveOutflowRecords(records []*oCore.OutflowRecord) error {
+	args := m.Called(records)
+
+	return args.Error(0)
+}
+
+// GetManualApproval implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) GetManualApproval(
+	chainID string, txHash common.Hash,
+) (*oCore.ManualApproval, error) {
+	args := m.Called(chainID, txHash)
+
+	return args.Get(0).(*oCore.ManualApproval), args.Error(1) //nolint
+}
+
+// GetManualApprovals implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) GetManualApprovals() ([]*oCore.ManualApproval, error) {
+	args := m.Called()
+
+	return args.Get(0).([]*oCore.ManualApproval), args.Error(1) //nolint
+}
+
+// ApproveManualApproval implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) ApproveManualApproval(
+	chainID string, txHash common.Hash, operator string,
+) (*oCore.ManualApproval, error) {
+	args := m.Called(chainID, txHash, operator)
+
+	return args.Get(0).(*oCore.ManualApproval), args.Error(1) //nolint
+}
+
 // SetBlocksSubmitterInfo implements EthTxsProcessorDB.
 func (m *EthTxsProcessorDBMock) SetBlocksSubmitterInfo(chainID string, info oCore.BlocksSubmitterInfo) error {
 	args := m.Called(chainID, info)
@@ -106,6 +154,79 @@ func (m *EthTxsProcessorDBMock) ClearAllTxs(chainID string) error {
 	return args.Error(0)
 }
 
+// RequeueTx implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) RequeueTx(
+	chainID string, txHash []byte, update func(tx *EthTx) error,
+) (*EthTx, error) {
+	args := m.Called(chainID, txHash, update)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).(*EthTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// InvalidateTx implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) InvalidateTx(chainID string, txHash []byte) (*EthTx, error) {
+	args := m.Called(chainID, txHash)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).(*EthTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// WithdrawTx implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) WithdrawTx(
+	chainID string, txHash []byte, shouldWithdraw func(tx *EthTx, isPending bool) bool,
+) (*EthTx, error) {
+	args := m.Called(chainID, txHash, shouldWithdraw)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).(*EthTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// GetDeadLetterTxs implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) GetDeadLetterTxs(chainID string, threshold int) ([]*EthDeadLetterTx, error) {
+	args := m.Called(chainID, threshold)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).([]*EthDeadLetterTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// GetDeadLetterTxsCount implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) GetDeadLetterTxsCount(chainID string) (int, error) {
+	args := m.Called(chainID)
+
+	return args.Int(0), args.Error(1)
+}
+
+// ReplayDeadLetterTx implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) ReplayDeadLetterTx(
+	chainID string, txHash []byte, update func(tx *EthTx) error,
+) (*EthTx, error) {
+	args := m.Called(chainID, txHash, update)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).(*EthTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
 // GetAllExpectedTxs implements EthTxsProcessorDB.
 func (m *EthTxsProcessorDBMock) GetAllExpectedTxs(
 	chainID string, threshold int,
@@ -132,6 +253,30 @@ func (m *EthTxsProcessorDBMock) GetAllUnprocessedTxs(chainID string, threshold i
 	return nil, args.Error(1)
 }
 
+// GetAllPendingTxs implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) GetAllPendingTxs(chainID string, threshold int) ([]*EthTx, error) {
+	args := m.Called(chainID, threshold)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).([]*EthTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// GetAllProcessedTxs implements EthTxsProcessorDB.
+func (m *EthTxsProcessorDBMock) GetAllProcessedTxs(chainID string, threshold int) ([]*ProcessedEthTx, error) {
+	args := m.Called(chainID, threshold)
+	if args.Get(0) != nil {
+		arg0, _ := args.Get(0).([]*ProcessedEthTx)
+
+		return arg0, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
 // GetExpectedTxs implements EthTxsProcessorDB.
 func (m *EthTxsProcessorDBMock) GetExpectedTxs(
 	chainID string, priority uint8, threshold int,
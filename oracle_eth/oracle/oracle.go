@@ -50,13 +50,14 @@ func NewEthOracle(
 	indexerDbs map[string]eventTrackerStore.EventTrackerStore,
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater,
 	validatorSetObserver validatorobserver.IValidatorSetObserver,
+	addressScreener oCore.AddressScreener,
 	logger hclog.Logger,
 ) (*OracleImpl, error) {
 	db := &databaseaccess.BBoltDatabase{}
 	db.Init(boltDB, appConfig, typeRegister)
 
 	bridgeDataFetcher := bridge.NewEthBridgeDataFetcher(
-		ctx, oracleBridgeSC, logger.Named("eth_bridge_data_fetcher"))
+		ctx, oracleBridgeSC, appConfig, logger.Named("eth_bridge_data_fetcher"))
 
 	expectedTxsFetcher := bridge.NewExpectedTxsFetcher(
 		ctx, bridgeDataFetcher, appConfig, db, logger.Named("eth_expected_txs_fetcher"))
@@ -73,7 +74,7 @@ func NewEthOracle(
 
 	successProcessors = append(successProcessors,
 		successtxprocessors.NewEthBatchExecutedProcessor(logger),
-		successtxprocessors.NewEthBridgingRequestedProcessor(refundRequestProcessor, logger),
+		successtxprocessors.NewEthBridgingRequestedProcessor(refundRequestProcessor, addressScreener, logger),
 		successtxprocessors.NewHotWalletIncrementProcessor(logger),
 	)
 
@@ -87,7 +88,7 @@ func NewEthOracle(
 	txsProcessorLogger := logger.Named("eth_txs_processor")
 
 	ethTxsReceiver := ethtxsprocessor.NewEthTxsReceiverImpl(
-		appConfig, db, txProcessors, bridgingRequestStateUpdater, txsProcessorLogger)
+		appConfig, db, txProcessors, bridgeDataFetcher, bridgingRequestStateUpdater, txsProcessorLogger)
 
 	ethStateProcessor := ethtxsprocessor.NewEthStateProcessor(
 		ctx, appConfig, db, txProcessors,
@@ -1,6 +1,7 @@
 package successtxprocessors
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -17,14 +18,17 @@ var _ core.EthTxSuccessProcessor = (*BridgingRequestedProcessorImpl)(nil)
 
 type BridgingRequestedProcessorImpl struct {
 	refundRequestProcessor core.EthTxSuccessRefundProcessor
+	addressScreener        oCore.AddressScreener
 	logger                 hclog.Logger
 }
 
 func NewEthBridgingRequestedProcessor(
-	refundRequestProcessor core.EthTxSuccessRefundProcessor, logger hclog.Logger,
+	refundRequestProcessor core.EthTxSuccessRefundProcessor, addressScreener oCore.AddressScreener,
+	logger hclog.Logger,
 ) *BridgingRequestedProcessorImpl {
 	return &BridgingRequestedProcessorImpl{
 		refundRequestProcessor: refundRequestProcessor,
+		addressScreener:        addressScreener,
 		logger:                 logger.Named("eth_bridging_requested_processor"),
 	}
 }
@@ -55,16 +59,85 @@ func (p *BridgingRequestedProcessorImpl) ValidateAndAddClaim(
 	p.logger.Debug("Validating relevant tx", "txHash", tx.Hash, "metadata", metadata)
 
 	err = p.validate(tx, metadata, appConfig)
-	if err == nil {
-		p.addBridgingRequestClaim(claims, tx, metadata, appConfig)
-	} else {
+	if err != nil {
 		return p.refundRequestProcessor.HandleBridgingProcessorError(
 			claims, tx, appConfig, err, "validation failed for tx")
 	}
 
+	if decision := p.screenAddresses(tx, metadata, appConfig); decision != nil {
+		claims.ScreeningDecisions = append(claims.ScreeningDecisions, decision)
+
+		if decision.Policy == oCore.AddressScreeningPolicyHold {
+			return fmt.Errorf("%w: %s", oCore.ErrBridgingRequestHeld, decision)
+		}
+
+		return p.refundRequestProcessor.HandleBridgingProcessorError(
+			claims, tx, appConfig, errors.New(decision.String()), "address screening failed for tx")
+	}
+
+	p.addBridgingRequestClaim(claims, tx, metadata, appConfig)
+
+	return nil
+}
+
+// Validate runs the same checks as ValidateAndAddClaim without adding a claim or calling the refund
+// processor error handler, so the returned error is the exact reason the tx would be rejected
+func (p *BridgingRequestedProcessorImpl) Validate(tx *core.EthTx, appConfig *oCore.AppConfig) error {
+	metadata, err := core.UnmarshalEthMetadata[core.BridgingRequestEthMetadata](tx.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	if metadata.BridgingTxType != p.GetType() {
+		return fmt.Errorf("metadata bridging tx type is not %s", p.GetType())
+	}
+
+	if err := p.validate(tx, metadata, appConfig); err != nil {
+		return err
+	}
+
+	if decision := p.screenAddresses(tx, metadata, appConfig); decision != nil {
+		return errors.New(decision.String())
+	}
+
 	return nil
 }
 
+// screenAddresses returns the screening decision if the sender or one of the receivers
+// (except the fee address) is blocked, or nil otherwise
+func (p *BridgingRequestedProcessorImpl) screenAddresses(
+	tx *core.EthTx, metadata *core.BridgingRequestEthMetadata, appConfig *oCore.AppConfig,
+) *oCore.AddressScreeningDecision {
+	if p.addressScreener == nil {
+		return nil
+	}
+
+	cardanoDestConfig, _ := oUtils.GetChainConfig(appConfig, metadata.DestinationChainID)
+
+	addrs := make([]string, 0, len(metadata.Transactions)+1)
+	addrs = append(addrs, metadata.SenderAddr)
+
+	for _, receiver := range metadata.Transactions {
+		if receiver.Address != cardanoDestConfig.BridgingAddresses.FeeAddress {
+			addrs = append(addrs, receiver.Address)
+		}
+	}
+
+	blockedAddr := p.addressScreener.GetBlockedAddress(addrs...)
+	if blockedAddr == "" {
+		return nil
+	}
+
+	p.logger.Warn("Bridging request with a blocked address", "txHash", tx.Hash, "addr", blockedAddr)
+
+	return &oCore.AddressScreeningDecision{
+		SourceChainID: tx.OriginChainID,
+		TxHash:        common.Hash(tx.Hash),
+		BlockedAddr:   blockedAddr,
+		Policy:        appConfig.BridgingSettings.AddressScreening.Policy,
+	}
+}
+
 func (p *BridgingRequestedProcessorImpl) addBridgingRequestClaim(
 	claims *oCore.BridgeClaims, tx *core.EthTx,
 	metadata *core.BridgingRequestEthMetadata, appConfig *oCore.AppConfig,
@@ -86,8 +159,15 @@ func (p *BridgingRequestedProcessorImpl) addBridgingRequestClaim(
 		receivers = append(receivers, oCore.BridgingRequestReceiver{
 			DestinationAddress: receiver.Address,
 			Amount:             receiverAmountDfm,
+			TokenAmount:        big.NewInt(0),
 		})
 
+		if receiver.TokenAmount != nil && receiver.TokenAmount.Sign() > 0 {
+			receivers[len(receivers)-1].TokenAmount = receiver.TokenAmount
+			receivers[len(receivers)-1].Token, _ = appConfig.BridgingSettings.NativeTokens.GetDestinationToken(
+				tx.OriginChainID, receiver.Token, metadata.DestinationChainID)
+		}
+
 		totalAmount.Add(totalAmount, receiverAmountDfm)
 	}
 
@@ -98,6 +178,7 @@ func (p *BridgingRequestedProcessorImpl) addBridgingRequestClaim(
 	receivers = append(receivers, oCore.BridgingRequestReceiver{
 		DestinationAddress: cardanoDestConfig.BridgingAddresses.FeeAddress,
 		Amount:             feeCurrencyDfmDst,
+		TokenAmount:        big.NewInt(0),
 	})
 
 	claim := oCore.BridgingRequestClaim{
@@ -168,6 +249,10 @@ func (p *BridgingRequestedProcessorImpl) validate(
 		}
 	}
 
+	if err := p.validateTokens(tx, metadata, cardanoDestConfig, appConfig); err != nil {
+		return err
+	}
+
 	if foundAUtxoValueBelowMinimumValue {
 		return fmt.Errorf("found a utxo value below minimum value in metadata receivers: %v", metadata)
 	}
@@ -199,3 +284,31 @@ func (p *BridgingRequestedProcessorImpl) validate(
 
 	return nil
 }
+
+// validateTokens checks that every erc20 token sent to the receivers is mapped to a native token of
+// the destination chain. The gateway emits the withdraw only if the tokens are transferred to it
+func (p *BridgingRequestedProcessorImpl) validateTokens(
+	tx *core.EthTx, metadata *core.BridgingRequestEthMetadata,
+	cardanoDestConfig *oCore.CardanoChainConfig, appConfig *oCore.AppConfig,
+) error {
+	for _, receiver := range metadata.Transactions {
+		if receiver.TokenAmount == nil || receiver.TokenAmount.Sign() == 0 {
+			continue
+		}
+
+		if receiver.TokenAmount.Sign() < 0 {
+			return fmt.Errorf("negative amount of token %s in metadata: %v", receiver.Token, metadata)
+		}
+
+		if receiver.Address == cardanoDestConfig.BridgingAddresses.FeeAddress {
+			return fmt.Errorf("token %s can not be sent to the fee address", receiver.Token)
+		}
+
+		if _, exists := appConfig.BridgingSettings.NativeTokens.GetDestinationToken(
+			tx.OriginChainID, receiver.Token, metadata.DestinationChainID); !exists {
+			return fmt.Errorf("token %s can not be bridged to %s", receiver.Token, metadata.DestinationChainID)
+		}
+	}
+
+	return nil
+}
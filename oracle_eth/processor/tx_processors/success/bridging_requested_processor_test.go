@@ -77,7 +77,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, &core.EthTx{}, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err := proc.ValidateAndAddClaim(claims, &core.EthTx{}, appConfig)
 		require.Error(t, err)
@@ -92,7 +92,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, &core.EthTx{}, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err := proc.ValidateAndAddClaim(claims, &core.EthTx{}, appConfig)
 		require.NoError(t, err)
@@ -106,7 +106,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, &core.EthTx{}, appConfig).Return(fmt.Errorf("test err"))
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err := proc.ValidateAndAddClaim(claims, &core.EthTx{}, appConfig)
 		require.Error(t, err)
@@ -127,7 +127,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, &core.EthTx{}, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, &core.EthTx{
 			Metadata: irrelevantMetadata,
@@ -153,7 +153,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorError", claims, ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.NoError(t, err)
@@ -179,7 +179,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -205,7 +205,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.NoError(t, err)
@@ -235,7 +235,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -267,7 +267,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -299,7 +299,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -331,7 +331,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "transaction direction not allowed")
@@ -361,7 +361,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -392,7 +392,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -428,7 +428,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -461,7 +461,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -496,7 +496,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.NoError(t, err)
@@ -529,7 +529,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -563,7 +563,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -597,7 +597,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -639,7 +639,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 
@@ -682,7 +682,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 
@@ -716,7 +716,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -757,7 +757,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
 		require.Error(t, err)
@@ -796,7 +796,7 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 		refundRequestProcessorMock.On(
 			"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
 
-		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, hclog.NewNullLogger())
+		proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
 
 		claims := &oCore.BridgeClaims{}
 		err = proc.ValidateAndAddClaim(claims, ethTx, appConfig)
@@ -813,4 +813,146 @@ func TestBridgingRequestedProcessor(t *testing.T) {
 			claims.BridgingRequestClaims[0].Receivers[1].DestinationAddress)
 		require.Equal(t, feeAddrBridgingAmount, claims.BridgingRequestClaims[0].Receivers[1].Amount.Uint64())
 	})
+
+	t.Run("ValidateAndAddClaim blocked address", func(t *testing.T) {
+		receivers := []core.BridgingRequestEthMetadataTransaction{
+			{Address: primeBridgingFeeAddr, Amount: common.DfmToWei(new(big.Int).SetUint64(minFeeForBridging))},
+			{Address: validTestAddress, Amount: common.DfmToWei(new(big.Int).SetUint64(utxoMinValue))},
+		}
+
+		validMetadata, err := core.MarshalEthMetadata(core.BridgingRequestEthMetadata{
+			BridgingTxType:     common.BridgingTxTypeBridgingRequest,
+			DestinationChainID: common.ChainIDStrPrime,
+			SenderAddr:         "0xSender",
+			Transactions:       receivers,
+			FeeAmount:          big.NewInt(0),
+		})
+		require.NoError(t, err)
+
+		ethTx := &core.EthTx{
+			Hash:          [32]byte(common.NewHashFromHexString("0x2244FF")),
+			Metadata:      validMetadata,
+			OriginChainID: common.ChainIDStrNexus,
+			Value:         common.DfmToWei(new(big.Int).SetUint64(utxoMinValue + minFeeForBridging)),
+		}
+
+		addressScreenerMock := &oCore.AddressScreenerMock{}
+		addressScreenerMock.On("GetBlockedAddress", []string{"0xSender", validTestAddress}).Return(validTestAddress)
+
+		t.Run("hold", func(t *testing.T) {
+			appConfig := getAppConfig(true)
+			appConfig.BridgingSettings.AddressScreening.Policy = oCore.AddressScreeningPolicyHold
+
+			refundRequestProcessorMock := &core.EthTxSuccessRefundProcessorMock{}
+			refundRequestProcessorMock.On("HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
+
+			proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, addressScreenerMock, hclog.NewNullLogger())
+
+			claims := &oCore.BridgeClaims{}
+			err := proc.ValidateAndAddClaim(claims, ethTx, appConfig)
+			require.ErrorIs(t, err, oCore.ErrBridgingRequestHeld)
+			require.Equal(t, 0, claims.Count())
+			require.Len(t, claims.ScreeningDecisions, 1)
+			require.Equal(t, validTestAddress, claims.ScreeningDecisions[0].BlockedAddr)
+			require.Equal(t, common.Hash(ethTx.Hash), claims.ScreeningDecisions[0].TxHash)
+
+			require.ErrorContains(t, proc.Validate(ethTx, appConfig), "is blocked")
+		})
+
+		t.Run("refund", func(t *testing.T) {
+			appConfig := getAppConfig(true)
+			appConfig.BridgingSettings.AddressScreening.Policy = oCore.AddressScreeningPolicyRefund
+
+			claims := &oCore.BridgeClaims{}
+
+			refundRequestProcessorMock := &core.EthTxSuccessRefundProcessorMock{}
+			refundRequestProcessorMock.On("HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
+			refundRequestProcessorMock.On("HandleBridgingProcessorError", claims, ethTx, appConfig).Return(nil).Once()
+
+			proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, addressScreenerMock, hclog.NewNullLogger())
+
+			require.NoError(t, proc.ValidateAndAddClaim(claims, ethTx, appConfig))
+			require.Empty(t, claims.BridgingRequestClaims)
+			require.Len(t, claims.ScreeningDecisions, 1)
+			refundRequestProcessorMock.AssertExpectations(t)
+		})
+	})
+
+	t.Run("ValidateAndAddClaim native tokens", func(t *testing.T) {
+		const (
+			erc20Address = "0x1111111111111111111111111111111111111111"
+			tokenName    = "29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8.526f75746533"
+		)
+
+		appConfig := getAppConfig(false)
+		appConfig.BridgingSettings.NativeTokens = oCore.NativeTokens{
+			{
+				CardanoChainID: common.ChainIDStrPrime,
+				TokenName:      tokenName,
+				EthChainID:     common.ChainIDStrNexus,
+				ERC20Address:   erc20Address,
+			},
+		}
+
+		validateAndAddClaim := func(t *testing.T, receiverAddr, token string) (*oCore.BridgeClaims, error) {
+			t.Helper()
+
+			metadata, err := core.MarshalEthMetadata(core.BridgingRequestEthMetadata{
+				BridgingTxType:     common.BridgingTxTypeBridgingRequest,
+				DestinationChainID: common.ChainIDStrPrime,
+				SenderAddr:         "addr1",
+				Transactions: []core.BridgingRequestEthMetadataTransaction{
+					{Address: primeBridgingFeeAddr, Amount: common.DfmToWei(new(big.Int).SetUint64(minFeeForBridging))},
+					{
+						Address:     receiverAddr,
+						Amount:      common.DfmToWei(new(big.Int).SetUint64(utxoMinValue)),
+						Token:       token,
+						TokenAmount: big.NewInt(10),
+					},
+				},
+				FeeAmount: big.NewInt(0),
+			})
+			require.NoError(t, err)
+
+			ethTx := &core.EthTx{
+				Hash:          [32]byte(common.NewHashFromHexString("0x2244FF")),
+				Metadata:      metadata,
+				OriginChainID: common.ChainIDStrNexus,
+				Value:         common.DfmToWei(new(big.Int).SetUint64(utxoMinValue + minFeeForBridging)),
+			}
+
+			refundRequestProcessorMock := &core.EthTxSuccessRefundProcessorMock{}
+			refundRequestProcessorMock.On(
+				"HandleBridgingProcessorPreValidate", ethTx, appConfig).Return(nil)
+
+			claims := &oCore.BridgeClaims{}
+			proc := NewEthBridgingRequestedProcessor(refundRequestProcessorMock, nil, hclog.NewNullLogger())
+
+			return claims, proc.ValidateAndAddClaim(claims, ethTx, appConfig)
+		}
+
+		t.Run("token not mapped", func(t *testing.T) {
+			_, err := validateAndAddClaim(t, validTestAddress, "0x2222222222222222222222222222222222222222")
+			require.ErrorContains(t, err, "can not be bridged")
+		})
+
+		t.Run("token sent to fee address", func(t *testing.T) {
+			_, err := validateAndAddClaim(t, primeBridgingFeeAddr, erc20Address)
+			require.ErrorContains(t, err, "can not be sent to the fee address")
+		})
+
+		t.Run("valid", func(t *testing.T) {
+			claims, err := validateAndAddClaim(t, validTestAddress, erc20Address)
+			require.NoError(t, err)
+			require.Len(t, claims.BridgingRequestClaims, 1)
+
+			receivers := claims.BridgingRequestClaims[0].Receivers
+			require.Len(t, receivers, 2)
+			require.Equal(t, validTestAddress, receivers[0].DestinationAddress)
+			require.Equal(t, uint64(10), receivers[0].TokenAmount.Uint64())
+			require.Equal(t, tokenName, receivers[0].Token)
+			require.Equal(t, primeBridgingFeeAddr, receivers[1].DestinationAddress)
+			require.Zero(t, receivers[1].TokenAmount.Sign())
+		})
+	})
 }
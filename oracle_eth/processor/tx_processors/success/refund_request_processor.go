@@ -1,6 +1,7 @@
 package successtxprocessors
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -33,11 +34,17 @@ func (*RefundRequestProcessorImpl) PreValidate(tx *core.EthTx, appConfig *cCore.
 
 func (*RefundRequestProcessorImpl) HandleBridgingProcessorPreValidate(
 	tx *core.EthTx, appConfig *cCore.AppConfig) error {
-	if tx.BatchTryCount > appConfig.TryCountLimits.MaxBatchTryCount ||
+	if tx.ForceRefund {
+		return errors.New("refund forced by operator")
+	}
+
+	batchTryCount := tx.BatchTryCount - tx.BatchTryCountOffset
+
+	if batchTryCount > appConfig.TryCountLimits.MaxBatchTryCount ||
 		tx.SubmitTryCount > appConfig.TryCountLimits.MaxSubmitTryCount {
 		return fmt.Errorf(
-			"try count exceeded. BatchTryCount: (current, max)=(%d, %d), SubmitTryCount: (current, max)=(%d, %d)",
-			tx.BatchTryCount, appConfig.TryCountLimits.MaxBatchTryCount,
+			"%w. BatchTryCount: (current, max)=(%d, %d), SubmitTryCount: (current, max)=(%d, %d)",
+			cCore.ErrTryCountExceeded, batchTryCount, appConfig.TryCountLimits.MaxBatchTryCount,
 			tx.SubmitTryCount, appConfig.TryCountLimits.MaxSubmitTryCount)
 	}
 
@@ -95,9 +102,11 @@ func (p *RefundRequestProcessorImpl) addRefundRequestClaim(
 func (p *RefundRequestProcessorImpl) validate(
 	tx *core.EthTx, metadata *core.RefundBridgingRequestEthMetadata, appConfig *cCore.AppConfig,
 ) error {
-	if tx.RefundTryCount > appConfig.TryCountLimits.MaxRefundTryCount {
-		return fmt.Errorf("try count exceeded. RefundTryCount: (current, max)=(%d, %d)",
-			tx.RefundTryCount, appConfig.TryCountLimits.MaxRefundTryCount)
+	refundTryCount := tx.RefundTryCount - tx.RefundTryCountOffset
+
+	if refundTryCount > appConfig.TryCountLimits.MaxRefundTryCount {
+		return fmt.Errorf("%w. RefundTryCount: (current, max)=(%d, %d)",
+			cCore.ErrTryCountExceeded, refundTryCount, appConfig.TryCountLimits.MaxRefundTryCount)
 	}
 
 	chainConfig := appConfig.EthChains[tx.OriginChainID]
@@ -101,6 +101,7 @@ func TestRefundRequestedProcessor(t *testing.T) {
 		err := proc.HandleBridgingProcessorPreValidate(&core.EthTx{BatchTryCount: 1}, appConfig)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "try count exceeded")
+		require.ErrorIs(t, err, oCore.ErrTryCountExceeded)
 	})
 
 	t.Run("HandleBridgingProcessorPreValidate - submitTryCount over", func(t *testing.T) {
@@ -111,6 +112,22 @@ func TestRefundRequestedProcessor(t *testing.T) {
 		require.ErrorContains(t, err, "try count exceeded")
 	})
 
+	t.Run("HandleBridgingProcessorPreValidate - batchTryCount requeued", func(t *testing.T) {
+		appConfig := getAppConfig(false)
+
+		err := proc.HandleBridgingProcessorPreValidate(
+			&core.EthTx{BatchTryCount: 3, BatchTryCountOffset: 3}, appConfig)
+		require.NoError(t, err)
+	})
+
+	t.Run("HandleBridgingProcessorPreValidate - force refund", func(t *testing.T) {
+		appConfig := getAppConfig(false)
+
+		err := proc.HandleBridgingProcessorPreValidate(&core.EthTx{ForceRefund: true}, appConfig)
+		require.Error(t, err)
+		require.ErrorContains(t, err, "refund forced by operator")
+	})
+
 	t.Run("HandleBridgingProcessorError - empty ty", func(t *testing.T) {
 		appConfig := getAppConfig(false)
 
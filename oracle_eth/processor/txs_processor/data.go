@@ -14,12 +14,25 @@ type perTickState struct {
 	// duplicated data, used for easier marking of invalid state for bridging request history
 	allProcessedInvalid           []*core.EthTx
 	innerActionHashToActualTxHash map[string]common.Hash
+	// validation errors of invalid txs, used as failure reason in bridging request history
+	invalidReasons map[string]string
 
 	expectedTxsMap map[string]*core.BridgeExpectedEthTx
 	unprocessedTxs []*core.EthTx
 	blockInfo      *core.BridgeClaimsBlockInfo
 
 	lastObservedPerChain map[string]uint64
+	// bridging requests held until the confirmation depth of their amount tier is reached
+	awaitingConfirmationsTxs []*awaitingConfirmationsTx
+	// loaded on the first bridging request of the tick which is checked against the outflow limits
+	outflowUsage *cCore.OutflowUsage
+	// bridging requests held until enough operators approve them
+	awaitingApprovalTxs []*core.EthTx
+}
+
+type awaitingConfirmationsTx struct {
+	tx                    *core.EthTx
+	requiredConfirmations uint64
 }
 
 type txProcessorsCollection struct {
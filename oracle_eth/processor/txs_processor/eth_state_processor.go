@@ -2,6 +2,7 @@ package processor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sort"
@@ -38,6 +39,8 @@ type EthStateProcessor struct {
 	oracleBridgeSC eth.IOracleBridgeSmartContract
 
 	state *perTickState
+	// dead-letter txs count is refreshed on the first persist and whenever new dead-letter txs are added
+	isDeadLetterTelemetrySet bool
 }
 
 func NewEthStateProcessor(
@@ -68,6 +71,7 @@ func (sp *EthStateProcessor) Reset() {
 	sp.state = &perTickState{
 		updateData:                    &core.EthUpdateTxsData{},
 		innerActionHashToActualTxHash: make(map[string]common.Hash),
+		invalidReasons:                make(map[string]string),
 	}
 
 	sp.state.lastObservedPerChain = make(map[string]uint64)
@@ -120,6 +124,15 @@ func (sp *EthStateProcessor) RunChecks(
 		return
 	}
 
+	// held txs are left out, so they do not block the txs from the later blocks.
+	// No tx is processed if the confirmations can not be checked, so a tx is never dropped for a tick
+	sp.state.unprocessedTxs, err = sp.holdUnconfirmedTxs(sp.state.unprocessedTxs)
+	if err != nil {
+		sp.logger.Error("Failed to hold unconfirmed txs", "err", err)
+
+		return
+	}
+
 	// needed for the guarantee that both unprocessedTxs and expectedTxs are processed in order of slot
 	// and prevent the situation when there are always enough unprocessedTxs to fill out claims,
 	// that all claims are filled only from unprocessedTxs and never from expectedTxs
@@ -194,6 +207,9 @@ func (sp *EthStateProcessor) processBatchExecutionInfoEvents(
 				tx.IncrementBatchTryCount()
 				tx.ResetSubmitTryCount()
 				tx.SetLastTimeTried(time.Time{})
+				tx.AddTryTime(time.Now().UTC())
+				tx.SetLastError(fmt.Sprintf("batch %d failed on chain %s",
+					event.BatchID, common.ToStrChainID(event.DstChainID)))
 
 				for _, batchTx := range event.TxHashes {
 					if common.ToStrChainID(batchTx.SourceChainID) == tx.GetChainID() &&
@@ -307,6 +323,9 @@ func (sp *EthStateProcessor) processNotEnoughFundsEvents(
 
 		txToUpdate.SubmitTryCount++
 		txToUpdate.LastTimeTried = now
+		txToUpdate.AddTryTime(now)
+		txToUpdate.LastError = fmt.Sprintf("bridge rejected %s claim, not enough funds. available amount: %s",
+			event.ClaimeType, event.AvailableAmount)
 		unprocessedToUpdate = append(unprocessedToUpdate, txToUpdate)
 
 		sp.logger.Debug("updated unprocessedTx SubmitTryCount and LastTimeTried", "tx", txToUpdate)
@@ -372,8 +391,29 @@ func (sp *EthStateProcessor) PersistNew() {
 
 		if err := sp.db.UpdateTxs(sp.state.updateData); err != nil {
 			sp.logger.Error("Failed to update txs", "err", err)
+
+			return
+		}
+	}
+
+	if !sp.isDeadLetterTelemetrySet || len(sp.state.updateData.AddDeadLetterTxs) > 0 {
+		sp.updateDeadLetterTelemetry()
+	}
+}
+
+func (sp *EthStateProcessor) updateDeadLetterTelemetry() {
+	for _, chain := range sp.appConfig.EthChains {
+		cnt, err := sp.db.GetDeadLetterTxsCount(chain.ChainID)
+		if err != nil {
+			sp.logger.Error("Failed to get dead-letter txs count", "chainID", chain.ChainID, "err", err)
+
+			return
 		}
+
+		telemetry.UpdateOracleDeadLetterTxsCount(chain.ChainID, cnt)
 	}
+
+	sp.isDeadLetterTelemetrySet = true
 }
 
 func (sp *EthStateProcessor) constructBridgeClaimsBlockInfo(
@@ -458,9 +498,23 @@ func (sp *EthStateProcessor) checkUnprocessedTxs(
 		invalidTxsCounter    int
 	)
 
-	onInvalidTx := func(tx *core.EthTx) {
+	onInvalidTx := func(tx *core.EthTx, err error) {
 		processedInvalidTxs = append(processedInvalidTxs, tx)
 		invalidTxsCounter++
+
+		sp.state.invalidReasons[string(tx.ToEthTxKey())] = err.Error()
+
+		if errors.Is(err, oracleCore.ErrTryCountExceeded) {
+			// the try count error only tells which limit was exceeded, keep the error of the last try too
+			lastError := tx.LastError
+			if lastError == "" {
+				lastError = err.Error()
+			}
+
+			sp.state.updateData.AddDeadLetterTxs = append(sp.state.updateData.AddDeadLetterTxs, &core.EthDeadLetterTx{
+				Tx: tx, LastError: lastError, Reason: err.Error(), CreatedAt: time.Now().UTC(),
+			})
+		}
 	}
 
 	directlyProcessTx := func(tx *core.EthTx) {
@@ -485,7 +539,7 @@ func (sp *EthStateProcessor) checkUnprocessedTxs(
 		if err != nil {
 			sp.logger.Error("Failed to get tx processor for unprocessed tx", "tx", unprocessedTx, "err", err)
 
-			onInvalidTx(unprocessedTx)
+			onInvalidTx(unprocessedTx, err)
 
 			continue
 		}
@@ -510,14 +564,35 @@ func (sp *EthStateProcessor) checkUnprocessedTxs(
 		// and automatically added to processedValidTxs
 		if unprocessedTx.BlockNumber > lastObservedBlock {
 			err = txProcessor.ValidateAndAddClaim(bridgeClaims, unprocessedTx, sp.appConfig)
-			if err != nil {
+			if errors.Is(err, oracleCore.ErrBridgingRequestHeld) {
+				sp.logger.Info("Bridging request held", "tx", unprocessedTx.Hash, "reason", err)
+
+				continue
+			} else if err != nil {
 				sp.logger.Error("Failed to ValidateAndAddClaim", "tx", unprocessedTx, "err", err)
 
-				onInvalidTx(unprocessedTx)
+				onInvalidTx(unprocessedTx, err)
 
 				continue
 			}
 
+			if txProcessor.GetType() == common.BridgingTxTypeBridgingRequest {
+				isHeld, err := sp.checkManualApproval(bridgeClaims, txProcessor, unprocessedTx)
+				if err == nil && !isHeld {
+					isHeld, err = sp.checkOutflowLimits(bridgeClaims, txProcessor, unprocessedTx)
+				}
+
+				if err != nil {
+					sp.logger.Error("Failed to ValidateAndAddClaim", "tx", unprocessedTx, "err", err)
+
+					onInvalidTx(unprocessedTx, err)
+
+					continue
+				} else if isHeld {
+					continue
+				}
+			}
+
 			if txProcessor.GetType() == common.BridgingTxTypeBridgingRequest ||
 				txProcessor.GetType() == common.TxTypeRefundRequest {
 				pendingTxs = append(pendingTxs, unprocessedTx)
@@ -684,6 +759,17 @@ func (sp *EthStateProcessor) UpdateBridgingRequestStates(
 	bridgeClaims *oracleCore.BridgeClaims,
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater,
 ) {
+	for _, x := range bridgeClaims.ScreeningDecisions {
+		err := bridgingRequestStateUpdater.AddressScreened(
+			common.NewBridgingRequestStateKey(x.SourceChainID, x.TxHash, false), x.String())
+		if err != nil {
+			sp.logger.Error(
+				"error while updating a bridging request state with the screening decision",
+				"sourceChainId", x.SourceChainID,
+				"sourceTxHash", x.TxHash, "err", err)
+		}
+	}
+
 	if len(bridgeClaims.BridgingRequestClaims) > 0 || len(bridgeClaims.RefundRequestClaims) > 0 {
 		notRejectedMap := make(map[string]bool, len(sp.state.updateData.MoveUnprocessedToPending))
 		for _, tx := range sp.state.updateData.MoveUnprocessedToPending {
@@ -730,7 +816,7 @@ func (sp *EthStateProcessor) UpdateBridgingRequestStates(
 		} else if txProcessor.GetType() == common.BridgingTxTypeBridgingRequest ||
 			txProcessor.GetType() == common.TxTypeRefundRequest {
 			err := bridgingRequestStateUpdater.Invalid(common.NewBridgingRequestStateKey(
-				tx.OriginChainID, common.Hash(tx.Hash), false))
+				tx.OriginChainID, common.Hash(tx.Hash), false), sp.state.invalidReasons[string(tx.ToEthTxKey())])
 			if err != nil {
 				sp.logger.Error(
 					"error while updating a bridging request state to Invalid",
@@ -739,6 +825,328 @@ func (sp *EthStateProcessor) UpdateBridgingRequestStates(
 			}
 		}
 	}
+
+	for _, tx := range sp.state.awaitingApprovalTxs {
+		err := bridgingRequestStateUpdater.AwaitingApproval(
+			common.NewBridgingRequestStateKey(tx.OriginChainID, common.Hash(tx.Hash), false))
+		if err != nil {
+			sp.logger.Error(
+				"error while updating a bridging request state to AwaitingApproval",
+				"sourceChainId", tx.OriginChainID,
+				"sourceTxHash", tx.Hash, "err", err)
+		}
+	}
+
+	for _, x := range sp.state.awaitingConfirmationsTxs {
+		err := bridgingRequestStateUpdater.AwaitingConfirmations(common.NewBridgingRequestStateKey(
+			x.tx.OriginChainID, common.Hash(x.tx.Hash), false), x.requiredConfirmations)
+		if err != nil {
+			sp.logger.Error(
+				"error while updating a bridging request state with the required confirmations",
+				"sourceChainId", x.tx.OriginChainID,
+				"sourceTxHash", x.tx.Hash, "err", err)
+		}
+	}
+}
+
+// holdUnconfirmedTxs returns the txs without the bridging requests
+// which have not reached the confirmation depth of their amount tier yet.
+// It fails if the confirmations of any tx can not be checked, so no tx is skipped
+func (sp *EthStateProcessor) holdUnconfirmedTxs(txs []*core.EthTx) ([]*core.EthTx, error) {
+	result := make([]*core.EthTx, 0, len(txs))
+
+	awaitingCnt := len(sp.state.awaitingConfirmationsTxs)
+
+	for _, tx := range txs {
+		requiredConfirmations, pendingConfirmations, err := sp.getPendingConfirmations(tx)
+		if err != nil {
+			// the txs are checked again on the next tick
+			sp.state.awaitingConfirmationsTxs = sp.state.awaitingConfirmationsTxs[:awaitingCnt]
+
+			return nil, fmt.Errorf("failed to get pending confirmations for tx %s: %w", tx.Hash, err)
+		}
+
+		if pendingConfirmations > 0 {
+			sp.logger.Debug("Holding tx until its confirmation tier depth is reached",
+				"tx", tx.Hash, "required", requiredConfirmations, "pending", pendingConfirmations)
+
+			sp.state.awaitingConfirmationsTxs = append(sp.state.awaitingConfirmationsTxs, &awaitingConfirmationsTx{
+				tx:                    tx,
+				requiredConfirmations: requiredConfirmations,
+			})
+
+			continue
+		}
+
+		result = append(result, tx)
+	}
+
+	return result, nil
+}
+
+// getPendingConfirmations returns the confirmation depth required by the amount tier of the bridging request
+// and the number of blocks still missing to reach it
+func (sp *EthStateProcessor) getPendingConfirmations(tx *core.EthTx) (uint64, uint64, error) {
+	chainConfig := sp.appConfig.EthChains[tx.OriginChainID]
+	if chainConfig == nil || len(chainConfig.ConfirmationTiers) == 0 {
+		return 0, 0, nil
+	}
+
+	// txs which can not be processed are left to the checks to be marked as invalid
+	txProcessor, err := sp.txProcessors.getSuccess(tx, sp.appConfig)
+	if err != nil || txProcessor.GetType() != common.BridgingTxTypeBridgingRequest {
+		return 0, 0, nil
+	}
+
+	defaultConfirmations := chainConfig.NumBlockConfirmations
+	if chainConfig.ConfirmationMode.IsBlockTag() {
+		defaultConfirmations = 0
+	}
+
+	requiredConfirmations := chainConfig.ConfirmationTiers.GetConfirmationBlocks(tx.Value)
+	if requiredConfirmations <= defaultConfirmations {
+		return 0, 0, nil
+	}
+
+	indexerDB := sp.indexerDbs[tx.OriginChainID]
+	if indexerDB == nil {
+		return 0, 0, fmt.Errorf("failed to get eth chain observer db for chain: %s", tx.OriginChainID)
+	}
+
+	lastProcessedBlock, err := indexerDB.GetLastProcessedBlock()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// the last processed block is already confirmed by the default depth
+	confirmations := defaultConfirmations
+	if lastProcessedBlock > tx.BlockNumber {
+		confirmations += lastProcessedBlock - tx.BlockNumber
+	}
+
+	if confirmations >= requiredConfirmations {
+		return requiredConfirmations, 0, nil
+	}
+
+	return requiredConfirmations, requiredConfirmations - confirmations, nil
+}
+
+// checkManualApproval holds the bridging request claim of the tx until enough operators approve it,
+// if its amount requires a manual approval. A request which is not approved before the source chain reaches
+// the review deadline is refunded, never bridged
+func (sp *EthStateProcessor) checkManualApproval(
+	bridgeClaims *oracleCore.BridgeClaims, txProcessor core.EthTxSuccessProcessor, tx *core.EthTx,
+) (bool, error) {
+	settings := sp.appConfig.BridgingSettings.ManualApproval
+	claimsCnt := len(bridgeClaims.BridgingRequestClaims)
+
+	// the request might have been already handed over to the refund processor
+	if claimsCnt == 0 || bridgeClaims.BridgingRequestClaims[claimsCnt-1].ObservedTransactionHash != tx.Hash ||
+		!settings.IsRequired(bridgeClaims.BridgingRequestClaims[claimsCnt-1].TotalAmountDst) {
+		return false, nil
+	}
+
+	claim := bridgeClaims.BridgingRequestClaims[claimsCnt-1]
+
+	approval, err := sp.db.GetManualApproval(tx.OriginChainID, common.Hash(tx.Hash))
+	if err != nil {
+		sp.logger.Error("Failed to get manual approval, holding the tx", "tx", tx.Hash, "err", err)
+
+		bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+		return true, nil
+	} else if approval == nil {
+		approval = &oracleCore.ManualApproval{
+			SourceChainID:      tx.OriginChainID,
+			TxHash:             common.Hash(tx.Hash),
+			DestinationChainID: common.ToStrChainID(claim.DestinationChainId),
+			Amount:             claim.TotalAmountDst,
+			HeldAtBlock:        tx.BlockNumber,
+			DeadlineBlock:      tx.BlockNumber + settings.ReviewTimeoutBlocks[tx.OriginChainID],
+		}
+
+		sp.state.updateData.AddManualApprovals = append(sp.state.updateData.AddManualApprovals, approval)
+	}
+
+	if approval.IsApproved(settings.RequiredApprovals) {
+		return false, nil
+	}
+
+	bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+	latestBlock, err := sp.getLatestBlock(tx.OriginChainID)
+	if err != nil {
+		sp.logger.Error("Failed to get the latest block of the source chain, holding the tx", "tx", tx.Hash, "err", err)
+
+		sp.state.awaitingApprovalTxs = append(sp.state.awaitingApprovalTxs, tx)
+
+		return true, nil
+	}
+
+	if !approval.IsDeadlineReached(latestBlock) {
+		sp.state.awaitingApprovalTxs = append(sp.state.awaitingApprovalTxs, tx)
+
+		return true, nil
+	}
+
+	sp.logger.Info("Review deadline of the bridging request has passed, refunding it",
+		"tx", tx.Hash, "approvals", len(approval.Approvals), "deadline", approval.DeadlineBlock, "block", latestBlock)
+
+	if err := sp.refundTx(bridgeClaims, txProcessor, tx, "the review deadline has passed"); err != nil {
+		return false, err
+	}
+
+	sp.state.updateData.ExpireManualApprovals = append(sp.state.updateData.ExpireManualApprovals, approval)
+
+	return false, nil
+}
+
+// getLatestBlock returns the latest confirmed block of the chain
+func (sp *EthStateProcessor) getLatestBlock(chainID string) (uint64, error) {
+	indexerDB := sp.indexerDbs[chainID]
+	if indexerDB == nil {
+		return 0, fmt.Errorf("failed to get eth chain observer db for chain: %s", chainID)
+	}
+
+	return indexerDB.GetLastProcessedBlock()
+}
+
+// checkOutflowLimits counts the bridging request claim of the tx in the outflow limits.
+// A request which would exceed a limit is either deferred or handed over to the refund processor
+func (sp *EthStateProcessor) checkOutflowLimits(
+	bridgeClaims *oracleCore.BridgeClaims, txProcessor core.EthTxSuccessProcessor, tx *core.EthTx,
+) (bool, error) {
+	limits := sp.appConfig.BridgingSettings.OutflowLimits
+	claimsCnt := len(bridgeClaims.BridgingRequestClaims)
+
+	// the request might have been already handed over to the refund processor
+	if !limits.IsEnabled() || claimsCnt == 0 ||
+		bridgeClaims.BridgingRequestClaims[claimsCnt-1].ObservedTransactionHash != tx.Hash {
+		return false, nil
+	}
+
+	claim := bridgeClaims.BridgingRequestClaims[claimsCnt-1]
+
+	usage, err := sp.getOutflowUsage()
+	if err != nil {
+		sp.logger.Error("Failed to get outflow usage, deferring the tx", "tx", tx.Hash, "err", err)
+
+		bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+		return true, nil
+	}
+
+	record := &oracleCore.OutflowRecord{
+		SourceChainID:      tx.OriginChainID,
+		DestinationChainID: common.ToStrChainID(claim.DestinationChainId),
+		SenderAddr:         getOutflowSenderAddr(tx),
+		TxHash:             common.Hash(tx.Hash),
+		Amount:             claim.TotalAmountDst,
+		BlockSlot:          tx.BlockNumber,
+	}
+
+	if err := limits.Check(usage, record); err == nil {
+		usage.Add(record)
+
+		sp.state.updateData.AddOutflowRecords = append(sp.state.updateData.AddOutflowRecords, record)
+
+		return false, nil
+	} else {
+		sp.logger.Info("Bridging request exceeds the outflow limits",
+			"tx", tx.Hash, "policy", limits.Policy, "reason", err)
+	}
+
+	telemetry.UpdateOracleOutflowLimitExceededCounter(tx.OriginChainID, string(limits.Policy), 1)
+
+	bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt-1]
+
+	if limits.Policy == oracleCore.OutflowLimitPolicyDefer {
+		return true, nil
+	}
+
+	return false, sp.refundTx(bridgeClaims, txProcessor, tx, "the outflow limits are exceeded")
+}
+
+// refundTx hands the bridging request over to the refund processor.
+// The tx is rejected if it can not be refunded, so the request is never bridged instead
+func (sp *EthStateProcessor) refundTx(
+	bridgeClaims *oracleCore.BridgeClaims, txProcessor core.EthTxSuccessProcessor, tx *core.EthTx, reason string,
+) error {
+	if !sp.appConfig.RefundEnabled {
+		return fmt.Errorf("%s and refunds are disabled", reason)
+	}
+
+	claimsCnt := len(bridgeClaims.BridgingRequestClaims)
+	tx.ForceRefund = true
+
+	if err := txProcessor.ValidateAndAddClaim(bridgeClaims, tx, sp.appConfig); err != nil {
+		return err
+	}
+
+	if len(bridgeClaims.BridgingRequestClaims) != claimsCnt {
+		bridgeClaims.BridgingRequestClaims = bridgeClaims.BridgingRequestClaims[:claimsCnt]
+
+		return fmt.Errorf("%s and the tx was not handed over to the refund processor", reason)
+	}
+
+	return nil
+}
+
+// getOutflowUsage returns the usage of the current outflow limits window. Records out of the window are removed
+func (sp *EthStateProcessor) getOutflowUsage() (*oracleCore.OutflowUsage, error) {
+	if sp.state.outflowUsage != nil {
+		return sp.state.outflowUsage, nil
+	}
+
+	limits := sp.appConfig.BridgingSettings.OutflowLimits
+
+	records, err := sp.db.GetOutflowRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	var inWindowRecords, expiredRecords []*oracleCore.OutflowRecord
+
+	for _, record := range records {
+		// the window ends at the last block observed by the bridge, so it is the same for all the validators
+		lastObservedBlock, err := sp.getLastObservedBlock(record.SourceChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		if limits.IsInWindow(record, lastObservedBlock) {
+			inWindowRecords = append(inWindowRecords, record)
+		} else {
+			expiredRecords = append(expiredRecords, record)
+		}
+	}
+
+	if len(expiredRecords) > 0 {
+		if err := sp.db.RemoveOutflowRecords(expiredRecords); err != nil {
+			return nil, err
+		}
+	}
+
+	sp.state.outflowUsage = oracleCore.NewOutflowUsage(inWindowRecords)
+
+	for chainID, amount := range sp.state.outflowUsage.PerSourceChain {
+		telemetry.UpdateOracleOutflowUsage("source", chainID, amount)
+	}
+
+	for chainID, amount := range sp.state.outflowUsage.PerDestinationChain {
+		telemetry.UpdateOracleOutflowUsage("destination", chainID, amount)
+	}
+
+	return sp.state.outflowUsage, nil
+}
+
+func getOutflowSenderAddr(tx *core.EthTx) string {
+	metadata, err := core.UnmarshalEthMetadata[core.BridgingRequestEthMetadata](tx.Metadata)
+	if err != nil {
+		return ""
+	}
+
+	return metadata.SenderAddr
 }
 
 func (sp *EthStateProcessor) getLastObservedBlock(chainID string) (uint64, error) {
@@ -2,6 +2,7 @@ package processor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -45,7 +46,8 @@ func newEthTxsProcessor(
 		successTxProcessors, failedTxProcessors,
 	)
 
-	ethTxsReceiver := NewEthTxsReceiverImpl(appConfig, db, txProcessors, bridgingRequestStateUpdater, hclog.NewNullLogger())
+	ethTxsReceiver := NewEthTxsReceiverImpl(
+		appConfig, db, txProcessors, bridgeDataFetcher, bridgingRequestStateUpdater, hclog.NewNullLogger())
 
 	bridgeSmartContractMock := &eth.OracleBridgeSmartContractMock{}
 
@@ -276,7 +278,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -337,7 +339,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -407,7 +409,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -477,7 +479,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -554,7 +556,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -635,7 +637,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -872,7 +874,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -977,7 +979,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -1066,7 +1068,7 @@ func TestEthTxsProcessor(t *testing.T) {
 		bridgeSubmitter.On("Dispose").Return(nil)
 		bridgeSubmitter.On("SubmitClaims", mock.Anything, mock.Anything).Return()
 
-		txHash := ethgo.HexToHash("0xf62590f36f8b18f71bb343ad6e861ad62ac23bece85414772c7f06f1b1910995")
+		txHash := ethgo.HexToHash("0xdb00e2b9a9743ea60cd5cecb080bdadeccd4d5f18a5df78317e2e2113f9a1cb3")
 		txHash2 := ethgo.HexToHash("0xf62590f36f8b18f71bb343ad6e861ad62ac23bece85414772c7f06f1b1910996")
 
 		ctx, cancelFunc := context.WithCancel(context.Background())
@@ -1082,7 +1084,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -1240,7 +1242,7 @@ func TestEthTxsProcessor(t *testing.T) {
 			oracleDB, err := createOracleDB(dbFilePath)
 			require.NoError(t, err)
 
-			events, err := eth.GetNexusEventSignatures()
+			events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 			require.NoError(t, err)
 
 			// withdraw (bridge)
@@ -1268,7 +1270,7 @@ func TestEthTxsProcessor(t *testing.T) {
 			oracleDB, err := createOracleDB(dbFilePath)
 			require.NoError(t, err)
 
-			events, err := eth.GetNexusEventSignatures()
+			events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 			require.NoError(t, err)
 
 			// deposit (batch)
@@ -1300,7 +1302,7 @@ func TestEthTxsProcessor(t *testing.T) {
 			oracleDB, err := createOracleDB(dbFilePath)
 			require.NoError(t, err)
 
-			events, err := eth.GetNexusEventSignatures()
+			events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 			require.NoError(t, err)
 
 			// deposit (batch)
@@ -1327,7 +1329,7 @@ func TestEthTxsProcessor(t *testing.T) {
 	})
 
 	t.Run("verify abi pack for withdraw", func(t *testing.T) {
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		withdrawEventSig := events[1]
@@ -1339,8 +1341,10 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		receiptData, err := eventAbi.Inputs.Pack(
 			common.ChainIDIntPrime, ethereum_common.Address{}, []ReceiverWithdraw{{
-				Receiver: "123",
-				Amount:   big.NewInt(1),
+				Receiver:    "123",
+				Amount:      big.NewInt(1),
+				Token:       ethereum_common.HexToAddress("0xAB"),
+				TokenAmount: big.NewInt(5),
 			}},
 			big.NewInt(1), big.NewInt(1),
 		)
@@ -1357,6 +1361,9 @@ func TestEthTxsProcessor(t *testing.T) {
 		event, err := contract.ParseWithdraw(gethLog)
 		require.NoError(t, err)
 		require.NotNil(t, event)
+		require.Len(t, event.Receivers, 1)
+		require.Equal(t, ethereum_common.HexToAddress("0xAB"), event.Receivers[0].Token)
+		require.Equal(t, big.NewInt(5), event.Receivers[0].TokenAmount)
 	})
 
 	t.Run("Start - unprocessedTxs - valid brc goes to pending", func(t *testing.T) {
@@ -1397,7 +1404,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		withdrawEventSig := events[1]
@@ -1409,8 +1416,9 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		receiptData, err := eventAbi.Inputs.Pack(
 			common.ChainIDIntPrime, ethereum_common.Address{}, []ReceiverWithdraw{{
-				Receiver: "123",
-				Amount:   big.NewInt(1),
+				Receiver:    "123",
+				Amount:      big.NewInt(1),
+				TokenAmount: big.NewInt(0),
 			}},
 			big.NewInt(1), big.NewInt(1),
 		)
@@ -1452,6 +1460,146 @@ func TestEthTxsProcessor(t *testing.T) {
 		require.Equal(t, tx.Hash[:], pendingTx.GetTxHash())
 	})
 
+	t.Run("NewUnprocessedLog - reorged txs", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		const (
+			originChainID = common.ChainIDStrNexus
+		)
+
+		oracleDB, err := createOracleDB(dbFilePath)
+		require.NoError(t, err)
+
+		validTxProc := &ethcore.EthTxSuccessProcessorMock{Type: common.BridgingTxTypeBridgingRequest}
+
+		txHash := ethgo.HexToHash("0xf62590f36f8b18f71bb343ad6e861ad62ac23bece85414772c7f06f1b1910995")
+		confirmedTxHash := ethgo.HexToHash("0xa62590f36f8b18f71bb343ad6e861ad62ac23bece85414772c7f06f1b1910995")
+		removedTxHash := ethgo.HexToHash("0xb62590f36f8b18f71bb343ad6e861ad62ac23bece85414772c7f06f1b1910995")
+
+		bridgingRequestStateUpdater := &common.BridgingRequestStateUpdaterMock{}
+		bridgingRequestStateUpdater.On("NewMultiple", originChainID, mock.Anything).Return(nil).Times(4)
+		bridgingRequestStateUpdater.On("Reorged", common.NewBridgingRequestStateKey(
+			originChainID, common.Hash(txHash), false), mock.Anything).Return(nil).Once()
+		bridgingRequestStateUpdater.On("Reorged", common.NewBridgingRequestStateKey(
+			originChainID, common.Hash(removedTxHash), false), mock.Anything).Return(nil).Once()
+
+		bridgeDataFetcher := &ethcore.EthBridgeDataFetcherMock{}
+		bridgeDataFetcher.On("IsBridgingRequestConfirmed", originChainID, common.ChainIDStrPrime, txHash).
+			Return(false, nil).Once()
+		bridgeDataFetcher.On("IsBridgingRequestConfirmed", originChainID, common.ChainIDStrPrime, confirmedTxHash).
+			Return(true, nil).Once()
+
+		_, rec := newValidProcessor(
+			context.Background(),
+			appConfig, oracleDB,
+			validTxProc, nil, bridgeDataFetcher, nil, nil,
+			bridgingRequestStateUpdater,
+			validatorSetObserver,
+		)
+
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
+		require.NoError(t, err)
+
+		withdrawEventSig := events[1]
+		abi, err := contractbinding.GatewayMetaData.GetAbi()
+
+		require.NoError(t, err)
+		eventAbi, err := abi.EventByID(ethereum_common.Hash(withdrawEventSig))
+		require.NoError(t, err)
+
+		receiptData, err := eventAbi.Inputs.Pack(
+			common.ChainIDIntPrime, ethereum_common.Address{}, []ReceiverWithdraw{{
+				Receiver:    "123",
+				Amount:      big.NewInt(1),
+				TokenAmount: big.NewInt(0),
+			}},
+			big.NewInt(1), big.NewInt(1),
+		)
+		require.NoError(t, err)
+
+		newLog := func(txHash ethgo.Hash, blockNumber uint64) *ethgo.Log {
+			return &ethgo.Log{
+				BlockHash:       ethgo.Hash{byte(blockNumber)},
+				TransactionHash: txHash,
+				Data:            receiptData,
+				Topics:          []ethgo.Hash{withdrawEventSig},
+				BlockNumber:     blockNumber,
+			}
+		}
+
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, newLog(txHash, 1)))
+
+		// same tx included in a different block before its claim is submitted
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, newLog(txHash, 2)))
+
+		unprocessedTxs, err := oracleDB.GetAllUnprocessedTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, unprocessedTxs, 1)
+		require.Equal(t, ethgo.Hash{2}, unprocessedTxs[0].BlockHash)
+
+		// the log is delivered again from the same block
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, newLog(txHash, 2)))
+
+		unprocessedTxs, err = oracleDB.GetAllUnprocessedTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, unprocessedTxs, 1)
+
+		// claim submitted
+		require.NoError(t, oracleDB.UpdateTxs(&ethcore.EthUpdateTxsData{
+			MoveUnprocessedToPending: unprocessedTxs,
+		}))
+
+		// reorged pending tx is not requeued so its claim is not submitted again
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, newLog(txHash, 3)))
+
+		removedLog := newLog(txHash, 3)
+		removedLog.Removed = true
+
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, removedLog))
+
+		unprocessedTxs, err = oracleDB.GetAllUnprocessedTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Empty(t, unprocessedTxs)
+
+		pendingTxs, err := oracleDB.GetAllPendingTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Len(t, pendingTxs, 1)
+		require.Equal(t, ethgo.Hash{2}, pendingTxs[0].BlockHash)
+
+		// reorged tx already confirmed by the bridge is not requeued
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, newLog(confirmedTxHash, 1)))
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, newLog(confirmedTxHash, 2)))
+
+		unprocessedTxs, err = oracleDB.GetAllUnprocessedTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Empty(t, unprocessedTxs)
+
+		processedTx, err := oracleDB.GetProcessedTx(oCore.DBTxID{ChainID: originChainID, DBKey: confirmedTxHash[:]})
+		require.NoError(t, err)
+		require.NotNil(t, processedTx)
+		require.False(t, processedTx.IsInvalid)
+		require.Equal(t, ethgo.Hash{2}, processedTx.BlockHash)
+
+		// removed log of an unprocessed tx
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, newLog(removedTxHash, 1)))
+
+		removedLog = newLog(removedTxHash, 1)
+		removedLog.Removed = true
+
+		require.NoError(t, rec.NewUnprocessedLog(originChainID, removedLog))
+
+		unprocessedTxs, err = oracleDB.GetAllUnprocessedTxs(originChainID, 0)
+		require.NoError(t, err)
+		require.Empty(t, unprocessedTxs)
+
+		processedTx, err = oracleDB.GetProcessedTx(oCore.DBTxID{ChainID: originChainID, DBKey: removedTxHash[:]})
+		require.NoError(t, err)
+		require.Nil(t, processedTx)
+
+		bridgingRequestStateUpdater.AssertExpectations(t)
+		bridgeDataFetcher.AssertExpectations(t)
+	})
+
 	t.Run("Start - unprocessedTxs - valid brc rejected and retry", func(t *testing.T) {
 		t.Cleanup(dbCleanup)
 
@@ -1507,7 +1655,7 @@ func TestEthTxsProcessor(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, event)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		withdrawEventSig := events[1]
@@ -1519,8 +1667,9 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		withdrawReceiptData, err := eventAbi.Inputs.Pack(
 			common.ChainIDIntPrime, ethereum_common.Address{}, []ReceiverWithdraw{{
-				Receiver: "123",
-				Amount:   big.NewInt(1),
+				Receiver:    "123",
+				Amount:      big.NewInt(1),
+				TokenAmount: big.NewInt(0),
 			}},
 			big.NewInt(1), big.NewInt(1),
 		)
@@ -1750,7 +1899,7 @@ func TestEthTxsProcessor(t *testing.T) {
 
 		require.NotNil(t, proc)
 
-		events, err := eth.GetNexusEventSignatures()
+		events, err := eth.GetNexusEventSignatures(contractbinding.NativeTokensContractsABI)
 		require.NoError(t, err)
 
 		depositEventSig := events[0]
@@ -1818,7 +1967,7 @@ func simulateRealData() []byte {
 		0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 1, 0,
+		0, 0, 0, 0, 0, 0, 1, 64,
 
 		0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0,
@@ -1859,12 +2008,24 @@ func simulateRealData() []byte {
 		0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0,
 		13, 224, 182, 179, 167, 100, 0, 0,
+
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
 	}
 }
 
 type ReceiverWithdraw struct {
-	Receiver string   `json:"receiver" abi:"receiver"`
-	Amount   *big.Int `json:"amount" abi:"amount"`
+	Receiver    string                  `json:"receiver" abi:"receiver"`
+	Amount      *big.Int                `json:"amount" abi:"amount"`
+	Token       ethereum_common.Address `json:"token" abi:"token"`
+	TokenAmount *big.Int                `json:"tokenAmount" abi:"tokenAmount"`
 }
 
 var (
@@ -1874,3 +2035,332 @@ var (
 		{Name: "availableAmount", Type: abi.Type{T: abi.UintTy, Size: 256}},
 	}
 )
+
+func TestEthStateProcessor_HoldUnconfirmedTxs(t *testing.T) {
+	appConfig := &oCore.AppConfig{
+		EthChains: map[string]*oCore.EthChainConfig{
+			common.ChainIDStrNexus: {
+				NumBlockConfirmations: 2,
+				ConfirmationTiers: oCore.ConfirmationTiers{
+					{MinAmount: big.NewInt(1_000), ConfirmationBlocks: 10},
+				},
+			},
+		},
+	}
+
+	metadata, err := ethcore.MarshalEthMetadata(
+		ethcore.BaseEthMetadata{BridgingTxType: common.BridgingTxTypeBridgingRequest})
+	require.NoError(t, err)
+
+	smallTx := &ethcore.EthTx{
+		OriginChainID: common.ChainIDStrNexus, Hash: ethgo.Hash{1}, BlockNumber: 100,
+		Value: big.NewInt(999), Metadata: metadata,
+	}
+	largeTx := &ethcore.EthTx{
+		OriginChainID: common.ChainIDStrNexus, Hash: ethgo.Hash{2}, BlockNumber: 100,
+		Value: big.NewInt(1_000), Metadata: metadata,
+	}
+
+	nexusDB := &ethcore.EventStoreMock{}
+
+	sp := NewEthStateProcessor(
+		context.Background(), appConfig, nil,
+		NewTxProcessorsCollection([]ethcore.EthTxSuccessProcessor{
+			&ethcore.EthTxSuccessProcessorMock{Type: common.BridgingTxTypeBridgingRequest},
+		}, nil),
+		map[string]eventTrackerStore.EventTrackerStore{common.ChainIDStrNexus: nexusDB}, hclog.NewNullLogger(), nil)
+
+	t.Run("depth not reached", func(t *testing.T) {
+		nexusDB.On("GetLastProcessedBlock").Return(uint64(107), nil).Once()
+
+		sp.Reset()
+
+		txs, err := sp.holdUnconfirmedTxs([]*ethcore.EthTx{smallTx, largeTx})
+		require.NoError(t, err)
+		require.Equal(t, []*ethcore.EthTx{smallTx}, txs)
+		require.Len(t, sp.state.awaitingConfirmationsTxs, 1)
+		require.Equal(t, largeTx, sp.state.awaitingConfirmationsTxs[0].tx)
+		require.Equal(t, uint64(10), sp.state.awaitingConfirmationsTxs[0].requiredConfirmations)
+
+		stateUpdater := &common.BridgingRequestStateUpdaterMock{}
+		stateUpdater.On("AwaitingConfirmations", common.NewBridgingRequestStateKey(
+			common.ChainIDStrNexus, common.Hash(largeTx.Hash), false), uint64(10)).Return(nil).Once()
+
+		sp.UpdateBridgingRequestStates(&oCore.BridgeClaims{}, stateUpdater)
+
+		stateUpdater.AssertExpectations(t)
+	})
+
+	t.Run("depth reached", func(t *testing.T) {
+		nexusDB.On("GetLastProcessedBlock").Return(uint64(108), nil).Once()
+
+		sp.Reset()
+
+		txs, err := sp.holdUnconfirmedTxs([]*ethcore.EthTx{smallTx, largeTx})
+		require.NoError(t, err)
+		require.Equal(t, []*ethcore.EthTx{smallTx, largeTx}, txs)
+		require.Empty(t, sp.state.awaitingConfirmationsTxs)
+	})
+
+	t.Run("confirmations can not be checked", func(t *testing.T) {
+		nexusDB.On("GetLastProcessedBlock").Return(uint64(0), errors.New("db failed")).Once()
+
+		sp.Reset()
+
+		_, err := sp.holdUnconfirmedTxs([]*ethcore.EthTx{smallTx, largeTx})
+		require.ErrorContains(t, err, "db failed")
+		require.Empty(t, sp.state.awaitingConfirmationsTxs)
+	})
+
+	nexusDB.AssertExpectations(t)
+}
+
+func TestEthStateProcessor_CheckOutflowLimits(t *testing.T) {
+	appConfig := &oCore.AppConfig{
+		BridgingSettings: oCore.BridgingSettings{
+			OutflowLimits: oCore.OutflowLimitsSettings{
+				WindowMilis:         3_600_000,
+				Policy:              oCore.OutflowLimitPolicyDefer,
+				PerDestinationChain: map[string]*big.Int{common.ChainIDStrPrime: big.NewInt(1_000)},
+			},
+		},
+	}
+
+	metadata, err := ethcore.MarshalEthMetadata(ethcore.BridgingRequestEthMetadata{
+		BridgingTxType: common.BridgingTxTypeBridgingRequest,
+		SenderAddr:     "0xsender",
+	})
+	require.NoError(t, err)
+
+	tx := &ethcore.EthTx{
+		OriginChainID: common.ChainIDStrNexus, Hash: ethgo.Hash{1}, Metadata: metadata,
+	}
+	txProcessor := &ethcore.EthTxSuccessProcessorMock{
+		Type: common.BridgingTxTypeBridgingRequest,
+		AddClaimCallback: func(claims *oCore.BridgeClaims) {
+			if !tx.ForceRefund {
+				claims.BridgingRequestClaims = append(claims.BridgingRequestClaims, oCore.BridgingRequestClaim{
+					ObservedTransactionHash: common.Hash(tx.Hash),
+					DestinationChainId:      common.ToNumChainID(common.ChainIDStrPrime),
+					TotalAmountDst:          big.NewInt(600),
+				})
+			}
+		},
+	}
+	txProcessor.On("ValidateAndAddClaim", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	expiredRecord := &oCore.OutflowRecord{
+		SourceChainID: common.ChainIDStrVector, DestinationChainID: common.ChainIDStrPrime,
+		TxHash: common.Hash{3}, Amount: big.NewInt(500), BlockSlot: 1_000,
+	}
+
+	dbMock := &ethcore.EthTxsProcessorDBMock{}
+	dbMock.On("RemoveOutflowRecords", []*oCore.OutflowRecord{expiredRecord}).Return(nil).Once()
+	dbMock.On("GetOutflowRecords").Return([]*oCore.OutflowRecord{
+		{
+			SourceChainID: common.ChainIDStrVector, DestinationChainID: common.ChainIDStrPrime,
+			TxHash: common.Hash{2}, Amount: big.NewInt(300), BlockSlot: 2_000,
+		},
+		expiredRecord,
+	}, nil)
+
+	bridgeSC := &eth.OracleBridgeSmartContractMock{}
+	bridgeSC.On("GetLastObservedBlock").Return(eth.CardanoBlock{BlockSlot: big.NewInt(5_000)}, nil)
+
+	sp := NewEthStateProcessor(
+		context.Background(), appConfig, dbMock, nil, nil, hclog.NewNullLogger(), bridgeSC)
+
+	validateAndCheck := func() (*oCore.BridgeClaims, bool, error) {
+		claims := &oCore.BridgeClaims{}
+
+		require.NoError(t, txProcessor.ValidateAndAddClaim(claims, tx, appConfig))
+
+		isDeferred, err := sp.checkOutflowLimits(claims, txProcessor, tx)
+
+		return claims, isDeferred, err
+	}
+
+	sp.Reset()
+
+	claims, isDeferred, err := validateAndCheck()
+	require.NoError(t, err)
+	require.False(t, isDeferred)
+	require.Len(t, claims.BridgingRequestClaims, 1)
+	require.Len(t, sp.state.updateData.AddOutflowRecords, 1)
+	require.Equal(t, "0xsender", sp.state.updateData.AddOutflowRecords[0].SenderAddr)
+	require.Equal(t, big.NewInt(900), sp.state.outflowUsage.PerDestinationChain[common.ChainIDStrPrime])
+
+	t.Run("defer", func(t *testing.T) {
+		claims, isDeferred, err := validateAndCheck()
+		require.NoError(t, err)
+		require.True(t, isDeferred)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.updateData.AddOutflowRecords, 1)
+	})
+
+	t.Run("refund disabled", func(t *testing.T) {
+		appConfig.BridgingSettings.OutflowLimits.Policy = oCore.OutflowLimitPolicyRefund
+
+		claims, isDeferred, err := validateAndCheck()
+		require.ErrorContains(t, err, "refunds are disabled")
+		require.False(t, isDeferred)
+		require.False(t, tx.ForceRefund)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.updateData.AddOutflowRecords, 1)
+	})
+
+	t.Run("refund", func(t *testing.T) {
+		appConfig.RefundEnabled = true
+
+		claims, isDeferred, err := validateAndCheck()
+		require.NoError(t, err)
+		require.False(t, isDeferred)
+		require.True(t, tx.ForceRefund)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.updateData.AddOutflowRecords, 1)
+	})
+
+	t.Run("not refunded", func(t *testing.T) {
+		tx.ForceRefund = false
+		txProcessor.AddClaimCallback = func(claims *oCore.BridgeClaims) {
+			claims.BridgingRequestClaims = append(claims.BridgingRequestClaims, oCore.BridgingRequestClaim{
+				ObservedTransactionHash: common.Hash(tx.Hash),
+				DestinationChainId:      common.ToNumChainID(common.ChainIDStrPrime),
+				TotalAmountDst:          big.NewInt(600),
+			})
+		}
+
+		claims, _, err := validateAndCheck()
+		require.ErrorContains(t, err, "not handed over to the refund processor")
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.updateData.AddOutflowRecords, 1)
+	})
+
+	dbMock.AssertNumberOfCalls(t, "GetOutflowRecords", 1)
+	dbMock.AssertExpectations(t)
+}
+
+func TestEthStateProcessor_CheckManualApproval(t *testing.T) {
+	appConfig := &oCore.AppConfig{
+		BridgingSettings: oCore.BridgingSettings{
+			ManualApproval: oCore.ManualApprovalSettings{
+				MinAmount:         big.NewInt(1_000),
+				RequiredApprovals: 2,
+				ReviewTimeoutBlocks: map[string]uint64{
+					common.ChainIDStrNexus: 50,
+				},
+			},
+		},
+		RefundEnabled: true,
+	}
+
+	tx := &ethcore.EthTx{OriginChainID: common.ChainIDStrNexus, Hash: ethgo.Hash{1}, BlockNumber: 100}
+	txProcessor := &ethcore.EthTxSuccessProcessorMock{
+		Type: common.BridgingTxTypeBridgingRequest,
+		AddClaimCallback: func(claims *oCore.BridgeClaims) {
+			if !tx.ForceRefund {
+				claims.BridgingRequestClaims = append(claims.BridgingRequestClaims, oCore.BridgingRequestClaim{
+					ObservedTransactionHash: common.Hash(tx.Hash),
+					DestinationChainId:      common.ToNumChainID(common.ChainIDStrPrime),
+					TotalAmountDst:          big.NewInt(2_000),
+				})
+			}
+		},
+	}
+	txProcessor.On("ValidateAndAddClaim", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	dbMock := &ethcore.EthTxsProcessorDBMock{}
+	dbMock.On("GetManualApproval", common.ChainIDStrNexus, common.Hash(tx.Hash)).Return(
+		(*oCore.ManualApproval)(nil), nil).Once()
+	dbMock.On("GetManualApproval", common.ChainIDStrNexus, common.Hash(tx.Hash)).Return(&oCore.ManualApproval{
+		DeadlineBlock: 150,
+		Approvals: []*oCore.ManualApprovalVote{
+			{Operator: "alice"}, {Operator: "bob"},
+		},
+	}, nil).Once()
+	dbMock.On("GetManualApproval", common.ChainIDStrNexus, common.Hash(tx.Hash)).Return(&oCore.ManualApproval{
+		DeadlineBlock: 150,
+		Approvals: []*oCore.ManualApprovalVote{
+			{Operator: "alice"}, {Operator: "alice"}, {Operator: ""},
+		},
+	}, nil).Once()
+	dbMock.On("GetManualApproval", common.ChainIDStrNexus, common.Hash(tx.Hash)).Return(&oCore.ManualApproval{
+		DeadlineBlock: 120,
+		Approvals:     []*oCore.ManualApprovalVote{{Operator: "alice"}},
+	}, nil).Twice()
+
+	// the deadline is counted in the blocks of the source chain
+	nexusDB := &ethcore.EventStoreMock{}
+	nexusDB.On("GetLastProcessedBlock").Return(uint64(110), nil).Twice()
+	nexusDB.On("GetLastProcessedBlock").Return(uint64(120), nil).Twice()
+
+	sp := NewEthStateProcessor(
+		context.Background(), appConfig, dbMock, nil,
+		map[string]eventTrackerStore.EventTrackerStore{common.ChainIDStrNexus: nexusDB}, hclog.NewNullLogger(), nil)
+
+	validateAndCheck := func() (*oCore.BridgeClaims, bool, error) {
+		claims := &oCore.BridgeClaims{}
+
+		require.NoError(t, txProcessor.ValidateAndAddClaim(claims, tx, appConfig))
+
+		isHeld, err := sp.checkManualApproval(claims, txProcessor, tx)
+
+		return claims, isHeld, err
+	}
+
+	sp.Reset()
+
+	t.Run("hold", func(t *testing.T) {
+		claims, isHeld, err := validateAndCheck()
+		require.NoError(t, err)
+		require.True(t, isHeld)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.awaitingApprovalTxs, 1)
+		require.Len(t, sp.state.updateData.AddManualApprovals, 1)
+		require.Equal(t, common.ChainIDStrPrime, sp.state.updateData.AddManualApprovals[0].DestinationChainID)
+		require.Equal(t, big.NewInt(2_000), sp.state.updateData.AddManualApprovals[0].Amount)
+		require.Equal(t, uint64(100), sp.state.updateData.AddManualApprovals[0].HeldAtBlock)
+		require.Equal(t, uint64(150), sp.state.updateData.AddManualApprovals[0].DeadlineBlock)
+	})
+
+	t.Run("approved", func(t *testing.T) {
+		claims, isHeld, err := validateAndCheck()
+		require.NoError(t, err)
+		require.False(t, isHeld)
+		require.Len(t, claims.BridgingRequestClaims, 1)
+		require.Len(t, sp.state.awaitingApprovalTxs, 1)
+	})
+
+	t.Run("approved twice by the same operator", func(t *testing.T) {
+		claims, isHeld, err := validateAndCheck()
+		require.NoError(t, err)
+		require.True(t, isHeld)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.awaitingApprovalTxs, 2)
+	})
+
+	t.Run("review deadline passed", func(t *testing.T) {
+		claims, isHeld, err := validateAndCheck()
+		require.NoError(t, err)
+		require.False(t, isHeld)
+		require.True(t, tx.ForceRefund)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.awaitingApprovalTxs, 2)
+		require.Len(t, sp.state.updateData.ExpireManualApprovals, 1)
+	})
+
+	t.Run("review deadline passed with refunds disabled", func(t *testing.T) {
+		appConfig.RefundEnabled = false
+		tx.ForceRefund = false
+
+		claims, isHeld, err := validateAndCheck()
+		require.ErrorContains(t, err, "refunds are disabled")
+		require.False(t, isHeld)
+		require.False(t, tx.ForceRefund)
+		require.Empty(t, claims.BridgingRequestClaims)
+		require.Len(t, sp.state.updateData.ExpireManualApprovals, 1)
+	})
+
+	dbMock.AssertExpectations(t)
+	nexusDB.AssertExpectations(t)
+}
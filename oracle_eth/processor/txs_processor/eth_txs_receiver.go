@@ -10,6 +10,7 @@ import (
 	oCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_common/utils"
 	"github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	"github.com/Ethernal-Tech/apex-bridge/telemetry"
 	"github.com/Ethernal-Tech/ethgo"
 	ethereum_common "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -21,6 +22,7 @@ type EthTxsReceiverImpl struct {
 	appConfig                   *oCore.AppConfig
 	db                          core.EthTxsProcessorDB
 	txProcessors                *txProcessorsCollection
+	bridgeDataFetcher           core.EthBridgeDataFetcher
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater
 	logger                      hclog.Logger
 }
@@ -31,6 +33,7 @@ func NewEthTxsReceiverImpl(
 	appConfig *oCore.AppConfig,
 	db core.EthTxsProcessorDB,
 	txProcessors *txProcessorsCollection,
+	bridgeDataFetcher core.EthBridgeDataFetcher,
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater,
 	logger hclog.Logger,
 ) *EthTxsReceiverImpl {
@@ -38,6 +41,7 @@ func NewEthTxsReceiverImpl(
 		appConfig:                   appConfig,
 		db:                          db,
 		txProcessors:                txProcessors,
+		bridgeDataFetcher:           bridgeDataFetcher,
 		bridgingRequestStateUpdater: bridgingRequestStateUpdater,
 		logger:                      logger,
 	}
@@ -72,6 +76,18 @@ func (r *EthTxsReceiverImpl) NewUnprocessedLog(originChainID string, log *ethgo.
 		return err
 	}
 
+	isReorged, isClaimed, err := r.handleReorg(tx)
+	if err != nil {
+		r.logger.Error("failed to handle reorg of tx", "tx", tx, "err", err)
+
+		return err
+	}
+
+	// removed logs and reorged txs already claimed on the bridge are not added again
+	if tx.Removed || (isReorged && isClaimed) {
+		return nil
+	}
+
 	r.logger.Debug("Checking if tx is relevant", "tx", tx)
 
 	txProcessor, err := r.txProcessors.getSuccess(tx, r.appConfig)
@@ -85,15 +101,10 @@ func (r *EthTxsReceiverImpl) NewUnprocessedLog(originChainID string, log *ethgo.
 
 		relevantTxs = append(relevantTxs, tx)
 
-		if txProcessorType == common.BridgingTxTypeBridgingRequest ||
-			txProcessorType == common.TxTypeRefundRequest {
-			bridgingRequests = append(
-				bridgingRequests,
-				&common.NewBridgingRequestStateModel{
-					SourceTxHash: common.Hash(tx.Hash),
-					IsRefund:     txProcessorType == common.TxTypeRefundRequest,
-				},
-			)
+		// reorged txs already have their bridging request state moved back
+		if !isReorged && (txProcessorType == common.BridgingTxTypeBridgingRequest ||
+			txProcessorType == common.TxTypeRefundRequest) {
+			bridgingRequests = append(bridgingRequests, newBridgingRequestStateModel(tx, txProcessorType))
 		}
 	}
 
@@ -123,8 +134,145 @@ func (r *EthTxsReceiverImpl) NewUnprocessedLog(originChainID string, log *ethgo.
 	return nil
 }
 
+// handleReorg checks if the tx was reorged: either its log was removed
+// or the stored tx with the same hash is from a different block.
+// A reorged pending tx is left pending because its claim is already submitted and an alert is raised.
+// A reorged unprocessed tx is withdrawn and its bridging request state is moved back,
+// unless the bridge already confirmed it, in which case it is stored as processed
+func (r *EthTxsReceiverImpl) handleReorg(tx *core.EthTx) (isReorged bool, isClaimed bool, err error) {
+	processedTx, err := r.db.GetProcessedTx(oCore.DBTxID{ChainID: tx.OriginChainID, DBKey: tx.Hash[:]})
+	if err != nil {
+		return false, false, err
+	}
+
+	if processedTx != nil {
+		if !tx.Removed && processedTx.BlockHash == tx.BlockHash {
+			return false, true, nil
+		}
+
+		reason := reorgReason(tx, processedTx.BlockHash)
+
+		r.logger.Warn("Processed tx reorged", "chainID", tx.OriginChainID, "txHash", tx.Hash,
+			"reason", reason, "isInvalid", processedTx.IsInvalid)
+		telemetry.UpdateOracleReorgedTxsCounter(tx.OriginChainID, 1)
+
+		if !processedTx.IsInvalid {
+			r.alertReorgedClaim(tx, reason)
+		}
+
+		return true, true, nil
+	}
+
+	var reorgedPendingTx *core.EthTx
+
+	withdrawnTx, err := r.db.WithdrawTx(tx.OriginChainID, tx.Hash[:], func(storedTx *core.EthTx, isPending bool) bool {
+		if !tx.Removed && storedTx.BlockHash == tx.BlockHash {
+			return false
+		}
+
+		if isPending {
+			reorgedPendingTx = storedTx
+
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		return false, false, err
+	}
+
+	if reorgedPendingTx != nil {
+		reason := reorgReason(tx, reorgedPendingTx.BlockHash)
+
+		// requeueing the tx would submit its claim again, so the bridge decides its outcome
+		r.logger.Warn("Pending tx reorged", "chainID", tx.OriginChainID, "txHash", tx.Hash, "reason", reason)
+		telemetry.UpdateOracleReorgedTxsCounter(tx.OriginChainID, 1)
+		r.alertReorgedClaim(tx, reason)
+
+		return true, true, nil
+	}
+
+	if withdrawnTx == nil {
+		return false, false, nil
+	}
+
+	reason := reorgReason(tx, withdrawnTx.BlockHash)
+
+	r.logger.Warn("Reorged tx withdrawn", "chainID", tx.OriginChainID, "txHash", tx.Hash, "reason", reason)
+	telemetry.UpdateOracleReorgedTxsCounter(tx.OriginChainID, 1)
+
+	txProcessor, err := r.txProcessors.getSuccess(withdrawnTx, r.appConfig)
+	if err != nil {
+		r.logger.Debug("Failed to get tx processor for reorged tx", "tx", withdrawnTx, "err", err)
+
+		return true, false, nil
+	}
+
+	txProcessorType := txProcessor.GetType()
+	if txProcessorType != common.BridgingTxTypeBridgingRequest && txProcessorType != common.TxTypeRefundRequest {
+		return true, false, nil
+	}
+
+	if !tx.Removed && txProcessorType == common.BridgingTxTypeBridgingRequest && r.isBridgingRequestConfirmed(tx) {
+		r.logger.Info("Reorged tx already confirmed by the bridge", "chainID", tx.OriginChainID, "txHash", tx.Hash)
+
+		if err := r.db.AddTxs([]*core.ProcessedEthTx{tx.ToProcessedEthTx(false)}, nil); err != nil {
+			return false, false, err
+		}
+
+		return true, true, nil
+	}
+
+	err = r.bridgingRequestStateUpdater.Reorged(common.NewBridgingRequestStateKey(
+		tx.OriginChainID, common.Hash(tx.Hash), txProcessorType == common.TxTypeRefundRequest), reason)
+	if err != nil {
+		r.logger.Error("error while moving back a bridging request state of reorged tx",
+			"chainID", tx.OriginChainID, "txHash", tx.Hash, "err", err)
+	}
+
+	return true, false, nil
+}
+
+// isBridgingRequestConfirmed checks if the bridge already confirmed the reorged bridging request,
+// so submitting its claim again is not needed. If the bridge can not be queried, the tx is requeued
+// because the bridge ignores claims for the already confirmed txs
+func (r *EthTxsReceiverImpl) isBridgingRequestConfirmed(tx *core.EthTx) bool {
+	metadata, err := core.UnmarshalEthMetadata[core.BridgingRequestEthMetadata](tx.Metadata)
+	if err != nil {
+		return false
+	}
+
+	isConfirmed, err := r.bridgeDataFetcher.IsBridgingRequestConfirmed(
+		tx.OriginChainID, metadata.DestinationChainID, tx.Hash)
+	if err != nil {
+		r.logger.Error("Failed to check if the reorged tx is confirmed by the bridge",
+			"chainID", tx.OriginChainID, "txHash", tx.Hash, "err", err)
+
+		return false
+	}
+
+	return isConfirmed
+}
+
+func (r *EthTxsReceiverImpl) alertReorgedClaim(tx *core.EthTx, reason string) {
+	r.logger.Error("Claim already submitted for reorged tx", "chainID", tx.OriginChainID, "txHash", tx.Hash,
+		"reason", reason)
+	telemetry.UpdateOracleReorgedClaimsCounter(tx.OriginChainID, 1)
+}
+
+func reorgReason(tx *core.EthTx, oldBlockHash ethgo.Hash) string {
+	if tx.Removed {
+		return fmt.Sprintf("log removed from block %s by a reorg", oldBlockHash)
+	}
+
+	return fmt.Sprintf("tx reorged from block %s to block %s", oldBlockHash, tx.BlockHash)
+}
+
 func (r *EthTxsReceiverImpl) logToTx(originChainID string, log *ethgo.Log) (*core.EthTx, error) {
-	events, err := eth.GetNexusEventSignatures()
+	contractsABI := r.appConfig.GetGatewayContractsABI(originChainID)
+
+	events, err := eth.GetNexusEventSignatures(contractsABI)
 	if err != nil {
 		r.logger.Error("failed to get nexus event signatures", "err", err)
 
@@ -137,7 +285,7 @@ func (r *EthTxsReceiverImpl) logToTx(originChainID string, log *ethgo.Log) (*cor
 	// validator set change occurred
 	vscEventSig := events[3]
 
-	contract, err := contractbinding.NewGateway(ethereum_common.Address{}, nil)
+	contract, err := contractbinding.NewGatewayWithABI(ethereum_common.Address{}, nil, contractsABI)
 	if err != nil {
 		r.logger.Error("failed to get contractbinding gateway", "err", err)
 
@@ -177,7 +325,7 @@ func (r *EthTxsReceiverImpl) logToTx(originChainID string, log *ethgo.Log) (*cor
 			return nil, err
 		}
 
-		evmTx, err := eth.NewEVMSmartContractTransaction(deposit.Data)
+		evmTx, err := eth.NewEVMSmartContractTransaction(deposit.Data, contractsABI)
 		if err != nil {
 			r.logger.Error("failed to create new evm smart contract tx", "err", err)
 
@@ -216,6 +364,11 @@ func (r *EthTxsReceiverImpl) logToTx(originChainID string, log *ethgo.Log) (*cor
 				Amount:  tx.Amount,
 				Address: tx.Receiver,
 			}
+
+			if tx.TokenAmount != nil && tx.TokenAmount.Sign() > 0 {
+				txs[idx].Token = tx.Token.String()
+				txs[idx].TokenAmount = tx.TokenAmount
+			}
 		}
 
 		bridgingRequestMetadata := core.BridgingRequestEthMetadata{
@@ -298,3 +451,37 @@ func (r *EthTxsReceiverImpl) logToTx(originChainID string, log *ethgo.Log) (*cor
 		InnerActionHash: innerActionTxHash,
 	}, nil
 }
+
+// newBridgingRequestStateModel fills the searchable data of a new bridging request state from tx metadata
+func newBridgingRequestStateModel(
+	tx *core.EthTx, txProcessorType common.BridgingTxType,
+) *common.NewBridgingRequestStateModel {
+	model := &common.NewBridgingRequestStateModel{
+		SourceTxHash: common.Hash(tx.Hash),
+		IsRefund:     txProcessorType == common.TxTypeRefundRequest,
+	}
+
+	if model.IsRefund {
+		metadata, err := core.UnmarshalEthMetadata[core.RefundBridgingRequestEthMetadata](tx.Metadata)
+		if err == nil {
+			model.SenderAddr = metadata.SenderAddr
+		}
+
+		return model
+	}
+
+	metadata, err := core.UnmarshalEthMetadata[core.BridgingRequestEthMetadata](tx.Metadata)
+	if err != nil {
+		return model
+	}
+
+	model.SenderAddr = metadata.SenderAddr
+	model.DestinationChainID = metadata.DestinationChainID
+	model.ReceiverAddrs = make([]string, len(metadata.Transactions))
+
+	for i, x := range metadata.Transactions {
+		model.ReceiverAddrs[i] = x.Address
+	}
+
+	return model
+}
@@ -10,6 +10,8 @@ type BridgeConfig struct {
 	NodeURL              string `json:"NodeUrl"`
 	DynamicTx            bool   `json:"dynamicTx"`
 	SmartContractAddress string `json:"scAddress"`
+	// the bridge contract deployed before the native tokens release is bound with the legacy ABI
+	LegacyContractsABI bool `json:"legacyContractsAbi,omitempty"`
 }
 
 type RelayerConfiguration struct {
@@ -20,7 +22,10 @@ type RelayerConfiguration struct {
 }
 
 type ChainConfig struct {
-	ChainID       string          `json:"id,omitempty"`
+	ChainID string `json:"id,omitempty"`
+	// ChainIDNum is the numeric id of the chain on the bridge contract. The registered chains
+	// which are not prime, vector or nexus are relayed only if they are configured with it
+	ChainIDNum    uint8           `json:"idNum,omitempty"`
 	ChainType     string          `json:"type"`
 	DbsPath       string          `json:"dbsPath"`
 	ChainSpecific json.RawMessage `json:"config"`
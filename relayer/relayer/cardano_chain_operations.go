@@ -19,6 +19,7 @@ var _ core.ChainOperations = (*CardanoChainOperations)(nil)
 
 type CardanoChainOperations struct {
 	txProvider       cardanowallet.ITxProvider
+	txBuilderBackend cardanotx.TxBuilderBackend
 	cardanoCliBinary string
 	logger           hclog.Logger
 }
@@ -32,6 +33,10 @@ func NewCardanoChainOperations(
 		return nil, err
 	}
 
+	if err := config.TxBuilderBackend.Validate(); err != nil {
+		return nil, err
+	}
+
 	txProvider, err := config.CreateTxProvider()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tx provider: %w", err)
@@ -39,6 +44,7 @@ func NewCardanoChainOperations(
 
 	return &CardanoChainOperations{
 		txProvider:       txProvider,
+		txBuilderBackend: config.TxBuilderBackend,
 		cardanoCliBinary: cardanowallet.ResolveCardanoCliBinary(config.NetworkID),
 		logger:           logger,
 	}, nil
@@ -61,7 +67,7 @@ func (cco *CardanoChainOperations) SendTx(
 	copy(witnesses, smartContractData.Signatures)
 	copy(witnesses[len(smartContractData.Signatures):], smartContractData.FeeSignatures)
 
-	txBuilder, err := cardanowallet.NewTxBuilder(cco.cardanoCliBinary)
+	txBuilder, err := cardanotx.NewTxBuilder(cco.txBuilderBackend, cco.cardanoCliBinary)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/batcher/batcher"
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	ethtxhelper "github.com/Ethernal-Tech/apex-bridge/eth/txhelper"
 	"github.com/Ethernal-Tech/apex-bridge/relayer/core"
@@ -72,7 +73,7 @@ func NewEVMChainOperations(
 
 	evmSmartContract, err := eth.NewEVMGatewaySmartContract(
 		gatewayAddress, txHelper, config.DepositGasLimit,
-		gasPrice, gasFeeCap, gasTipCap, logger)
+		gasPrice, gasFeeCap, gasTipCap, contractbinding.GetContractsABI(config.LegacyContractsABI), logger)
 	if err != nil {
 		return nil, err
 	}
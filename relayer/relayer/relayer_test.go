@@ -170,8 +170,7 @@ func TestRelayerExecute(t *testing.T) {
 func TestRelayerGetChainSpecificOperations(t *testing.T) {
 	jsonData := []byte(`{
 		"socketPath": "./socket",
-		"testnetMagic": 2,
-		"potentialFee": 300000
+		"testnetMagic": 2
 		}`)
 
 	t.Run("invalid chain type", func(t *testing.T) {
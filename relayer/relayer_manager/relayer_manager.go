@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	ethtxhelper "github.com/Ethernal-Tech/apex-bridge/eth/txhelper"
 	"github.com/Ethernal-Tech/apex-bridge/relayer/core"
@@ -39,9 +40,14 @@ func NewRelayerManager(
 			ethtxhelper.WithNodeURL(config.Bridge.NodeURL),
 			ethtxhelper.WithInitClientAndChainIDFn(context.Background()),
 			ethtxhelper.WithDynamicTx(config.Bridge.DynamicTx))
-		bridgeSmartContract = eth.NewBridgeSmartContract(config.Bridge.SmartContractAddress, txHelper)
+		bridgeSmartContract = eth.NewBridgeSmartContract(
+			config.Bridge.SmartContractAddress, txHelper, contractbinding.GetContractsABI(config.Bridge.LegacyContractsABI))
 	)
 
+	if err := registerChains(config); err != nil {
+		return nil, fmt.Errorf("failed to register configured chains. err: %w", err)
+	}
+
 	err := common.RetryForever(ctx, 2*time.Second, func(ctxInner context.Context) (err error) {
 		allRegisteredChains, err = bridgeSmartContract.GetAllRegisteredChains(ctxInner)
 		if err != nil {
@@ -109,6 +115,25 @@ func LoadConfig(path string) (*core.RelayerManagerConfiguration, error) {
 	return &appConfig, nil
 }
 
+func registerChains(config *core.RelayerManagerConfiguration) error {
+	for chainID, chainConfig := range config.Chains {
+		if chainConfig.ChainIDNum == 0 {
+			continue
+		}
+
+		chainType, err := common.ChainTypeFromStr(strings.ToLower(chainConfig.ChainType))
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", chainID, err)
+		}
+
+		if err := common.RegisterChain(chainID, chainConfig.ChainIDNum, chainType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func getRelayersAndConfigurations(
 	bridgeSmartContract eth.IBridgeSmartContract,
 	allRegisteredChains []eth.Chain,
@@ -124,12 +149,17 @@ func getRelayersAndConfigurations(
 		chainID := common.ToStrChainID(chainData.Id)
 
 		chainConfig, exists := config.Chains[chainID]
-		if !exists {
-			logger.Warn("No configuration for registered chain: %s. Chain type = %d", chainID, chainData.ChainType)
+		if chainID == "" || !exists {
+			logger.Warn("No configuration for registered chain", "chainID", chainID, "chainIDNum", chainData.Id,
+				"chainType", chainData.ChainType)
 
 			continue
 		}
 
+		if err := common.RegisterChain(chainID, chainData.Id, chainData.ChainType); err != nil {
+			return nil, nil, fmt.Errorf("failed to register chain %s: %w", chainID, err)
+		}
+
 		chainConfig.ChainID = chainID
 		newChainsConfigs[chainID] = chainConfig
 
@@ -27,8 +27,7 @@ func TestRelayerManagerConfig(t *testing.T) {
 
 	jsonData := []byte(`{
 		"blockFrostUrl": "http://hello.com",
-		"testnetMagic": 2,
-		"potentialFee": 300000
+		"testnetMagic": 2
 		}`)
 
 	rawMessage := json.RawMessage(jsonData)
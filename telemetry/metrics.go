@@ -1,9 +1,15 @@
 package telemetry
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-metrics"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
@@ -11,6 +17,9 @@ const (
 	batcherMetricsPrefix   = "batcher"
 	indexersMetricsPrefix  = "indexers"
 	hotWalletMetricsPrefix = "hotwallet"
+	bridgingRequestsPrefix = "bridging_requests"
+
+	prometheusNamespace = "apex_bridge"
 )
 
 func UpdateOracleTxsReceivedCounter(chain string, cnt int) {
@@ -29,6 +38,30 @@ func UpdateOracleClaimsInvalidMetaDataCounter(chain string, cnt int) {
 	metrics.IncrCounter([]string{oracleMetricsPrefix, "claims_invalid_metadata_counter", chain}, float32(cnt))
 }
 
+func UpdateOracleDeadLetterTxsCount(chain string, cnt int) {
+	metrics.SetGauge([]string{oracleMetricsPrefix, "dead_letter_txs", chain}, float32(cnt))
+}
+
+func UpdateOracleReorgedTxsCounter(chain string, cnt int) {
+	metrics.IncrCounter([]string{oracleMetricsPrefix, "reorged_txs_counter", chain}, float32(cnt))
+}
+
+func UpdateOracleReorgedClaimsCounter(chain string, cnt int) {
+	metrics.IncrCounter([]string{oracleMetricsPrefix, "reorged_claims_counter", chain}, float32(cnt))
+}
+
+func UpdateOracleOutflowLimitExceededCounter(chain string, policy string, cnt int) {
+	metrics.IncrCounter([]string{oracleMetricsPrefix, "outflow_limit_exceeded_counter", policy, chain}, float32(cnt))
+}
+
+// UpdateOracleOutflowUsage sets the amount bridged within the outflow limits window
+// for a source or a destination chain
+func UpdateOracleOutflowUsage(direction string, chain string, amount *big.Int) {
+	val, _ := new(big.Float).SetInt(amount).Float32()
+
+	metrics.SetGauge([]string{oracleMetricsPrefix, "outflow_usage", direction, chain}, val)
+}
+
 func UpdateBatcherBatchSubmitSucceeded(chain string, id uint64) {
 	metrics.SetGauge([]string{batcherMetricsPrefix, "batch_submit_succeeded", chain}, float32(id))
 }
@@ -37,6 +70,16 @@ func UpdateBatcherBatchSubmitFailed(chain string, id uint64) {
 	metrics.SetGauge([]string{batcherMetricsPrefix, "batch_submit_failed", chain}, float32(id))
 }
 
+// UpdateBatcherUtxoCount sets the number of utxos of the multisig or the fee multisig address
+func UpdateBatcherUtxoCount(chain string, typeAddr string, cnt int) {
+	metrics.SetGauge([]string{batcherMetricsPrefix, "utxo_count", typeAddr, chain}, float32(cnt))
+}
+
+// UpdateBatcherUtxoAmounts replaces the size distribution of the utxos of the multisig or the fee multisig address
+func UpdateBatcherUtxoAmounts(chain string, typeAddr string, amounts []uint64) {
+	batcherUtxoAmounts.update(amounts, typeAddr, chain)
+}
+
 func UpdateIndexersBlockCounter(chain string, cnt int) {
 	metrics.IncrCounter([]string{indexersMetricsPrefix, "block_counter", chain}, float32(cnt))
 }
@@ -48,3 +91,110 @@ func UpdateHotWalletState(chain string, typeWallet string, val uint64) {
 	metrics.SetGauge([]string{batcherMetricsPrefix, stateHigh, chain}, float32(val>>32))
 	metrics.SetGauge([]string{batcherMetricsPrefix, stateLow, chain}, float32(uint32(val))) //nolint:gosec
 }
+
+// bridging request durations are histograms, so quantiles can be aggregated across validators and time ranges.
+// go-metrics sink supports only summaries, therefore these are registered directly on the prometheus registry
+var (
+	bridgingRequestDurationBuckets = prometheus.ExponentialBuckets(5, 2, 12) // 5s up to ~2.8h
+
+	bridgingRequestStageDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: bridgingRequestsPrefix,
+		Name:      "stage_duration_seconds",
+		Help:      "Duration of a bridging request stage",
+		Buckets:   bridgingRequestDurationBuckets,
+	}, []string{"stage", "source_chain", "destination_chain"})
+
+	bridgingRequestTotalDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: bridgingRequestsPrefix,
+		Name:      "total_duration_seconds",
+		Help:      "Duration of a bridging request from the source tx until it is executed on the destination",
+		Buckets:   bridgingRequestDurationBuckets,
+	}, []string{"source_chain", "destination_chain"})
+)
+
+// utxoAmountsCollector exposes the size distribution of the utxos as a histogram. Utxos are a snapshot
+// of an address, so every update replaces the previous histogram instead of adding observations to it
+type utxoAmountsCollector struct {
+	desc       *prometheus.Desc
+	buckets    []float64
+	lock       sync.Mutex
+	histograms map[string]prometheus.Metric
+}
+
+var _ prometheus.Collector = (*utxoAmountsCollector)(nil)
+
+var batcherUtxoAmounts = &utxoAmountsCollector{
+	desc: prometheus.NewDesc(
+		prometheus.BuildFQName(prometheusNamespace, batcherMetricsPrefix, "utxo_amounts"),
+		"Size distribution of the utxos of the multisig and the fee multisig address",
+		[]string{"type", "chain"}, nil),
+	buckets:    prometheus.ExponentialBuckets(1_000_000, 4, 10), // 1 ada up to ~262k ada
+	histograms: map[string]prometheus.Metric{},
+}
+
+func (c *utxoAmountsCollector) update(amounts []uint64, labelValues ...string) {
+	bucketCounts := make(map[float64]uint64, len(c.buckets))
+	for _, bucket := range c.buckets {
+		bucketCounts[bucket] = 0
+	}
+
+	sum := float64(0)
+
+	for _, amount := range amounts {
+		sum += float64(amount)
+
+		for _, bucket := range c.buckets {
+			if float64(amount) <= bucket {
+				bucketCounts[bucket]++
+			}
+		}
+	}
+
+	histogram, err := prometheus.NewConstHistogram(
+		c.desc, uint64(len(amounts)), sum, bucketCounts, labelValues...)
+	if err != nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.histograms[strings.Join(labelValues, "_")] = histogram
+}
+
+func (c *utxoAmountsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *utxoAmountsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, histogram := range c.histograms {
+		ch <- histogram
+	}
+}
+
+func UpdateBridgingRequestStageDuration(srcChain, dstChain, stage string, duration time.Duration) {
+	bridgingRequestStageDuration.WithLabelValues(stage, srcChain, dstChain).Observe(duration.Seconds())
+}
+
+func UpdateBridgingRequestTotalDuration(srcChain, dstChain string, duration time.Duration) {
+	bridgingRequestTotalDuration.WithLabelValues(srcChain, dstChain).Observe(duration.Seconds())
+}
+
+func registerHistograms(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{
+		bridgingRequestStageDuration, bridgingRequestTotalDuration, batcherUtxoAmounts,
+	} {
+		var alreadyRegisteredErr prometheus.AlreadyRegisteredError
+
+		if err := registerer.Register(collector); err != nil && !errors.As(err, &alreadyRegisteredErr) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -123,6 +123,10 @@ func setupPrometheusTelemetry() error {
 		return err
 	}
 
+	if err := registerHistograms(prometheus.DefaultRegisterer); err != nil {
+		return err
+	}
+
 	metricsConf := metrics.DefaultConfig("apex-bridge")
 	metricsConf.EnableHostname = false
 	_, err = metrics.NewGlobal(metricsConf, metrics.FanoutSink{
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/utils"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+// admin request bodies are small, bigger ones are rejected so they do not bloat the audit log
+const maxAdminRequestBodySize = 64 * 1024
+
+// statusRecorder remembers the status code written by the handler, so it can be written to the audit log
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func validateAdminAPIKeys(apiConfig core.APIConfig) error {
+	keys := make(map[string]bool, len(apiConfig.AdminAPIKeys))
+	operators := make(map[string]bool, len(apiConfig.AdminAPIKeys))
+
+	for i, adminKey := range apiConfig.AdminAPIKeys {
+		switch {
+		case adminKey.Operator == "":
+			return fmt.Errorf("operator is missing for admin api key number %d", i)
+		case adminKey.Key == "":
+			return fmt.Errorf("key is missing for admin api key of operator %s", adminKey.Operator)
+		case adminKey.Role != core.AdminRoleOperator && adminKey.Role != core.AdminRoleAdmin:
+			return fmt.Errorf("invalid role %s for admin api key of operator %s", adminKey.Role, adminKey.Operator)
+		case operators[adminKey.Operator]:
+			return fmt.Errorf("duplicated admin api key operator: %s", adminKey.Operator)
+		case keys[adminKey.Key] || slices.Contains(apiConfig.APIKeys, adminKey.Key):
+			return fmt.Errorf("admin api key of operator %s is not unique", adminKey.Operator)
+		}
+
+		keys[adminKey.Key] = true
+		operators[adminKey.Operator] = true
+	}
+
+	return nil
+}
+
+// withAdminAuth allows only the admin api keys with the required role and writes every call to the audit log.
+// The authenticated operator is available to the handler through utils.GetAdminOperator
+func withAdminAuth(
+	apiConfig core.APIConfig, requiredRole core.AdminRole, auditDB core.AdminAuditDB,
+	handler core.APIEndpointHandler, logger hclog.Logger,
+) core.APIEndpointHandler {
+	return func(w http.ResponseWriter, r *http.Request) {
+		apiKeyHeaderValue := r.Header.Get(apiConfig.APIKeyHeader)
+		if apiKeyHeaderValue == "" {
+			utils.WriteUnauthorizedResponse(w, r, logger)
+
+			return
+		}
+
+		idx := slices.IndexFunc(apiConfig.AdminAPIKeys, func(x core.AdminAPIKey) bool {
+			return x.Key == apiKeyHeaderValue
+		})
+		if idx == -1 {
+			utils.WriteUnauthorizedResponse(w, r, logger)
+
+			return
+		}
+
+		adminKey := apiConfig.AdminAPIKeys[idx]
+		if !adminKey.Role.IsAllowed(requiredRole) {
+			utils.WriteErrorResponse(
+				w, r, http.StatusForbidden,
+				fmt.Errorf("operator %s with role %s is not allowed to call this endpoint", adminKey.Operator, adminKey.Role),
+				logger)
+
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAdminRequestBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+
+			if errors.As(err, &maxBytesErr) {
+				utils.WriteErrorResponse(w, r, http.StatusRequestEntityTooLarge, err, logger)
+			} else {
+				utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, logger)
+			}
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r = r.WithContext(utils.WithAdminOperator(r.Context(), adminKey.Operator))
+
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+
+		handler(recorder, r)
+
+		entry := &core.AdminAuditEntry{
+			Operator:   adminKey.Operator,
+			Role:       adminKey.Role,
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			Query:      r.URL.RawQuery,
+			Body:       string(body),
+			RemoteAddr: r.RemoteAddr,
+			StatusCode: recorder.statusCode,
+			CreatedAt:  time.Now().UTC(),
+		}
+
+		if err := auditDB.AddAdminAuditEntry(entry); err != nil {
+			logger.Error("failed to write admin audit entry", "operator", entry.Operator,
+				"method", entry.Method, "path", entry.Path, "status", entry.StatusCode, "err", err)
+		}
+	}
+}
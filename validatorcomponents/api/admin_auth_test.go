@@ -0,0 +1,129 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/utils"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type adminAuditDBMock struct {
+	entries []*core.AdminAuditEntry
+}
+
+func (m *adminAuditDBMock) AddAdminAuditEntry(entry *core.AdminAuditEntry) error {
+	entry.ID = uint64(len(m.entries) + 1)
+	m.entries = append(m.entries, entry)
+
+	return nil
+}
+
+func (m *adminAuditDBMock) GetAdminAuditEntries(limit int) ([]*core.AdminAuditEntry, error) {
+	return m.entries[:min(limit, len(m.entries))], nil
+}
+
+func TestWithAdminAuth(t *testing.T) {
+	apiConfig := core.APIConfig{
+		APIKeyHeader: "x-api-key",
+		APIKeys:      []string{"shared"},
+		AdminAPIKeys: []core.AdminAPIKey{
+			{Operator: "alice", Key: "alice_key", Role: core.AdminRoleAdmin},
+			{Operator: "bob", Key: "bob_key", Role: core.AdminRoleOperator},
+		},
+	}
+
+	call := func(role core.AdminRole, auditDB *adminAuditDBMock, key string) (int, string) {
+		var operator string
+
+		handler := withAdminAuth(apiConfig, role, auditDB, func(w http.ResponseWriter, r *http.Request) {
+			operator = utils.GetAdminOperator(r)
+
+			w.WriteHeader(http.StatusAccepted)
+		}, hclog.NewNullLogger())
+
+		req := httptest.NewRequest(http.MethodPost, "/api/OracleAdmin/Invalidate", strings.NewReader(`{"txHash":"0x01"}`))
+		if key != "" {
+			req.Header.Set(apiConfig.APIKeyHeader, key)
+		}
+
+		rec := httptest.NewRecorder()
+
+		handler(rec, req)
+
+		return rec.Code, operator
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		auditDB := &adminAuditDBMock{}
+
+		for _, key := range []string{"", "shared", "unknown"} {
+			code, _ := call(core.AdminRoleOperator, auditDB, key)
+			require.Equal(t, http.StatusUnauthorized, code)
+		}
+
+		require.Empty(t, auditDB.entries)
+	})
+
+	t.Run("role not allowed", func(t *testing.T) {
+		auditDB := &adminAuditDBMock{}
+
+		code, _ := call(core.AdminRoleAdmin, auditDB, "bob_key")
+		require.Equal(t, http.StatusForbidden, code)
+		require.Empty(t, auditDB.entries)
+	})
+
+	t.Run("authorized", func(t *testing.T) {
+		auditDB := &adminAuditDBMock{}
+
+		code, operator := call(core.AdminRoleOperator, auditDB, "bob_key")
+		require.Equal(t, http.StatusAccepted, code)
+		require.Equal(t, "bob", operator)
+
+		code, operator = call(core.AdminRoleOperator, auditDB, "alice_key")
+		require.Equal(t, http.StatusAccepted, code)
+		require.Equal(t, "alice", operator)
+
+		require.Len(t, auditDB.entries, 2)
+		require.Equal(t, "bob", auditDB.entries[0].Operator)
+		require.Equal(t, core.AdminRoleOperator, auditDB.entries[0].Role)
+		require.Equal(t, "/api/OracleAdmin/Invalidate", auditDB.entries[0].Path)
+		require.Equal(t, `{"txHash":"0x01"}`, auditDB.entries[0].Body)
+		require.Equal(t, http.StatusAccepted, auditDB.entries[0].StatusCode)
+		require.Equal(t, "alice", auditDB.entries[1].Operator)
+	})
+}
+
+func TestValidateAdminAPIKeys(t *testing.T) {
+	newConfig := func(keys ...core.AdminAPIKey) core.APIConfig {
+		return core.APIConfig{APIKeys: []string{"shared"}, AdminAPIKeys: keys}
+	}
+
+	require.NoError(t, validateAdminAPIKeys(newConfig()))
+	require.NoError(t, validateAdminAPIKeys(newConfig(
+		core.AdminAPIKey{Operator: "alice", Key: "a", Role: core.AdminRoleAdmin},
+		core.AdminAPIKey{Operator: "bob", Key: "b", Role: core.AdminRoleOperator},
+	)))
+
+	require.ErrorContains(t, validateAdminAPIKeys(newConfig(
+		core.AdminAPIKey{Key: "a", Role: core.AdminRoleAdmin},
+	)), "operator is missing")
+	require.ErrorContains(t, validateAdminAPIKeys(newConfig(
+		core.AdminAPIKey{Operator: "alice", Key: "a", Role: "root"},
+	)), "invalid role")
+	require.ErrorContains(t, validateAdminAPIKeys(newConfig(
+		core.AdminAPIKey{Operator: "alice", Key: "a", Role: core.AdminRoleAdmin},
+		core.AdminAPIKey{Operator: "alice", Key: "b", Role: core.AdminRoleAdmin},
+	)), "duplicated admin api key operator")
+	require.ErrorContains(t, validateAdminAPIKeys(newConfig(
+		core.AdminAPIKey{Operator: "alice", Key: "a", Role: core.AdminRoleAdmin},
+		core.AdminAPIKey{Operator: "bob", Key: "a", Role: core.AdminRoleOperator},
+	)), "is not unique")
+	require.ErrorContains(t, validateAdminAPIKeys(newConfig(
+		core.AdminAPIKey{Operator: "alice", Key: "shared", Role: core.AdminRoleAdmin},
+	)), "is not unique")
+}
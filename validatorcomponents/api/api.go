@@ -39,10 +39,14 @@ var _ core.API = (*APIImpl)(nil)
 
 func NewAPI(
 	ctx context.Context, apiConfig core.APIConfig,
-	controllers []core.APIController, logger hclog.Logger,
+	controllers []core.APIController, auditDB core.AdminAuditDB, logger hclog.Logger,
 ) (
 	*APIImpl, error,
 ) {
+	if err := validateAdminAPIKeys(apiConfig); err != nil {
+		return nil, err
+	}
+
 	headersOk := handlers.AllowedHeaders(apiConfig.AllowedHeaders)
 	originsOk := handlers.AllowedOrigins(apiConfig.AllowedOrigins)
 	methodsOk := handlers.AllowedMethods(apiConfig.AllowedMethods)
@@ -55,9 +59,15 @@ func NewAPI(
 
 		for _, endpoint := range endpoints {
 			endpointPath := fmt.Sprintf("/%s/%s/%s", apiConfig.PathPrefix, controllerPathPrefix, endpoint.Path)
+			// controllers without path prefix are served from the root (e.g. health probes)
+			if controllerPathPrefix == "" {
+				endpointPath = "/" + endpoint.Path
+			}
 
 			endpointHandler := endpoint.Handler
-			if endpoint.APIKeyAuth {
+			if endpoint.AdminRole != "" {
+				endpointHandler = withAdminAuth(apiConfig, endpoint.AdminRole, auditDB, endpointHandler, logger)
+			} else if endpoint.APIKeyAuth {
 				endpointHandler = withAPIKeyAuth(apiConfig, endpointHandler, logger)
 			}
 
@@ -0,0 +1,256 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/request"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/response"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/utils"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+type BridgingRequestControllerImpl struct {
+	validator core.BridgingRequestValidator
+	txBuilder core.BridgingTxBuilder
+	quoter    core.BridgingQuoter
+	logger    hclog.Logger
+}
+
+var _ core.APIController = (*BridgingRequestControllerImpl)(nil)
+
+func NewBridgingRequestController(
+	validator core.BridgingRequestValidator,
+	txBuilder core.BridgingTxBuilder,
+	quoter core.BridgingQuoter,
+	logger hclog.Logger,
+) *BridgingRequestControllerImpl {
+	return &BridgingRequestControllerImpl{
+		validator: validator,
+		txBuilder: txBuilder,
+		quoter:    quoter,
+		logger:    logger,
+	}
+}
+
+func (*BridgingRequestControllerImpl) GetPathPrefix() string {
+	return "BridgingRequest"
+}
+
+func (c *BridgingRequestControllerImpl) GetEndpoints() []*core.APIEndpoint {
+	return []*core.APIEndpoint{
+		{Path: "Validate", Method: http.MethodPost, Handler: c.validate, APIKeyAuth: true},
+		{Path: "BuildCardanoTx", Method: http.MethodPost, Handler: c.buildCardanoTx, APIKeyAuth: true},
+		{Path: "BuildEvmTx", Method: http.MethodPost, Handler: c.buildEvmTx, APIKeyAuth: true},
+		{Path: "Quote", Method: http.MethodPost, Handler: c.quote, APIKeyAuth: true},
+	}
+}
+
+// validate is a dry run of the oracle checks for a bridging request that is not submitted yet.
+// A request that would be rejected is not an error, the reason is returned in the response
+func (c *BridgingRequestControllerImpl) validate(w http.ResponseWriter, r *http.Request) {
+	requestBody, ok := utils.DecodeModel[request.BridgingRequestValidationRequest](w, r, c.logger)
+	if !ok {
+		return
+	}
+
+	c.logger.Debug("validate request", "body", requestBody, "url", r.URL)
+
+	validationRequest, err := newBridgingRequestValidationRequest(&requestBody)
+	if err != nil {
+		utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, c.logger)
+
+		return
+	}
+
+	err = c.validator.Validate(validationRequest)
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewBridgingRequestValidationResponse(err), c.logger)
+}
+
+// buildCardanoTx returns an unsigned cardano bridging tx in cbor hex, ready to be signed by the wallet
+func (c *BridgingRequestControllerImpl) buildCardanoTx(w http.ResponseWriter, r *http.Request) {
+	requestBody, ok := utils.DecodeModel[request.BuildCardanoBridgingTxRequest](w, r, c.logger)
+	if !ok {
+		return
+	}
+
+	c.logger.Debug("buildCardanoTx request", "body", requestBody, "url", r.URL)
+
+	receivers, err := newBridgingTxReceivers(requestBody.Receivers)
+	if err != nil {
+		utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, c.logger)
+
+		return
+	}
+
+	senderUtxos := make([]core.BridgingTxInput, len(requestBody.SenderUtxos))
+
+	for i, x := range requestBody.SenderUtxos {
+		senderUtxos[i] = core.BridgingTxInput{
+			Hash:  strings.ToLower(strings.TrimPrefix(x.Hash, "0x")),
+			Index: x.Index,
+		}
+	}
+
+	tx, err := c.txBuilder.BuildCardanoTx(r.Context(), &core.CardanoBridgingTxRequest{
+		SourceChainID:      requestBody.SourceChainID,
+		DestinationChainID: requestBody.DestinationChainID,
+		SenderAddr:         requestBody.SenderAddr,
+		SenderUtxos:        senderUtxos,
+		Receivers:          receivers,
+		BridgingFee:        requestBody.BridgingFee,
+	})
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to build cardano bridging tx: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewCardanoBridgingTxResponse(tx), c.logger)
+}
+
+// buildEvmTx returns the unsigned gateway withdraw call data and the value that has to be sent with it
+func (c *BridgingRequestControllerImpl) buildEvmTx(w http.ResponseWriter, r *http.Request) {
+	requestBody, ok := utils.DecodeModel[request.BuildEvmBridgingTxRequest](w, r, c.logger)
+	if !ok {
+		return
+	}
+
+	c.logger.Debug("buildEvmTx request", "body", requestBody, "url", r.URL)
+
+	receivers, err := newBridgingTxReceivers(requestBody.Receivers)
+	if err != nil {
+		utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, c.logger)
+
+		return
+	}
+
+	feeAmount, ok := new(big.Int).SetString(requestBody.FeeAmount, 10)
+	if !ok {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("invalid feeAmount: %s", requestBody.FeeAmount), c.logger)
+
+		return
+	}
+
+	tx, err := c.txBuilder.BuildEvmTx(&core.EvmBridgingTxRequest{
+		SourceChainID:      requestBody.SourceChainID,
+		DestinationChainID: requestBody.DestinationChainID,
+		Receivers:          receivers,
+		FeeAmount:          feeAmount,
+	})
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to build evm bridging tx: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewEvmBridgingTxResponse(tx), c.logger)
+}
+
+// quote returns the minimal fee and receiver amounts for the direction and the amounts the receivers will get
+func (c *BridgingRequestControllerImpl) quote(w http.ResponseWriter, r *http.Request) {
+	requestBody, ok := utils.DecodeModel[request.BridgingQuoteRequest](w, r, c.logger)
+	if !ok {
+		return
+	}
+
+	c.logger.Debug("quote request", "body", requestBody, "url", r.URL)
+
+	receivers, err := newBridgingTxReceivers(requestBody.Receivers)
+	if err != nil {
+		utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, c.logger)
+
+		return
+	}
+
+	quote, err := c.quoter.Quote(r.Context(), &core.BridgingQuoteRequest{
+		SourceChainID:      requestBody.SourceChainID,
+		DestinationChainID: requestBody.DestinationChainID,
+		Receivers:          receivers,
+	})
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to get quote: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewBridgingQuoteResponse(quote), c.logger)
+}
+
+func newBridgingTxReceivers(receivers []request.BridgingTxReceiverRequest) ([]core.BridgingTxReceiver, error) {
+	result := make([]core.BridgingTxReceiver, len(receivers))
+
+	for i, x := range receivers {
+		amount, ok := new(big.Int).SetString(x.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount for receiver %s: %s", x.Address, x.Amount)
+		}
+
+		result[i] = core.BridgingTxReceiver{
+			Address: x.Address,
+			Amount:  amount,
+		}
+	}
+
+	return result, nil
+}
+
+func newBridgingRequestValidationRequest(
+	requestBody *request.BridgingRequestValidationRequest,
+) (*core.BridgingRequestValidationRequest, error) {
+	if requestBody.SourceChainID == "" {
+		return nil, errors.New("sourceChainId missing from request")
+	}
+
+	metadata, err := common.DecodeHex(requestBody.Metadata)
+	if err != nil || len(metadata) == 0 {
+		return nil, errors.New("metadata must be a non empty hex string")
+	}
+
+	result := &core.BridgingRequestValidationRequest{
+		SourceChainID: requestBody.SourceChainID,
+		Metadata:      metadata,
+		InputAddrs:    requestBody.InputAddrs,
+		Outputs:       make([]core.BridgingRequestValidationOutput, len(requestBody.Outputs)),
+	}
+
+	for i, x := range requestBody.Outputs {
+		result.Outputs[i] = core.BridgingRequestValidationOutput{
+			Address: x.Address,
+			Amount:  x.Amount,
+			Tokens:  make([]core.BridgingRequestValidationToken, len(x.Tokens)),
+		}
+
+		for j, token := range x.Tokens {
+			result.Outputs[i].Tokens[j] = core.BridgingRequestValidationToken{
+				Name:   token.Name,
+				Amount: token.Amount,
+			}
+		}
+	}
+
+	if requestBody.Value != "" {
+		value, ok := new(big.Int).SetString(requestBody.Value, 10)
+		if !ok || value.Sign() < 0 {
+			return nil, fmt.Errorf("invalid value: %s", requestBody.Value)
+		}
+
+		result.Value = value
+	}
+
+	return result, nil
+}
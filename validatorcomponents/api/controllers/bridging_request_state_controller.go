@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/response"
@@ -12,18 +15,33 @@ import (
 	"github.com/hashicorp/go-hclog"
 )
 
+const (
+	streamKeepAliveInterval = 15 * time.Second
+	streamEventName         = "state"
+
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
 type BridgingRequestStateControllerImpl struct {
 	bridgingRequestStateManager core.BridgingRequestStateManager
+	subscriber                  core.BridgingRequestStateSubscriber
+	etaEstimator                core.BridgingRequestETAEstimator
 	logger                      hclog.Logger
 }
 
 var _ core.APIController = (*BridgingRequestStateControllerImpl)(nil)
 
 func NewBridgingRequestStateController(
-	bridgingRequestStateManager core.BridgingRequestStateManager, logger hclog.Logger,
+	bridgingRequestStateManager core.BridgingRequestStateManager,
+	subscriber core.BridgingRequestStateSubscriber,
+	etaEstimator core.BridgingRequestETAEstimator,
+	logger hclog.Logger,
 ) *BridgingRequestStateControllerImpl {
 	return &BridgingRequestStateControllerImpl{
 		bridgingRequestStateManager: bridgingRequestStateManager,
+		subscriber:                  subscriber,
+		etaEstimator:                etaEstimator,
 		logger:                      logger,
 	}
 }
@@ -36,6 +54,10 @@ func (c *BridgingRequestStateControllerImpl) GetEndpoints() []*core.APIEndpoint
 	return []*core.APIEndpoint{
 		{Path: "Get", Method: http.MethodGet, Handler: c.get, APIKeyAuth: true},
 		{Path: "GetMultiple", Method: http.MethodGet, Handler: c.getMultiple, APIKeyAuth: true},
+		{Path: "GetHistory", Method: http.MethodGet, Handler: c.getHistory, APIKeyAuth: true},
+		{Path: "GetETA", Method: http.MethodGet, Handler: c.getETA, APIKeyAuth: true},
+		{Path: "List", Method: http.MethodGet, Handler: c.list, APIKeyAuth: true},
+		{Path: "Subscribe", Method: http.MethodGet, Handler: c.subscribe, APIKeyAuth: true},
 	}
 }
 
@@ -122,3 +144,238 @@ func (c *BridgingRequestStateControllerImpl) getMultiple(w http.ResponseWriter,
 
 	utils.WriteResponse(w, r, http.StatusOK, statesResponse, c.logger)
 }
+
+func (c *BridgingRequestStateControllerImpl) getHistory(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("getHistory request", "query values", queryValues, "url", r.URL)
+
+	chainIDArr, exists := queryValues["chainId"]
+	if !exists || len(chainIDArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("chainId missing from query"), c.logger)
+
+		return
+	}
+
+	txHashArr, exists := queryValues["txHash"]
+	if !exists || len(txHashArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("txHash missing from query"), c.logger)
+
+		return
+	}
+
+	chainID := chainIDArr[0]
+	txHash := common.NewHashFromHexString(txHashArr[0])
+
+	history, err := c.bridgingRequestStateManager.GetHistory(chainID, txHash)
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to get bridging request state history: %w", err), c.logger)
+
+		return
+	}
+
+	if len(history) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusNotFound,
+			errors.New("not found"), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(
+		w, r, http.StatusOK, response.NewBridgingRequestStateHistoryResponse(chainID, txHash, history), c.logger)
+}
+
+// getETA returns the estimated time of completion of a bridging request.
+// The estimate is based on how long the remaining stages took for the recent requests in the same direction
+func (c *BridgingRequestStateControllerImpl) getETA(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("getETA request", "query values", queryValues, "url", r.URL)
+
+	chainIDArr, exists := queryValues["chainId"]
+	if !exists || len(chainIDArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("chainId missing from query"), c.logger)
+
+		return
+	}
+
+	txHashArr, exists := queryValues["txHash"]
+	if !exists || len(txHashArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("txHash missing from query"), c.logger)
+
+		return
+	}
+
+	eta, err := c.etaEstimator.GetETA(chainIDArr[0], common.NewHashFromHexString(txHashArr[0]))
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to get bridging request eta: %w", err), c.logger)
+
+		return
+	}
+
+	if eta == nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusNotFound,
+			errors.New("not found"), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewBridgingRequestETAResponse(eta), c.logger)
+}
+
+// list returns a page of bridging request states, newest first. All the filters are optional,
+// from and to are RFC3339 timestamps and nextCursor from the response is used to get the next page
+func (c *BridgingRequestStateControllerImpl) list(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("list request", "query values", queryValues, "url", r.URL)
+
+	filter, err := parseBridgingRequestStateFilter(queryValues)
+	if err != nil {
+		utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, c.logger)
+
+		return
+	}
+
+	limit := defaultListLimit
+
+	if limitStr := queryValues.Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > maxListLimit {
+			utils.WriteErrorResponse(
+				w, r, http.StatusBadRequest,
+				fmt.Errorf("limit must be a number between 1 and %d", maxListLimit), c.logger)
+
+			return
+		}
+	}
+
+	states, nextCursor, err := c.bridgingRequestStateManager.List(filter, queryValues.Get("cursor"), limit)
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to list bridging request states: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(
+		w, r, http.StatusOK, response.NewBridgingRequestStateListResponse(states, nextCursor), c.logger)
+}
+
+func parseBridgingRequestStateFilter(queryValues url.Values) (core.BridgingRequestStateFilter, error) {
+	filter := core.BridgingRequestStateFilter{
+		SourceChainID:      queryValues.Get("chainId"),
+		DestinationChainID: queryValues.Get("destinationChainId"),
+		SenderAddr:         queryValues.Get("sender"),
+		ReceiverAddr:       queryValues.Get("receiver"),
+		Status:             common.BridgingRequestStatus(queryValues.Get("status")),
+	}
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return filter, fmt.Errorf("invalid status: %s", filter.Status)
+	}
+
+	for _, x := range []struct {
+		name string
+		dst  *time.Time
+	}{
+		{name: "from", dst: &filter.CreatedFrom},
+		{name: "to", dst: &filter.CreatedTo},
+	} {
+		if value := queryValues.Get(x.name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s: %w", x.name, err)
+			}
+
+			*x.dst = t
+		}
+	}
+
+	return filter, nil
+}
+
+// subscribe streams state changes as server-sent events until the client disconnects.
+// If no txHash is provided, all the changes for requests from the source chain are streamed
+func (c *BridgingRequestStateControllerImpl) subscribe(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("subscribe request", "query values", queryValues, "url", r.URL)
+
+	chainIDArr, exists := queryValues["chainId"]
+	if !exists || len(chainIDArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("chainId missing from query"), c.logger)
+
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		utils.WriteErrorResponse(
+			w, r, http.StatusInternalServerError,
+			errors.New("streaming not supported"), c.logger)
+
+		return
+	}
+
+	filter := core.BridgingRequestStateEventFilter{
+		SourceChainID:  chainIDArr[0],
+		SourceTxHashes: make([]common.Hash, len(queryValues["txHash"])),
+	}
+
+	for i, x := range queryValues["txHash"] {
+		filter.SourceTxHashes[i] = common.NewHashFromHexString(x)
+	}
+
+	eventsCh, unsubscribe := c.subscriber.Subscribe(filter)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(streamKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-eventsCh:
+			if !ok {
+				c.logger.Debug("subscription closed", "url", r.URL)
+
+				return
+			}
+
+			err := utils.WriteServerSentEvent(w, flusher, streamEventName,
+				response.NewBridgingRequestStateEventResponse(&event.State, event.PreviousStatus))
+			if err != nil {
+				c.logger.Debug("failed to write event", "url", r.URL, "err", err)
+
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/utils"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+// HealthControllerImpl serves probes on /health and /ready without api key,
+// so they can be used as liveness and readiness probes
+type HealthControllerImpl struct {
+	healthChecker core.HealthChecker
+	logger        hclog.Logger
+}
+
+var _ core.APIController = (*HealthControllerImpl)(nil)
+
+func NewHealthController(
+	healthChecker core.HealthChecker,
+	logger hclog.Logger,
+) *HealthControllerImpl {
+	return &HealthControllerImpl{
+		healthChecker: healthChecker,
+		logger:        logger,
+	}
+}
+
+func (*HealthControllerImpl) GetPathPrefix() string {
+	return ""
+}
+
+func (c *HealthControllerImpl) GetEndpoints() []*core.APIEndpoint {
+	return []*core.APIEndpoint{
+		{Path: "health", Method: http.MethodGet, Handler: c.health, APIKeyAuth: false},
+		{Path: "ready", Method: http.MethodGet, Handler: c.ready, APIKeyAuth: false},
+	}
+}
+
+func (c *HealthControllerImpl) health(w http.ResponseWriter, r *http.Request) {
+	report := c.healthChecker.GetHealth()
+
+	status := http.StatusOK
+	if !report.IsHealthy {
+		status = http.StatusServiceUnavailable
+	}
+
+	utils.WriteResponse(w, r, status, report, c.logger)
+}
+
+func (c *HealthControllerImpl) ready(w http.ResponseWriter, r *http.Request) {
+	report := c.healthChecker.GetReadiness(r.Context())
+
+	status := http.StatusOK
+	if !report.IsReady {
+		status = http.StatusServiceUnavailable
+	}
+
+	utils.WriteResponse(w, r, status, report, c.logger)
+}
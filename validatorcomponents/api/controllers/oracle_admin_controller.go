@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/request"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/response"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/utils"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+type OracleAdminControllerImpl struct {
+	oracleTxsInspector core.OracleTxsInspector
+	oracleTxsAdmin     core.OracleTxsAdmin
+	auditDB            core.AdminAuditDB
+	logger             hclog.Logger
+}
+
+var _ core.APIController = (*OracleAdminControllerImpl)(nil)
+
+func NewOracleAdminController(
+	oracleTxsInspector core.OracleTxsInspector,
+	oracleTxsAdmin core.OracleTxsAdmin,
+	auditDB core.AdminAuditDB,
+	logger hclog.Logger,
+) *OracleAdminControllerImpl {
+	return &OracleAdminControllerImpl{
+		oracleTxsInspector: oracleTxsInspector,
+		oracleTxsAdmin:     oracleTxsAdmin,
+		auditDB:            auditDB,
+		logger:             logger,
+	}
+}
+
+func (*OracleAdminControllerImpl) GetPathPrefix() string {
+	return "OracleAdmin"
+}
+
+func (c *OracleAdminControllerImpl) GetEndpoints() []*core.APIEndpoint {
+	return []*core.APIEndpoint{
+		{Path: "GetUnprocessedTxs", Method: http.MethodGet, Handler: c.getUnprocessedTxs, AdminRole: core.AdminRoleOperator},
+		{Path: "GetPendingTxs", Method: http.MethodGet, Handler: c.getPendingTxs, AdminRole: core.AdminRoleOperator},
+		{Path: "GetProcessedTxs", Method: http.MethodGet, Handler: c.getProcessedTxs, AdminRole: core.AdminRoleOperator},
+		{Path: "GetExpectedTxs", Method: http.MethodGet, Handler: c.getExpectedTxs, AdminRole: core.AdminRoleOperator},
+		{Path: "GetDeadLetterTxs", Method: http.MethodGet, Handler: c.getDeadLetterTxs, AdminRole: core.AdminRoleOperator},
+		{
+			Path: "GetUnprocessedBatchEvents", Method: http.MethodGet,
+			Handler: c.getUnprocessedBatchEvents, AdminRole: core.AdminRoleOperator,
+		},
+		{Path: "GetOutflowUsage", Method: http.MethodGet, Handler: c.getOutflowUsage, AdminRole: core.AdminRoleOperator},
+		{
+			Path: "GetManualApprovals", Method: http.MethodGet,
+			Handler: c.getManualApprovals, AdminRole: core.AdminRoleOperator,
+		},
+		{Path: "Requeue", Method: http.MethodPost, Handler: c.requeue, AdminRole: core.AdminRoleOperator},
+		// invalidated and refunded txs can not be bridged anymore, so these actions are for admins only
+		{Path: "Invalidate", Method: http.MethodPost, Handler: c.invalidate, AdminRole: core.AdminRoleAdmin},
+		{Path: "ForceRefund", Method: http.MethodPost, Handler: c.forceRefund, AdminRole: core.AdminRoleAdmin},
+		{Path: "ReplayDeadLetter", Method: http.MethodPost, Handler: c.replayDeadLetter, AdminRole: core.AdminRoleOperator},
+		{Path: "Approve", Method: http.MethodPost, Handler: c.approve, AdminRole: core.AdminRoleOperator},
+		{Path: "GetAuditLog", Method: http.MethodGet, Handler: c.getAuditLog, AdminRole: core.AdminRoleAdmin},
+	}
+}
+
+func (c *OracleAdminControllerImpl) getUnprocessedTxs(w http.ResponseWriter, r *http.Request) {
+	c.getTxs(w, r, core.OracleTxsBucketUnprocessed)
+}
+
+func (c *OracleAdminControllerImpl) getPendingTxs(w http.ResponseWriter, r *http.Request) {
+	c.getTxs(w, r, core.OracleTxsBucketPending)
+}
+
+func (c *OracleAdminControllerImpl) getProcessedTxs(w http.ResponseWriter, r *http.Request) {
+	c.getTxs(w, r, core.OracleTxsBucketProcessed)
+}
+
+func (c *OracleAdminControllerImpl) getExpectedTxs(w http.ResponseWriter, r *http.Request) {
+	c.getTxs(w, r, core.OracleTxsBucketExpected)
+}
+
+func (c *OracleAdminControllerImpl) getDeadLetterTxs(w http.ResponseWriter, r *http.Request) {
+	c.getTxs(w, r, core.OracleTxsBucketDeadLetter)
+}
+
+func (c *OracleAdminControllerImpl) getTxs(w http.ResponseWriter, r *http.Request, bucket core.OracleTxsBucket) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("getTxs request", "bucket", bucket, "query values", queryValues, "url", r.URL)
+
+	chainID := queryValues.Get("chainId")
+	if chainID == "" {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("chainId missing from query"), c.logger)
+
+		return
+	}
+
+	limit, ok := c.getLimit(w, r)
+	if !ok {
+		return
+	}
+
+	txs, err := c.oracleTxsInspector.GetTxs(chainID, bucket, limit)
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to get %s txs: %w", bucket, err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewOracleTxsResponse(chainID, bucket, txs), c.logger)
+}
+
+func (c *OracleAdminControllerImpl) getUnprocessedBatchEvents(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("getUnprocessedBatchEvents request", "query values", queryValues, "url", r.URL)
+
+	chainID := queryValues.Get("chainId")
+	if chainID == "" {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("chainId missing from query"), c.logger)
+
+		return
+	}
+
+	events, err := c.oracleTxsInspector.GetUnprocessedBatchEvents(chainID)
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to get unprocessed batch events: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewOracleBatchEventsResponse(events), c.logger)
+}
+
+func (c *OracleAdminControllerImpl) getOutflowUsage(w http.ResponseWriter, r *http.Request) {
+	c.logger.Debug("getOutflowUsage request", "url", r.URL)
+
+	usage, err := c.oracleTxsInspector.GetOutflowUsage()
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusInternalServerError,
+			fmt.Errorf("failed to get outflow usage: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewOutflowUsageResponse(usage), c.logger)
+}
+
+func (c *OracleAdminControllerImpl) getManualApprovals(w http.ResponseWriter, r *http.Request) {
+	c.logger.Debug("getManualApprovals request", "url", r.URL)
+
+	approvals, err := c.oracleTxsInspector.GetManualApprovals()
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusInternalServerError,
+			fmt.Errorf("failed to get manual approvals: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewManualApprovalsResponse(approvals), c.logger)
+}
+
+func (c *OracleAdminControllerImpl) getAuditLog(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("getAuditLog request", "query values", queryValues, "url", r.URL)
+
+	limit, ok := c.getLimit(w, r)
+	if !ok {
+		return
+	}
+
+	entries, err := c.auditDB.GetAdminAuditEntries(limit)
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusInternalServerError,
+			fmt.Errorf("failed to get audit log: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewAdminAuditLogResponse(entries), c.logger)
+}
+
+func (c *OracleAdminControllerImpl) requeue(w http.ResponseWriter, r *http.Request) {
+	c.executeAction(w, r, "requeue", c.oracleTxsAdmin.Requeue)
+}
+
+func (c *OracleAdminControllerImpl) invalidate(w http.ResponseWriter, r *http.Request) {
+	c.executeAction(w, r, "invalidate", c.oracleTxsAdmin.Invalidate)
+}
+
+func (c *OracleAdminControllerImpl) forceRefund(w http.ResponseWriter, r *http.Request) {
+	c.executeAction(w, r, "forceRefund", c.oracleTxsAdmin.ForceRefund)
+}
+
+func (c *OracleAdminControllerImpl) replayDeadLetter(w http.ResponseWriter, r *http.Request) {
+	c.executeAction(w, r, "replayDeadLetter", c.oracleTxsAdmin.ReplayDeadLetter)
+}
+
+func (c *OracleAdminControllerImpl) approve(w http.ResponseWriter, r *http.Request) {
+	action, ok := c.decodeAction(w, r, "approve")
+	if !ok {
+		return
+	}
+
+	approval, err := c.oracleTxsAdmin.Approve(action)
+	if err != nil {
+		utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewManualApprovalResponse(approval), c.logger)
+}
+
+func (c *OracleAdminControllerImpl) executeAction(
+	w http.ResponseWriter, r *http.Request, name string,
+	action func(action *core.OracleTxAdminAction) (*core.OracleTx, error),
+) {
+	adminAction, ok := c.decodeAction(w, r, name)
+	if !ok {
+		return
+	}
+
+	tx, err := action(adminAction)
+	if err != nil {
+		utils.WriteErrorResponse(w, r, http.StatusBadRequest, err, c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewOracleTxResponse(tx), c.logger)
+}
+
+func (c *OracleAdminControllerImpl) getLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		return defaultListLimit, true
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 || limit > maxListLimit {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("limit must be a number between 1 and %d", maxListLimit), c.logger)
+
+		return 0, false
+	}
+
+	return limit, true
+}
+
+func (c *OracleAdminControllerImpl) decodeAction(
+	w http.ResponseWriter, r *http.Request, name string,
+) (*core.OracleTxAdminAction, bool) {
+	requestBody, ok := utils.DecodeModel[request.OracleTxAdminActionRequest](w, r, c.logger)
+	if !ok {
+		return nil, false
+	}
+
+	c.logger.Debug(name+" request", "body", requestBody, "url", r.URL)
+
+	if requestBody.TxHash == "" {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("txHash missing from body"), c.logger)
+
+		return nil, false
+	}
+
+	return &core.OracleTxAdminAction{
+		ChainID:    requestBody.ChainID,
+		TxHash:     common.NewHashFromHexString(requestBody.TxHash),
+		Operator:   utils.GetAdminOperator(r),
+		Reason:     requestBody.Reason,
+		RemoteAddr: r.RemoteAddr,
+	}, true
+}
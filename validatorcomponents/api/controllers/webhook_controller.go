@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/response"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/utils"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+type WebhookControllerImpl struct {
+	webhookDispatcher core.WebhookDispatcher
+	logger            hclog.Logger
+}
+
+var _ core.APIController = (*WebhookControllerImpl)(nil)
+
+func NewWebhookController(
+	webhookDispatcher core.WebhookDispatcher,
+	logger hclog.Logger,
+) *WebhookControllerImpl {
+	return &WebhookControllerImpl{
+		webhookDispatcher: webhookDispatcher,
+		logger:            logger,
+	}
+}
+
+func (*WebhookControllerImpl) GetPathPrefix() string {
+	return "Webhook"
+}
+
+func (c *WebhookControllerImpl) GetEndpoints() []*core.APIEndpoint {
+	return []*core.APIEndpoint{
+		{Path: "GetDelivery", Method: http.MethodGet, Handler: c.getDelivery, APIKeyAuth: true},
+		{Path: "GetDeliveries", Method: http.MethodGet, Handler: c.getDeliveries, APIKeyAuth: true},
+	}
+}
+
+func (c *WebhookControllerImpl) getDelivery(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("getDelivery request", "query values", queryValues, "url", r.URL)
+
+	idArr, exists := queryValues["id"]
+	if !exists || len(idArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("id missing from query"), c.logger)
+
+		return
+	}
+
+	id, err := strconv.ParseUint(idArr[0], 10, 64)
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("invalid id: %w", err), c.logger)
+
+		return
+	}
+
+	delivery, err := c.webhookDispatcher.GetDelivery(id)
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to get webhook delivery: %w", err), c.logger)
+
+		return
+	}
+
+	if delivery == nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusNotFound,
+			errors.New("not found"), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewWebhookDeliveryResponse(delivery), c.logger)
+}
+
+func (c *WebhookControllerImpl) getDeliveries(w http.ResponseWriter, r *http.Request) {
+	queryValues := r.URL.Query()
+	c.logger.Debug("getDeliveries request", "query values", queryValues, "url", r.URL)
+
+	chainIDArr, exists := queryValues["chainId"]
+	if !exists || len(chainIDArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("chainId missing from query"), c.logger)
+
+		return
+	}
+
+	txHashArr, exists := queryValues["txHash"]
+	if !exists || len(txHashArr) == 0 {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			errors.New("txHash missing from query"), c.logger)
+
+		return
+	}
+
+	deliveries, err := c.webhookDispatcher.GetDeliveries(chainIDArr[0], common.NewHashFromHexString(txHashArr[0]))
+	if err != nil {
+		utils.WriteErrorResponse(
+			w, r, http.StatusBadRequest,
+			fmt.Errorf("failed to get webhook deliveries: %w", err), c.logger)
+
+		return
+	}
+
+	utils.WriteResponse(w, r, http.StatusOK, response.NewWebhookDeliveriesResponse(deliveries), c.logger)
+}
@@ -0,0 +1,60 @@
+package request
+
+type BridgingRequestValidationTokenRequest struct {
+	// policy id and hex encoded asset name separated by a dot
+	Name   string `json:"name"`
+	Amount uint64 `json:"amount"`
+}
+
+type BridgingRequestValidationOutputRequest struct {
+	Address string                                  `json:"address"`
+	Amount  uint64                                  `json:"amount"`
+	Tokens  []BridgingRequestValidationTokenRequest `json:"tokens"`
+}
+
+type BridgingRequestValidationRequest struct {
+	SourceChainID string `json:"sourceChainId"`
+	// hex encoded metadata: cbor for cardano source chains and json for evm source chains
+	Metadata string `json:"metadata"`
+	// addresses the inputs of the cardano tx are spent from
+	InputAddrs []string `json:"inputAddrs"`
+	// outputs of the cardano tx, including the one to the bridging address
+	Outputs []BridgingRequestValidationOutputRequest `json:"outputs"`
+	// amount in wei sent with the evm tx
+	Value string `json:"value"`
+}
+
+type BridgingTxReceiverRequest struct {
+	Address string `json:"address"`
+	// amount in lovelace for cardano source chains and in wei for evm source chains
+	Amount string `json:"amount"`
+}
+
+type BridgingTxInputRequest struct {
+	Hash  string `json:"hash"`
+	Index uint32 `json:"index"`
+}
+
+type BuildCardanoBridgingTxRequest struct {
+	SourceChainID      string `json:"sourceChainId"`
+	DestinationChainID string `json:"destinationChainId"`
+	SenderAddr         string `json:"senderAddr"`
+	// optional, if empty any of the sender utxos can be spent
+	SenderUtxos []BridgingTxInputRequest    `json:"senderUtxos"`
+	Receivers   []BridgingTxReceiverRequest `json:"receivers"`
+	BridgingFee uint64                      `json:"bridgingFee"`
+}
+
+type BuildEvmBridgingTxRequest struct {
+	SourceChainID      string                      `json:"sourceChainId"`
+	DestinationChainID string                      `json:"destinationChainId"`
+	Receivers          []BridgingTxReceiverRequest `json:"receivers"`
+	// fee amount in wei
+	FeeAmount string `json:"feeAmount"`
+}
+
+type BridgingQuoteRequest struct {
+	SourceChainID      string                      `json:"sourceChainId"`
+	DestinationChainID string                      `json:"destinationChainId"`
+	Receivers          []BridgingTxReceiverRequest `json:"receivers"`
+}
@@ -0,0 +1,8 @@
+package request
+
+// OracleTxAdminActionRequest does not contain the operator, it is the one authenticated by the admin api key
+type OracleTxAdminActionRequest struct {
+	ChainID string `json:"chainId"`
+	TxHash  string `json:"txHash"`
+	Reason  string `json:"reason"`
+}
@@ -0,0 +1,93 @@
+package response
+
+import (
+	"encoding/hex"
+
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+)
+
+type BridgingRequestValidationResponse struct {
+	IsValid bool   `json:"isValid"`
+	Reason  string `json:"reason"`
+}
+
+func NewBridgingRequestValidationResponse(err error) *BridgingRequestValidationResponse {
+	if err == nil {
+		return &BridgingRequestValidationResponse{IsValid: true}
+	}
+
+	return &BridgingRequestValidationResponse{Reason: err.Error()}
+}
+
+type CardanoBridgingTxResponse struct {
+	TxRaw       string `json:"txRaw"`
+	TxHash      string `json:"txHash"`
+	BridgingFee uint64 `json:"bridgingFee"`
+}
+
+func NewCardanoBridgingTxResponse(tx *core.CardanoBridgingTx) *CardanoBridgingTxResponse {
+	return &CardanoBridgingTxResponse{
+		TxRaw:       hex.EncodeToString(tx.TxRaw),
+		TxHash:      tx.TxHash,
+		BridgingFee: tx.BridgingFee,
+	}
+}
+
+type EvmBridgingTxResponse struct {
+	To    string `json:"to"`
+	Data  string `json:"data"`
+	Value string `json:"value"`
+}
+
+func NewEvmBridgingTxResponse(tx *core.EvmBridgingTx) *EvmBridgingTxResponse {
+	return &EvmBridgingTxResponse{
+		To:    tx.To,
+		Data:  "0x" + hex.EncodeToString(tx.Data),
+		Value: tx.Value.String(),
+	}
+}
+
+type BridgingQuoteReceiverResponse struct {
+	Address           string `json:"address"`
+	Amount            string `json:"amount"`
+	DestinationAmount string `json:"destinationAmount"`
+	IsAboveMinimum    bool   `json:"isAboveMinimum"`
+}
+
+type BridgingQuoteResponse struct {
+	IsDirectionAllowed       bool                             `json:"isDirectionAllowed"`
+	MinBridgingFee           string                           `json:"minBridgingFee"`
+	MinReceiverAmount        string                           `json:"minReceiverAmount"`
+	MaxAmountAllowedToBridge string                           `json:"maxAmountAllowedToBridge"`
+	FeeAddrBridgingAmount    string                           `json:"feeAddrBridgingAmount"`
+	TotalAmount              string                           `json:"totalAmount"`
+	Receivers                []*BridgingQuoteReceiverResponse `json:"receivers"`
+}
+
+func NewBridgingQuoteResponse(quote *core.BridgingQuote) *BridgingQuoteResponse {
+	receivers := make([]*BridgingQuoteReceiverResponse, len(quote.Receivers))
+
+	for i, x := range quote.Receivers {
+		receivers[i] = &BridgingQuoteReceiverResponse{
+			Address:           x.Address,
+			Amount:            x.Amount.String(),
+			DestinationAmount: x.DestinationAmount.String(),
+			IsAboveMinimum:    x.IsAboveMinimum,
+		}
+	}
+
+	maxAmountAllowedToBridge := ""
+	if quote.MaxAmountAllowedToBridge != nil {
+		maxAmountAllowedToBridge = quote.MaxAmountAllowedToBridge.String()
+	}
+
+	return &BridgingQuoteResponse{
+		IsDirectionAllowed:       quote.IsDirectionAllowed,
+		MinBridgingFee:           quote.MinBridgingFee.String(),
+		MinReceiverAmount:        quote.MinReceiverAmount.String(),
+		MaxAmountAllowedToBridge: maxAmountAllowedToBridge,
+		FeeAddrBridgingAmount:    quote.FeeAddrBridgingAmount.String(),
+		TotalAmount:              quote.TotalAmount.String(),
+		Receivers:                receivers,
+	}
+}
@@ -1,7 +1,10 @@
 package response
 
 import (
+	"time"
+
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
 )
 
 type BridgingRequestStateResponse struct {
@@ -11,6 +14,16 @@ type BridgingRequestStateResponse struct {
 	Status             common.BridgingRequestStatus `json:"status"`
 	DestinationTxHash  string                       `json:"destinationTxHash"`
 	IsRefund           bool                         `json:"isRefund"`
+	BatchID            uint64                       `json:"batchId"`
+	FailureReason      string                       `json:"failureReason"`
+	SenderAddr         string                       `json:"senderAddr"`
+	ReceiverAddrs      []string                     `json:"receiverAddrs"`
+	CreatedAt          time.Time                    `json:"createdAt"`
+	UpdatedAt          time.Time                    `json:"updatedAt"`
+	// set only for the requests held for a deeper confirmation because of their amount
+	RequiredConfirmations uint64 `json:"requiredConfirmations,omitempty"`
+	// set only for the requests with a blocked sender or receiver address
+	ScreeningDecision string `json:"screeningDecision,omitempty"`
 }
 
 func NewBridgingRequestStateResponse(state *common.BridgingRequestState) *BridgingRequestStateResponse {
@@ -21,5 +34,130 @@ func NewBridgingRequestStateResponse(state *common.BridgingRequestState) *Bridgi
 		DestinationTxHash:  state.DestinationTxHash.String(),
 		Status:             state.Status,
 		IsRefund:           state.IsRefund,
+		BatchID:            state.BatchID,
+		FailureReason:      state.FailureReason,
+		SenderAddr:         state.SenderAddr,
+		ReceiverAddrs:      state.ReceiverAddrs,
+		CreatedAt:          state.CreatedAt,
+		UpdatedAt:          state.UpdatedAt,
+
+		RequiredConfirmations: state.RequiredConfirmations,
+		ScreeningDecision:     state.ScreeningDecision,
+	}
+}
+
+type BridgingRequestStateListResponse struct {
+	Items      []*BridgingRequestStateResponse `json:"items"`
+	NextCursor string                          `json:"nextCursor"`
+}
+
+func NewBridgingRequestStateListResponse(
+	states []*common.BridgingRequestState, nextCursor string,
+) *BridgingRequestStateListResponse {
+	items := make([]*BridgingRequestStateResponse, len(states))
+
+	for i, state := range states {
+		items[i] = NewBridgingRequestStateResponse(state)
+	}
+
+	return &BridgingRequestStateListResponse{
+		Items:      items,
+		NextCursor: nextCursor,
 	}
 }
+
+type BridgingRequestStateEventResponse struct {
+	*BridgingRequestStateResponse
+	StatusStr      string `json:"statusStr"`
+	PreviousStatus string `json:"previousStatus"`
+}
+
+func NewBridgingRequestStateEventResponse(
+	state *common.BridgingRequestState, previousStatus common.BridgingRequestStatus,
+) *BridgingRequestStateEventResponse {
+	previousStatusStr := ""
+	if previousStatus != "" {
+		previousStatusStr = common.BridgingRequestStateStatusStr(previousStatus, state.IsRefund)
+	}
+
+	return &BridgingRequestStateEventResponse{
+		BridgingRequestStateResponse: NewBridgingRequestStateResponse(state),
+		StatusStr:                    state.StatusStr(),
+		PreviousStatus:               previousStatusStr,
+	}
+}
+
+type BridgingRequestStateTransitionResponse struct {
+	Status             string    `json:"status"`
+	DestinationChainID string    `json:"destinationChainId"`
+	DestinationTxHash  string    `json:"destinationTxHash"`
+	BatchID            uint64    `json:"batchId"`
+	FailureReason      string    `json:"failureReason"`
+	Timestamp          time.Time `json:"timestamp"`
+}
+
+type BridgingRequestStateHistoryResponse struct {
+	SourceChainID string                                    `json:"sourceChainId"`
+	SourceTxHash  string                                    `json:"sourceTxHash"`
+	Transitions   []*BridgingRequestStateTransitionResponse `json:"transitions"`
+}
+
+func NewBridgingRequestStateHistoryResponse(
+	sourceChainID string, sourceTxHash common.Hash, history []*common.BridgingRequestStateTransition,
+) *BridgingRequestStateHistoryResponse {
+	transitions := make([]*BridgingRequestStateTransitionResponse, len(history))
+
+	for i, x := range history {
+		transitions[i] = &BridgingRequestStateTransitionResponse{
+			Status:             x.StatusStr(),
+			DestinationChainID: x.DestinationChainID,
+			DestinationTxHash:  x.DestinationTxHash.String(),
+			BatchID:            x.BatchID,
+			FailureReason:      x.FailureReason,
+			Timestamp:          x.Timestamp,
+		}
+	}
+
+	return &BridgingRequestStateHistoryResponse{
+		SourceChainID: sourceChainID,
+		SourceTxHash:  sourceTxHash.String(),
+		Transitions:   transitions,
+	}
+}
+
+type BridgingRequestStageEstimateResponse struct {
+	Status                   string  `json:"status"`
+	EstimatedDurationSeconds float64 `json:"estimatedDurationSeconds"`
+	Samples                  int     `json:"samples"`
+}
+
+type BridgingRequestETAResponse struct {
+	*BridgingRequestStateResponse
+	IsFinal                   bool                                    `json:"isFinal"`
+	EstimatedRemainingSeconds float64                                 `json:"estimatedRemainingSeconds"`
+	EstimatedCompletionAt     *time.Time                              `json:"estimatedCompletionAt,omitempty"`
+	Stages                    []*BridgingRequestStageEstimateResponse `json:"stages"`
+}
+
+func NewBridgingRequestETAResponse(eta *core.BridgingRequestETA) *BridgingRequestETAResponse {
+	result := &BridgingRequestETAResponse{
+		BridgingRequestStateResponse: NewBridgingRequestStateResponse(eta.State),
+		IsFinal:                      eta.IsFinal,
+		EstimatedRemainingSeconds:    eta.Remaining.Seconds(),
+		Stages:                       make([]*BridgingRequestStageEstimateResponse, len(eta.Stages)),
+	}
+
+	if !eta.IsFinal {
+		result.EstimatedCompletionAt = &eta.EstimatedCompletionAt
+	}
+
+	for i, x := range eta.Stages {
+		result.Stages[i] = &BridgingRequestStageEstimateResponse{
+			Status:                   common.BridgingRequestStateStatusStr(x.Status, eta.State.IsRefund),
+			EstimatedDurationSeconds: x.Duration.Seconds(),
+			Samples:                  x.Samples,
+		}
+	}
+
+	return result
+}
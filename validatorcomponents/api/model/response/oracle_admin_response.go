@@ -0,0 +1,248 @@
+package response
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	oCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+)
+
+type OracleTxResponse struct {
+	ChainID          string      `json:"chainId"`
+	Hash             string      `json:"hash"`
+	Priority         uint8       `json:"priority"`
+	BlockNumber      uint64      `json:"blockNumber"`
+	SubmitTryCount   uint32      `json:"submitTryCount"`
+	BatchTryCount    uint32      `json:"batchTryCount"`
+	RefundTryCount   uint32      `json:"refundTryCount"`
+	LastTimeTried    time.Time   `json:"lastTimeTried"`
+	TryTimes         []time.Time `json:"tryTimes,omitempty"`
+	TTL              uint64      `json:"ttl,omitempty"`
+	IsInvalid        bool        `json:"isInvalid"`
+	IsProcessed      bool        `json:"isProcessed"`
+	LastError        string      `json:"lastError,omitempty"`
+	DeadLetterReason string      `json:"deadLetterReason,omitempty"`
+	Metadata         any         `json:"metadata"`
+	MetadataError    string      `json:"metadataError,omitempty"`
+}
+
+func NewOracleTxResponse(tx *core.OracleTx) *OracleTxResponse {
+	return &OracleTxResponse{
+		ChainID:          tx.ChainID,
+		Hash:             tx.Hash,
+		Priority:         tx.Priority,
+		BlockNumber:      tx.BlockNumber,
+		SubmitTryCount:   tx.SubmitTryCount,
+		BatchTryCount:    tx.BatchTryCount,
+		RefundTryCount:   tx.RefundTryCount,
+		LastTimeTried:    tx.LastTimeTried,
+		TryTimes:         tx.TryTimes,
+		TTL:              tx.TTL,
+		IsInvalid:        tx.IsInvalid,
+		IsProcessed:      tx.IsProcessed,
+		LastError:        tx.LastError,
+		DeadLetterReason: tx.DeadLetterReason,
+		Metadata:         tx.Metadata,
+		MetadataError:    tx.MetadataError,
+	}
+}
+
+type OracleTxsResponse struct {
+	ChainID string               `json:"chainId"`
+	Bucket  core.OracleTxsBucket `json:"bucket"`
+	Txs     []*OracleTxResponse  `json:"txs"`
+}
+
+func NewOracleTxsResponse(
+	chainID string, bucket core.OracleTxsBucket, txs []*core.OracleTx,
+) *OracleTxsResponse {
+	txsResponse := make([]*OracleTxResponse, len(txs))
+
+	for i, tx := range txs {
+		txsResponse[i] = NewOracleTxResponse(tx)
+	}
+
+	return &OracleTxsResponse{
+		ChainID: chainID,
+		Bucket:  bucket,
+		Txs:     txsResponse,
+	}
+}
+
+type OracleBatchEventTxResponse struct {
+	SourceChainID           string `json:"sourceChainId"`
+	ObservedTransactionHash string `json:"observedTransactionHash"`
+	TransactionType         uint8  `json:"transactionType"`
+}
+
+type OracleBatchEventResponse struct {
+	BatchID            uint64                        `json:"batchId"`
+	DestinationChainID string                        `json:"destinationChainId"`
+	DestinationTxHash  string                        `json:"destinationTxHash"`
+	IsFailedClaim      bool                          `json:"isFailedClaim"`
+	Txs                []*OracleBatchEventTxResponse `json:"txs"`
+}
+
+func NewOracleBatchEventsResponse(events []*oCore.DBBatchInfoEvent) []*OracleBatchEventResponse {
+	result := make([]*OracleBatchEventResponse, len(events))
+
+	for i, evt := range events {
+		txs := make([]*OracleBatchEventTxResponse, len(evt.TxHashes))
+
+		for j, tx := range evt.TxHashes {
+			txs[j] = &OracleBatchEventTxResponse{
+				SourceChainID:           common.ToStrChainID(tx.SourceChainID),
+				ObservedTransactionHash: tx.ObservedTransactionHash.String(),
+				TransactionType:         tx.TransactionType,
+			}
+		}
+
+		result[i] = &OracleBatchEventResponse{
+			BatchID:            evt.BatchID,
+			DestinationChainID: common.ToStrChainID(evt.DstChainID),
+			DestinationTxHash:  evt.DstTxHash.String(),
+			IsFailedClaim:      evt.IsFailedClaim,
+			Txs:                txs,
+		}
+	}
+
+	return result
+}
+
+type OutflowUsageItemResponse struct {
+	Used string `json:"used"`
+	// empty if the usage is not limited
+	Limit string `json:"limit,omitempty"`
+}
+
+type OutflowUsageResponse struct {
+	WindowMilis         uint64                               `json:"windowMs"`
+	Policy              oCore.OutflowLimitPolicy             `json:"policy"`
+	PerSourceChain      map[string]*OutflowUsageItemResponse `json:"perSourceChain"`
+	PerDestinationChain map[string]*OutflowUsageItemResponse `json:"perDestinationChain"`
+	PerSender           map[string]*OutflowUsageItemResponse `json:"perSender"`
+}
+
+func NewOutflowUsageResponse(usage *core.OutflowUsage) *OutflowUsageResponse {
+	return &OutflowUsageResponse{
+		WindowMilis:         usage.Limits.WindowMilis,
+		Policy:              usage.Limits.Policy,
+		PerSourceChain:      newOutflowUsageItemsResponse(usage.PerSourceChain, usage.Limits.PerSourceChain, nil),
+		PerDestinationChain: newOutflowUsageItemsResponse(usage.PerDestinationChain, usage.Limits.PerDestinationChain, nil),
+		PerSender:           newOutflowUsageItemsResponse(usage.PerSender, nil, usage.Limits.PerSender),
+	}
+}
+
+// newOutflowUsageItemsResponse returns an item for every used or limited key.
+// defaultLimit is used for the keys without their own limit
+func newOutflowUsageItemsResponse(
+	used map[string]*big.Int, limits map[string]*big.Int, defaultLimit *big.Int,
+) map[string]*OutflowUsageItemResponse {
+	result := make(map[string]*OutflowUsageItemResponse, len(used))
+
+	for key, limit := range limits {
+		if limit != nil && limit.Sign() > 0 {
+			result[key] = &OutflowUsageItemResponse{Used: "0", Limit: limit.String()}
+		}
+	}
+
+	for key, amount := range used {
+		item, exists := result[key]
+		if !exists {
+			item = &OutflowUsageItemResponse{}
+			result[key] = item
+
+			if defaultLimit != nil && defaultLimit.Sign() > 0 {
+				item.Limit = defaultLimit.String()
+			}
+		}
+
+		item.Used = amount.String()
+	}
+
+	return result
+}
+
+type ManualApprovalVoteResponse struct {
+	Operator   string    `json:"operator"`
+	ApprovedAt time.Time `json:"approvedAt"`
+}
+
+type ManualApprovalResponse struct {
+	SourceChainID      string                        `json:"sourceChainId"`
+	TxHash             string                        `json:"txHash"`
+	DestinationChainID string                        `json:"destinationChainId"`
+	Amount             string                        `json:"amount"`
+	HeldAtBlock        uint64                        `json:"heldAtBlock"`
+	DeadlineBlock      uint64                        `json:"deadlineBlock"`
+	IsExpired          bool                          `json:"isExpired"`
+	Approvals          []*ManualApprovalVoteResponse `json:"approvals"`
+}
+
+func NewManualApprovalResponse(approval *oCore.ManualApproval) *ManualApprovalResponse {
+	approvals := make([]*ManualApprovalVoteResponse, len(approval.Approvals))
+
+	for i, x := range approval.Approvals {
+		approvals[i] = &ManualApprovalVoteResponse{
+			Operator:   x.Operator,
+			ApprovedAt: x.ApprovedAt,
+		}
+	}
+
+	return &ManualApprovalResponse{
+		SourceChainID:      approval.SourceChainID,
+		TxHash:             approval.TxHash.String(),
+		DestinationChainID: approval.DestinationChainID,
+		Amount:             approval.Amount.String(),
+		HeldAtBlock:        approval.HeldAtBlock,
+		DeadlineBlock:      approval.DeadlineBlock,
+		IsExpired:          approval.IsExpired,
+		Approvals:          approvals,
+	}
+}
+
+func NewManualApprovalsResponse(approvals []*oCore.ManualApproval) []*ManualApprovalResponse {
+	result := make([]*ManualApprovalResponse, len(approvals))
+
+	for i, approval := range approvals {
+		result[i] = NewManualApprovalResponse(approval)
+	}
+
+	return result
+}
+
+type AdminAuditEntryResponse struct {
+	ID         uint64    `json:"id"`
+	Operator   string    `json:"operator"`
+	Role       string    `json:"role"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Query      string    `json:"query,omitempty"`
+	Body       string    `json:"body,omitempty"`
+	RemoteAddr string    `json:"remoteAddr"`
+	StatusCode int       `json:"statusCode"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func NewAdminAuditLogResponse(entries []*core.AdminAuditEntry) []*AdminAuditEntryResponse {
+	result := make([]*AdminAuditEntryResponse, len(entries))
+
+	for i, x := range entries {
+		result[i] = &AdminAuditEntryResponse{
+			ID:         x.ID,
+			Operator:   x.Operator,
+			Role:       string(x.Role),
+			Method:     x.Method,
+			Path:       x.Path,
+			Query:      x.Query,
+			Body:       x.Body,
+			RemoteAddr: x.RemoteAddr,
+			StatusCode: x.StatusCode,
+			CreatedAt:  x.CreatedAt,
+		}
+	}
+
+	return result
+}
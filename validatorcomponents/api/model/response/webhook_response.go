@@ -0,0 +1,50 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+)
+
+type WebhookDeliveryResponse struct {
+	ID               uint64                     `json:"id"`
+	WebhookName      string                     `json:"webhookName"`
+	SourceChainID    string                     `json:"sourceChainId"`
+	SourceTxHash     string                     `json:"sourceTxHash"`
+	Status           core.WebhookDeliveryStatus `json:"status"`
+	Attempts         uint32                     `json:"attempts"`
+	LastError        string                     `json:"lastError"`
+	LastResponseCode int                        `json:"lastResponseCode"`
+	NextAttemptAt    time.Time                  `json:"nextAttemptAt"`
+	CreatedAt        time.Time                  `json:"createdAt"`
+	UpdatedAt        time.Time                  `json:"updatedAt"`
+	Payload          json.RawMessage            `json:"payload"`
+}
+
+func NewWebhookDeliveryResponse(delivery *core.WebhookDelivery) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:               delivery.ID,
+		WebhookName:      delivery.WebhookName,
+		SourceChainID:    delivery.SourceChainID,
+		SourceTxHash:     delivery.SourceTxHash.String(),
+		Status:           delivery.Status,
+		Attempts:         delivery.Attempts,
+		LastError:        delivery.LastError,
+		LastResponseCode: delivery.LastResponseCode,
+		NextAttemptAt:    delivery.NextAttemptAt,
+		CreatedAt:        delivery.CreatedAt,
+		UpdatedAt:        delivery.UpdatedAt,
+		Payload:          delivery.Payload,
+	}
+}
+
+func NewWebhookDeliveriesResponse(deliveries []*core.WebhookDelivery) []*WebhookDeliveryResponse {
+	result := make([]*WebhookDeliveryResponse, len(deliveries))
+
+	for i, x := range deliveries {
+		result[i] = NewWebhookDeliveryResponse(x)
+	}
+
+	return result
+}
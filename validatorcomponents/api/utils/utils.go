@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,6 +35,22 @@ func WriteUnauthorizedResponse(w http.ResponseWriter, r *http.Request, logger hc
 	WriteErrorResponse(w, r, http.StatusUnauthorized, errors.New("Unauthorized"), logger)
 }
 
+// WriteServerSentEvent writes a single server-sent event with json encoded data and flushes it to the client
+func WriteServerSentEvent(w http.ResponseWriter, flusher http.Flusher, event string, data any) error {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, bytes); err != nil {
+		return err
+	}
+
+	flusher.Flush()
+
+	return nil
+}
+
 func DecodeModel[T any](w http.ResponseWriter, r *http.Request, logger hclog.Logger) (T, bool) {
 	var requestBody T
 
@@ -82,3 +99,17 @@ func NewAPILogger(appConfig *core.AppConfig) (hclog.Logger, error) {
 
 	return apiLogger, nil
 }
+
+type adminOperatorContextKey struct{}
+
+// WithAdminOperator returns the context with the authenticated admin api key operator
+func WithAdminOperator(ctx context.Context, operator string) context.Context {
+	return context.WithValue(ctx, adminOperatorContextKey{}, operator)
+}
+
+// GetAdminOperator returns the operator authenticated by the admin api key of the request
+func GetAdminOperator(r *http.Request) string {
+	operator, _ := r.Context().Value(adminOperatorContextKey{}).(string)
+
+	return operator
+}
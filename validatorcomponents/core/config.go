@@ -1,6 +1,9 @@
 package core
 
 import (
+	"slices"
+	"time"
+
 	batcherCore "github.com/Ethernal-Tech/apex-bridge/batcher/core"
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
@@ -16,6 +19,62 @@ type APIConfig struct {
 	AllowedMethods []string `json:"allowedMethods"`
 	APIKeyHeader   string   `json:"apiKeyHeader"`
 	APIKeys        []string `json:"apiKeys"`
+	// AdminAPIKeys are the only keys accepted by the admin endpoints. They are sent in the APIKeyHeader too
+	AdminAPIKeys []AdminAPIKey `json:"adminApiKeys"`
+}
+
+type AdminRole string
+
+const (
+	// AdminRoleOperator can inspect the oracle txs and execute the recovery actions
+	AdminRoleOperator AdminRole = "operator"
+	// AdminRoleAdmin can do everything an operator can, and also execute the actions which can not be undone
+	AdminRoleAdmin AdminRole = "admin"
+)
+
+// IsAllowed returns true if the role can access endpoints which require the given role
+func (r AdminRole) IsAllowed(required AdminRole) bool {
+	return r == AdminRoleAdmin || r == required
+}
+
+// AdminAPIKey is the key of a single operator. The operator name is written to the admin audit log
+type AdminAPIKey struct {
+	Operator string    `json:"operator"`
+	Key      string    `json:"key"`
+	Role     AdminRole `json:"role"`
+}
+
+type WebhookEndpointConfig struct {
+	Name           string                         `json:"name"`
+	URL            string                         `json:"url"`
+	Secret         string                         `json:"secret"`
+	SourceChainIDs []string                       `json:"sourceChainIds"`
+	Statuses       []common.BridgingRequestStatus `json:"statuses"`
+}
+
+// IsMatch returns true if the endpoint should be notified about the state.
+// Empty source chains or statuses match everything
+func (c *WebhookEndpointConfig) IsMatch(state *common.BridgingRequestState) bool {
+	return (len(c.SourceChainIDs) == 0 || slices.Contains(c.SourceChainIDs, state.SourceChainID)) &&
+		(len(c.Statuses) == 0 || slices.Contains(c.Statuses, state.Status))
+}
+
+type WebhooksConfig struct {
+	Endpoints      []*WebhookEndpointConfig `json:"endpoints"`
+	PullTimeMilis  uint64                   `json:"pullTime"`
+	RequestTimeout time.Duration            `json:"requestTimeout"`
+	MaxAttempts    uint32                   `json:"maxAttempts"`
+	BaseRetryDelay time.Duration            `json:"baseRetryDelay"`
+	MaxRetryDelay  time.Duration            `json:"maxRetryDelay"`
+}
+
+type HealthConfig struct {
+	// MaxTickAge is the longest period a component can go without a successful iteration and still be healthy
+	MaxTickAge time.Duration `json:"maxTickAge"`
+	// MaxIndexerLag is the allowed distance between the indexer and the chain tip in slots or blocks
+	MaxIndexerLag map[string]uint64 `json:"maxIndexerLag"`
+	// ChainTipTimeout limits the time spent querying the chain tips. All chains are queried in parallel
+	ChainTipTimeout time.Duration `json:"chainTipTimeout"`
 }
 
 type AppConfig struct {
@@ -33,6 +92,33 @@ type AppConfig struct {
 	Telemetry                    telemetry.TelemetryConfig                 `json:"telemetry"`
 	RetryUnprocessedSettings     oracleCore.RetryUnprocessedSettings       `json:"retryUnprocessedSettings"`
 	TryCountLimits               oracleCore.TryCountLimits                 `json:"tryCountLimits"`
+	Webhooks                     WebhooksConfig                            `json:"webhooks"`
+	Health                       HealthConfig                              `json:"health"`
+}
+
+// RegisterChains adds every configured chain with an explicit numeric chain ID to the chain registry
+func (appConfig *AppConfig) RegisterChains() error {
+	for chainID, ccConfig := range appConfig.CardanoChains {
+		if ccConfig.ChainIDNum == 0 {
+			continue
+		}
+
+		if err := common.RegisterChain(chainID, ccConfig.ChainIDNum, common.ChainTypeCardano); err != nil {
+			return err
+		}
+	}
+
+	for chainID, ecConfig := range appConfig.EthChains {
+		if ecConfig.ChainIDNum == 0 {
+			continue
+		}
+
+		if err := common.RegisterChain(chainID, ecConfig.ChainIDNum, common.ChainTypeEVM); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (appConfig *AppConfig) SeparateConfigs() (
@@ -52,7 +138,6 @@ func (appConfig *AppConfig) SeparateConfigs() (
 			BlockfrostURL:         ccConfig.BlockfrostURL,
 			BlockfrostAPIKey:      ccConfig.BlockfrostAPIKey,
 			SocketPath:            ccConfig.SocketPath,
-			PotentialFee:          ccConfig.PotentialFee,
 			TTLSlotNumberInc:      ccConfig.TTLSlotNumberInc,
 			SlotRoundingThreshold: ccConfig.SlotRoundingThreshold,
 			NoBatchPeriodPercent:  ccConfig.NoBatchPeriodPercent,
@@ -61,6 +146,10 @@ func (appConfig *AppConfig) SeparateConfigs() (
 			MaxUtxoCount:          ccConfig.MaxUtxoCount,
 			MinFeeForBridging:     ccConfig.MinFeeForBridging,
 			TakeAtLeastUtxoCount:  ccConfig.TakeAtLeastUtxoCount,
+			NativeTokens:          appConfig.BridgingSettings.NativeTokens.GetCardanoTokenNames(ccConfig.ChainID),
+			UtxoSelectionStrategy: ccConfig.UtxoSelectionStrategy,
+			UtxoMaintenance:       ccConfig.UtxoMaintenance,
+			TxBuilderBackend:      ccConfig.TxBuilderBackend,
 		}).Serialize()
 
 		batcherChains = append(batcherChains, batcherCore.ChainConfig{
@@ -79,6 +168,9 @@ func (appConfig *AppConfig) SeparateConfigs() (
 			NoBatchPeriodPercent:   ecConfig.NoBatchPeriodPercent,
 			MinFeeForBridging:      ecConfig.MinFeeForBridging,
 			TestMode:               ecConfig.TestMode,
+			NodeURL:                ecConfig.NodeURL,
+			ConfirmationMode:       ecConfig.ConfirmationMode,
+			LegacyContractsABI:     ecConfig.LegacyContractsABI,
 		}).Serialize()
 
 		batcherChains = append(batcherChains, batcherCore.ChainConfig{
@@ -1,6 +1,14 @@
 package core
 
-import "net/http"
+import (
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	oracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+)
 
 type APIEndpointHandler = func(w http.ResponseWriter, r *http.Request)
 
@@ -9,4 +17,318 @@ type APIEndpoint struct {
 	Method     string
 	Handler    APIEndpointHandler
 	APIKeyAuth bool
+	// AdminRole, if set, makes the endpoint accessible only with an admin api key of that role.
+	// Every call of such an endpoint is written to the admin audit log
+	AdminRole AdminRole
+}
+
+type BridgingRequestStateEvent struct {
+	State             common.BridgingRequestState
+	PreviousStatus    common.BridgingRequestStatus
+	PreviousUpdatedAt time.Time
+}
+
+// BridgingRequestStateEventFilter matches either all the events of a source chain
+// or only the events for the given source transaction hashes
+type BridgingRequestStateEventFilter struct {
+	SourceChainID  string
+	SourceTxHashes []common.Hash
+}
+
+func (f BridgingRequestStateEventFilter) IsMatch(event *BridgingRequestStateEvent) bool {
+	if f.SourceChainID != event.State.SourceChainID {
+		return false
+	}
+
+	if len(f.SourceTxHashes) == 0 {
+		return true
+	}
+
+	for _, txHash := range f.SourceTxHashes {
+		if txHash == event.State.SourceTxHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BridgingRequestStateFilter is used for listing bridging request states.
+// Empty fields are ignored, addresses are compared case insensitive and the time range is inclusive
+type BridgingRequestStateFilter struct {
+	SourceChainID      string
+	DestinationChainID string
+	SenderAddr         string
+	ReceiverAddr       string
+	Status             common.BridgingRequestStatus
+	CreatedFrom        time.Time
+	CreatedTo          time.Time
+}
+
+func (f BridgingRequestStateFilter) IsMatch(state *common.BridgingRequestState) bool {
+	if f.SourceChainID != "" && f.SourceChainID != state.SourceChainID {
+		return false
+	}
+
+	if f.DestinationChainID != "" && f.DestinationChainID != state.DestinationChainID {
+		return false
+	}
+
+	if f.SenderAddr != "" && !strings.EqualFold(f.SenderAddr, state.SenderAddr) {
+		return false
+	}
+
+	if f.Status != "" && f.Status != state.Status {
+		return false
+	}
+
+	if !f.CreatedFrom.IsZero() && state.CreatedAt.Before(f.CreatedFrom) {
+		return false
+	}
+
+	if !f.CreatedTo.IsZero() && state.CreatedAt.After(f.CreatedTo) {
+		return false
+	}
+
+	if f.ReceiverAddr == "" {
+		return true
+	}
+
+	for _, addr := range state.ReceiverAddrs {
+		if strings.EqualFold(f.ReceiverAddr, addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "Pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "Delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "Failed"
+)
+
+// WebhookDelivery is a single notification of a bridging request state change to a webhook endpoint
+type WebhookDelivery struct {
+	ID               uint64
+	WebhookName      string
+	SourceChainID    string
+	SourceTxHash     common.Hash
+	Payload          []byte
+	Status           WebhookDeliveryStatus
+	Attempts         uint32
+	LastError        string
+	LastResponseCode int
+	NextAttemptAt    time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// AdminAuditEntry is a single call of an admin endpoint
+type AdminAuditEntry struct {
+	ID         uint64
+	Operator   string
+	Role       AdminRole
+	Method     string
+	Path       string
+	Query      string
+	Body       string
+	RemoteAddr string
+	StatusCode int
+	CreatedAt  time.Time
+}
+
+type ComponentHealth struct {
+	Name        string    `json:"name"`
+	IsHealthy   bool      `json:"isHealthy"`
+	LastTick    time.Time `json:"lastTick"`
+	LastError   string    `json:"lastError,omitempty"`
+	LastErrorAt time.Time `json:"lastErrorAt,omitempty"`
+}
+
+type HealthReport struct {
+	IsHealthy  bool               `json:"isHealthy"`
+	Components []*ComponentHealth `json:"components"`
+}
+
+type DatabaseReadiness struct {
+	Name    string `json:"name"`
+	IsReady bool   `json:"isReady"`
+	Error   string `json:"error,omitempty"`
+}
+
+type ChainReadiness struct {
+	ChainID         string `json:"chainId"`
+	IsReady         bool   `json:"isReady"`
+	IndexerPosition uint64 `json:"indexerPosition"`
+	ChainTip        uint64 `json:"chainTip"`
+	IndexerLag      uint64 `json:"indexerLag"`
+	IsBridgeSynced  bool   `json:"isBridgeSynced"`
+	BridgeSyncKnown bool   `json:"bridgeSyncKnown"`
+	Error           string `json:"error,omitempty"`
+}
+
+type ReadinessReport struct {
+	IsReady   bool                 `json:"isReady"`
+	Databases []*DatabaseReadiness `json:"databases"`
+	Chains    []*ChainReadiness    `json:"chains"`
+}
+
+// BridgingRequestValidationToken name is the policy id and the hex encoded asset name separated by a dot
+type BridgingRequestValidationToken struct {
+	Name   string
+	Amount uint64
+}
+
+type BridgingRequestValidationOutput struct {
+	Address string
+	Amount  uint64
+	Tokens  []BridgingRequestValidationToken
+}
+
+// BridgingRequestValidationRequest describes a bridging request that is not submitted yet.
+// Outputs and InputAddrs are used for cardano source chains and Value (in wei) for evm source chains.
+// InputAddrs are the addresses the tx inputs are spent from, they are screened as the oracle does
+type BridgingRequestValidationRequest struct {
+	SourceChainID string
+	Metadata      []byte
+	InputAddrs    []string
+	Outputs       []BridgingRequestValidationOutput
+	Value         *big.Int
+}
+
+type BridgingTxReceiver struct {
+	Address string
+	Amount  *big.Int
+}
+
+type BridgingTxInput struct {
+	Hash  string
+	Index uint32
+}
+
+// CardanoBridgingTxRequest amounts are in lovelace. If SenderUtxos is empty, any utxo of the sender can be spent
+type CardanoBridgingTxRequest struct {
+	SourceChainID      string
+	DestinationChainID string
+	SenderAddr         string
+	SenderUtxos        []BridgingTxInput
+	Receivers          []BridgingTxReceiver
+	BridgingFee        uint64
+}
+
+type CardanoBridgingTx struct {
+	TxRaw       []byte
+	TxHash      string
+	BridgingFee uint64
+}
+
+// EvmBridgingTxRequest amounts are in wei
+type EvmBridgingTxRequest struct {
+	SourceChainID      string
+	DestinationChainID string
+	Receivers          []BridgingTxReceiver
+	FeeAmount          *big.Int
+}
+
+type EvmBridgingTx struct {
+	To    string
+	Data  []byte
+	Value *big.Int
+}
+
+// BridgingQuoteRequest receivers amounts are in the source chain denomination (lovelace or wei)
+type BridgingQuoteRequest struct {
+	SourceChainID      string
+	DestinationChainID string
+	Receivers          []BridgingTxReceiver
+}
+
+type BridgingQuoteReceiver struct {
+	Address           string
+	Amount            *big.Int
+	DestinationAmount *big.Int
+	IsAboveMinimum    bool
+}
+
+// BridgingQuote amounts are in the source chain denomination,
+// except FeeAddrBridgingAmount and receivers DestinationAmount which are in the destination chain denomination
+type BridgingQuote struct {
+	IsDirectionAllowed       bool
+	MinBridgingFee           *big.Int
+	MinReceiverAmount        *big.Int
+	MaxAmountAllowedToBridge *big.Int
+	FeeAddrBridgingAmount    *big.Int
+	// TotalAmount is the sum of the receivers amounts and MinBridgingFee
+	TotalAmount *big.Int
+	Receivers   []*BridgingQuoteReceiver
+}
+
+// BridgingRequestStageEstimate is the estimated duration of reaching Status from the previous status.
+// Samples is zero when there is no statistics for the direction and the estimate is based on the pull intervals
+type BridgingRequestStageEstimate struct {
+	Status   common.BridgingRequestStatus
+	Duration time.Duration
+	Samples  int
+}
+
+type BridgingRequestETA struct {
+	State                 *common.BridgingRequestState
+	IsFinal               bool
+	Remaining             time.Duration
+	EstimatedCompletionAt time.Time
+	Stages                []*BridgingRequestStageEstimate
+}
+
+type OracleTxsBucket string
+
+const (
+	OracleTxsBucketUnprocessed OracleTxsBucket = "unprocessed"
+	OracleTxsBucketPending     OracleTxsBucket = "pending"
+	OracleTxsBucketProcessed   OracleTxsBucket = "processed"
+	OracleTxsBucketExpected    OracleTxsBucket = "expected"
+	OracleTxsBucketDeadLetter  OracleTxsBucket = "deadLetter"
+)
+
+// OracleTx is an entry of one of the oracle database buckets.
+// Try counts and LastTimeTried are set only for unprocessed, pending and dead-letter txs and TTL only for expected txs.
+// LastError is the error of the last failed try and DeadLetterReason is set only for dead-letter txs.
+// BlockNumber is the block slot for cardano chains.
+// Metadata is nil if it could not be decoded
+type OracleTx struct {
+	ChainID          string
+	Hash             string
+	Priority         uint8
+	BlockNumber      uint64
+	SubmitTryCount   uint32
+	BatchTryCount    uint32
+	RefundTryCount   uint32
+	LastTimeTried    time.Time
+	TryTimes         []time.Time
+	TTL              uint64
+	IsInvalid        bool
+	IsProcessed      bool
+	LastError        string
+	DeadLetterReason string
+	Metadata         any
+	MetadataError    string
+}
+
+// OracleTxAdminAction identifies a stuck oracle tx and who changes it and why, for the audit log
+type OracleTxAdminAction struct {
+	ChainID  string
+	TxHash   common.Hash
+	Operator string
+	Reason   string
+	// RemoteAddr is the address the action was requested from
+	RemoteAddr string
+}
+
+// OutflowUsage are the amounts bridged within the window and the outflow limits they are counted in
+type OutflowUsage struct {
+	*oracleCore.OutflowUsage
+	Limits oracleCore.OutflowLimitsSettings
 }
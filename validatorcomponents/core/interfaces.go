@@ -1,18 +1,51 @@
 package core
 
 import (
+	"context"
+	"time"
+
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	oracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 )
 
 type BridgingRequestStateDB interface {
 	AddBridgingRequestState(state *common.BridgingRequestState) error
 	UpdateBridgingRequestState(state *common.BridgingRequestState) error
 	GetBridgingRequestState(sourceChainID string, sourceTxHash common.Hash) (*common.BridgingRequestState, error)
+	GetBridgingRequestStateHistory(
+		sourceChainID string, sourceTxHash common.Hash,
+	) ([]*common.BridgingRequestStateTransition, error)
+	// ListBridgingRequestStates returns up to limit states matching the filter, newest first.
+	// Returned cursor is nil if there are no more states, otherwise it should be passed to get the next page
+	ListBridgingRequestStates(
+		filter BridgingRequestStateFilter, cursor []byte, limit int,
+	) ([]*common.BridgingRequestState, []byte, error)
+}
+
+type WebhookDeliveryDB interface {
+	// AddWebhookDelivery stores a new delivery and sets its ID
+	AddWebhookDelivery(delivery *WebhookDelivery) error
+	UpdateWebhookDelivery(delivery *WebhookDelivery) error
+	GetWebhookDelivery(id uint64) (*WebhookDelivery, error)
+	GetWebhookDeliveries(sourceChainID string, sourceTxHash common.Hash) ([]*WebhookDelivery, error)
+	// GetPendingWebhookDeliveries returns up to limit pending deliveries with next attempt not after the given time
+	GetPendingWebhookDeliveries(dueTime time.Time, limit int) ([]*WebhookDelivery, error)
+}
+
+type AdminAuditDB interface {
+	// AddAdminAuditEntry stores a new entry and sets its ID
+	AddAdminAuditEntry(entry *AdminAuditEntry) error
+	// GetAdminAuditEntries returns up to limit latest entries, the newest first
+	GetAdminAuditEntries(limit int) ([]*AdminAuditEntry, error)
 }
 
 type Database interface {
 	BridgingRequestStateDB
+	WebhookDeliveryDB
+	AdminAuditDB
 	Init(filePath string) error
+	// Ping returns an error if the database can not be used
+	Ping() error
 	Close() error
 }
 
@@ -31,6 +64,86 @@ type BridgingRequestStateManager interface {
 
 	Get(sourceChainID string, sourceTxHash common.Hash) (*common.BridgingRequestState, error)
 	GetMultiple(sourceChainID string, sourceTxHashes []common.Hash) ([]*common.BridgingRequestState, error)
+	GetHistory(sourceChainID string, sourceTxHash common.Hash) ([]*common.BridgingRequestStateTransition, error)
+	List(filter BridgingRequestStateFilter, cursor string, limit int) ([]*common.BridgingRequestState, string, error)
+	// Requeued sets the state back to DiscoveredOnSource after an operator requeued the tx.
+	// Unlike the other transitions, it is allowed from any status except ExecutedOnDestination
+	Requeued(key common.BridgingRequestStateKey, reason string) error
+	// ForcedInvalid sets the state to InvalidRequest after an operator invalidated the tx.
+	// Unlike Invalid, it is allowed from any status except ExecutedOnDestination
+	ForcedInvalid(key common.BridgingRequestStateKey, reason string) error
+}
+
+type BridgingRequestStateListener interface {
+	OnBridgingRequestStateChanged(event *BridgingRequestStateEvent)
+}
+
+type BridgingRequestStateSubscriber interface {
+	// Subscribe returns a channel with all the events matching the filter and a function for unsubscribing
+	Subscribe(filter BridgingRequestStateEventFilter) (<-chan *BridgingRequestStateEvent, func())
+}
+
+type WebhookDispatcher interface {
+	common.IStartable
+	BridgingRequestStateListener
+
+	GetDelivery(id uint64) (*WebhookDelivery, error)
+	GetDeliveries(sourceChainID string, sourceTxHash common.Hash) ([]*WebhookDelivery, error)
+}
+
+type HealthChecker interface {
+	// GetHealth reports whether all the components are alive
+	GetHealth() *HealthReport
+	// GetReadiness reports whether the databases, indexers and bridge are ready
+	GetReadiness(ctx context.Context) *ReadinessReport
+}
+
+type BridgingRequestValidator interface {
+	// Validate runs the oracle checks on a bridging request without submitting it.
+	// The returned error is the reason the request would be rejected
+	Validate(request *BridgingRequestValidationRequest) error
+}
+
+type BridgingTxBuilder interface {
+	// BuildCardanoTx returns an unsigned cardano bridging tx ready to be signed by the sender
+	BuildCardanoTx(ctx context.Context, request *CardanoBridgingTxRequest) (*CardanoBridgingTx, error)
+	// BuildEvmTx returns the unsigned gateway withdraw call
+	BuildEvmTx(request *EvmBridgingTxRequest) (*EvmBridgingTx, error)
+}
+
+type BridgingQuoter interface {
+	// Quote returns the minimal fee and amounts required for bridging in the given direction
+	Quote(ctx context.Context, request *BridgingQuoteRequest) (*BridgingQuote, error)
+}
+
+type BridgingRequestETAEstimator interface {
+	BridgingRequestStateListener
+
+	// GetETA returns nil if the bridging request state does not exist
+	GetETA(sourceChainID string, sourceTxHash common.Hash) (*BridgingRequestETA, error)
+}
+
+type OracleTxsInspector interface {
+	// GetTxs returns up to limit txs from the oracle database bucket of the chain, or all of them if limit is zero
+	GetTxs(chainID string, bucket OracleTxsBucket, limit int) ([]*OracleTx, error)
+	GetUnprocessedBatchEvents(chainID string) ([]*oracleCore.DBBatchInfoEvent, error)
+	// GetOutflowUsage returns the amounts bridged within the current outflow limits window
+	GetOutflowUsage() (*OutflowUsage, error)
+	// GetManualApprovals returns the bridging requests which are held until enough operators approve them
+	GetManualApprovals() ([]*oracleCore.ManualApproval, error)
+}
+
+type OracleTxsAdmin interface {
+	// Requeue moves an unprocessed or pending tx back to the unprocessed txs with a fresh set of tries
+	Requeue(action *OracleTxAdminAction) (*OracleTx, error)
+	// Invalidate moves an unprocessed or pending tx to the processed txs as invalid
+	Invalidate(action *OracleTxAdminAction) (*OracleTx, error)
+	// ForceRefund requeues an unprocessed or pending bridging request so it is handed over to the refund processor
+	ForceRefund(action *OracleTxAdminAction) (*OracleTx, error)
+	// ReplayDeadLetter moves a dead-letter tx back to the unprocessed txs with a fresh set of tries
+	ReplayDeadLetter(action *OracleTxAdminAction) (*OracleTx, error)
+	// Approve adds the approval of the operator to a bridging request held for manual approval
+	Approve(action *OracleTxAdminAction) (*oracleCore.ManualApproval, error)
 }
 
 type RelayerImitator interface {
@@ -14,7 +14,8 @@ type BBoltDatabase struct {
 }
 
 var (
-	bridgingRequestStatesBucket = []byte("BridgingRequestStates")
+	bridgingRequestStatesBucket       = []byte("BridgingRequestStates")
+	bridgingRequestStateHistoryBucket = []byte("BridgingRequestStateHistory")
 )
 
 var _ core.Database = (*BBoltDatabase)(nil)
@@ -28,13 +29,44 @@ func (bd *BBoltDatabase) Init(filePath string) error {
 	bd.db = db
 
 	return db.Update(func(tx *bbolt.Tx) error {
-		for _, bn := range [][]byte{bridgingRequestStatesBucket} {
+		for _, bn := range [][]byte{
+			bridgingRequestStatesBucket, bridgingRequestStateHistoryBucket, webhookDeliveriesBucket,
+			webhookPendingDeliveriesBucket, webhookDeliveriesByRequestBucket, adminAuditBucket,
+		} {
 			_, err := tx.CreateBucketIfNotExists(bn)
 			if err != nil {
 				return fmt.Errorf("could not bucket: %s, err: %w", string(bn), err)
 			}
 		}
 
+		shouldRebuildIndexes := false
+
+		for _, idx := range bridgingRequestStateIndexes {
+			if tx.Bucket(idx.bucket) != nil {
+				continue
+			}
+
+			if _, err := tx.CreateBucket(idx.bucket); err != nil {
+				return fmt.Errorf("could not bucket: %s, err: %w", string(idx.bucket), err)
+			}
+
+			shouldRebuildIndexes = true
+		}
+
+		if shouldRebuildIndexes {
+			return rebuildBridgingRequestStateIndexes(tx)
+		}
+
+		return nil
+	})
+}
+
+func (bd *BBoltDatabase) Ping() error {
+	return bd.db.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket(bridgingRequestStatesBucket) == nil {
+			return fmt.Errorf("bucket %s does not exist", string(bridgingRequestStatesBucket))
+		}
+
 		return nil
 	})
 }
@@ -59,17 +91,28 @@ func (bd *BBoltDatabase) AddBridgingRequestState(state *common.BridgingRequestSt
 			return fmt.Errorf("BridgingRequestState write error: %w", err)
 		}
 
-		return nil
+		if err = updateBridgingRequestStateIndexes(tx, nil, state); err != nil {
+			return err
+		}
+
+		return appendBridgingRequestStateHistory(tx, state)
 	})
 }
 
 // UpdateBridgingRequestState implements core.Database.
 func (bd *BBoltDatabase) UpdateBridgingRequestState(state *common.BridgingRequestState) error {
 	return bd.db.Update(func(tx *bbolt.Tx) error {
-		if len(tx.Bucket(bridgingRequestStatesBucket).Get(state.ToDBKey())) == 0 {
+		oldData := tx.Bucket(bridgingRequestStatesBucket).Get(state.ToDBKey())
+		if len(oldData) == 0 {
 			return fmt.Errorf("trying to update a BridgingRequestState that does not exist")
 		}
 
+		var oldState *common.BridgingRequestState
+
+		if err := json.Unmarshal(oldData, &oldState); err != nil {
+			return fmt.Errorf("could not unmarshal BridgingRequestState: %w", err)
+		}
+
 		bytes, err := json.Marshal(state)
 		if err != nil {
 			return fmt.Errorf("could not marshal BridgingRequestState: %w", err)
@@ -79,7 +122,16 @@ func (bd *BBoltDatabase) UpdateBridgingRequestState(state *common.BridgingReques
 			return fmt.Errorf("BridgingRequestState write error: %w", err)
 		}
 
-		return nil
+		if err = updateBridgingRequestStateIndexes(tx, oldState, state); err != nil {
+			return err
+		}
+
+		// the history keeps only the status transitions
+		if oldState.StatusStr() == state.StatusStr() {
+			return nil
+		}
+
+		return appendBridgingRequestStateHistory(tx, state)
 	})
 }
 
@@ -100,3 +152,61 @@ func (bd *BBoltDatabase) GetBridgingRequestState(
 
 	return result, err
 }
+
+// GetBridgingRequestStateHistory implements core.Database.
+func (bd *BBoltDatabase) GetBridgingRequestStateHistory(
+	sourceChainID string, sourceTxHash common.Hash,
+) (
+	result []*common.BridgingRequestStateTransition, err error,
+) {
+	err = bd.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(bridgingRequestStateHistoryBucket).Get(
+			common.ToBridgingRequestStateDBKey(sourceChainID, sourceTxHash))
+		if len(data) > 0 {
+			return json.Unmarshal(data, &result)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// ListBridgingRequestStates implements core.Database.
+func (bd *BBoltDatabase) ListBridgingRequestStates(
+	filter core.BridgingRequestStateFilter, cursor []byte, limit int,
+) (
+	result []*common.BridgingRequestState, nextCursor []byte, err error,
+) {
+	err = bd.db.View(func(tx *bbolt.Tx) (err error) {
+		result, nextCursor, err = listBridgingRequestStates(tx, filter, cursor, limit)
+
+		return err
+	})
+
+	return result, nextCursor, err
+}
+
+func appendBridgingRequestStateHistory(tx *bbolt.Tx, state *common.BridgingRequestState) error {
+	var (
+		history []*common.BridgingRequestStateTransition
+		bucket  = tx.Bucket(bridgingRequestStateHistoryBucket)
+	)
+
+	if data := bucket.Get(state.ToDBKey()); len(data) > 0 {
+		if err := json.Unmarshal(data, &history); err != nil {
+			return fmt.Errorf("could not unmarshal BridgingRequestState history: %w", err)
+		}
+	}
+
+	bytes, err := json.Marshal(append(history, state.ToTransition()))
+	if err != nil {
+		return fmt.Errorf("could not marshal BridgingRequestState history: %w", err)
+	}
+
+	if err = bucket.Put(state.ToDBKey(), bytes); err != nil {
+		return fmt.Errorf("BridgingRequestState history write error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package databaseaccess
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"go.etcd.io/bbolt"
+)
+
+// key: entry id (big endian), so the entries are ordered by the time they were added
+var adminAuditBucket = []byte("AdminAudit")
+
+// AddAdminAuditEntry implements core.Database.
+func (bd *BBoltDatabase) AddAdminAuditEntry(entry *core.AdminAuditEntry) error {
+	return bd.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(adminAuditBucket)
+
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return fmt.Errorf("could not get next AdminAuditEntry id: %w", err)
+		}
+
+		entry.ID = id
+
+		bytes, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("could not marshal AdminAuditEntry: %w", err)
+		}
+
+		if err = bucket.Put(binary.BigEndian.AppendUint64(nil, id), bytes); err != nil {
+			return fmt.Errorf("AdminAuditEntry write error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetAdminAuditEntries implements core.Database.
+func (bd *BBoltDatabase) GetAdminAuditEntries(limit int) (result []*core.AdminAuditEntry, err error) {
+	err = bd.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(adminAuditBucket).Cursor()
+
+		for k, v := cursor.Last(); k != nil && len(result) < limit; k, v = cursor.Prev() {
+			var entry *core.AdminAuditEntry
+
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return fmt.Errorf("could not unmarshal AdminAuditEntry: %w", err)
+			}
+
+			result = append(result, entry)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,253 @@
+package databaseaccess
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"go.etcd.io/bbolt"
+)
+
+// Every secondary index key has the form: value | 0x00 | createdAt (unix nano, big endian) | state db key
+// and the value of the index entry is the state db key. This way the entries for the same value
+// are ordered by creation time and a time range can be located with a single seek
+type bridgingRequestStateIndex struct {
+	id     byte
+	bucket []byte
+	values func(state *common.BridgingRequestState) []string
+}
+
+var (
+	bridgingRequestStatesBySenderBucket    = []byte("BridgingRequestStatesBySender")
+	bridgingRequestStatesByReceiverBucket  = []byte("BridgingRequestStatesByReceiver")
+	bridgingRequestStatesByDstChainBucket  = []byte("BridgingRequestStatesByDestinationChain")
+	bridgingRequestStatesByStatusBucket    = []byte("BridgingRequestStatesByStatus")
+	bridgingRequestStatesByCreatedAtBucket = []byte("BridgingRequestStatesByCreatedAt")
+
+	// indexes are ordered by their selectivity. The first one matching the filter is used for the scan
+	bridgingRequestStateIndexes = []*bridgingRequestStateIndex{
+		{
+			id:     1,
+			bucket: bridgingRequestStatesBySenderBucket,
+			values: func(state *common.BridgingRequestState) []string {
+				return nonEmptyValues(strings.ToLower(state.SenderAddr))
+			},
+		},
+		{
+			id:     2,
+			bucket: bridgingRequestStatesByReceiverBucket,
+			values: func(state *common.BridgingRequestState) []string {
+				values := make([]string, len(state.ReceiverAddrs))
+				for i, addr := range state.ReceiverAddrs {
+					values[i] = strings.ToLower(addr)
+				}
+
+				return nonEmptyValues(values...)
+			},
+		},
+		{
+			id:     3,
+			bucket: bridgingRequestStatesByDstChainBucket,
+			values: func(state *common.BridgingRequestState) []string {
+				return nonEmptyValues(state.DestinationChainID)
+			},
+		},
+		{
+			id:     4,
+			bucket: bridgingRequestStatesByStatusBucket,
+			values: func(state *common.BridgingRequestState) []string {
+				return nonEmptyValues(string(state.Status))
+			},
+		},
+		{
+			id:     5,
+			bucket: bridgingRequestStatesByCreatedAtBucket,
+			values: func(state *common.BridgingRequestState) []string {
+				return []string{""}
+			},
+		},
+	}
+)
+
+const indexValueSeparator = byte(0)
+
+func nonEmptyValues(values ...string) []string {
+	result := make([]string, 0, len(values))
+
+	for _, x := range values {
+		if x != "" {
+			result = append(result, x)
+		}
+	}
+
+	return result
+}
+
+func indexTime(t time.Time) uint64 {
+	if t.IsZero() || t.Before(time.Unix(0, 0)) {
+		return 0
+	}
+
+	return uint64(t.UnixNano()) //nolint:gosec
+}
+
+func indexPrefix(value string) []byte {
+	return append([]byte(value), indexValueSeparator)
+}
+
+func indexKey(value string, createdAt uint64, stateKey []byte) []byte {
+	key := indexPrefix(value)
+	key = binary.BigEndian.AppendUint64(key, createdAt)
+
+	return append(key, stateKey...)
+}
+
+func (idx *bridgingRequestStateIndex) keys(state *common.BridgingRequestState) [][]byte {
+	values := idx.values(state)
+	keys := make([][]byte, len(values))
+
+	for i, value := range values {
+		keys[i] = indexKey(value, indexTime(state.CreatedAt), state.ToDBKey())
+	}
+
+	return keys
+}
+
+// filterValue returns the index value for the filter and false if the index can not be used for the filter
+func (idx *bridgingRequestStateIndex) filterValue(filter core.BridgingRequestStateFilter) (string, bool) {
+	switch idx.id {
+	case 1:
+		return strings.ToLower(filter.SenderAddr), filter.SenderAddr != ""
+	case 2:
+		return strings.ToLower(filter.ReceiverAddr), filter.ReceiverAddr != ""
+	case 3:
+		return filter.DestinationChainID, filter.DestinationChainID != ""
+	case 4:
+		return string(filter.Status), filter.Status != ""
+	default:
+		return "", true
+	}
+}
+
+func selectBridgingRequestStateIndex(
+	filter core.BridgingRequestStateFilter,
+) (*bridgingRequestStateIndex, string) {
+	for _, idx := range bridgingRequestStateIndexes {
+		if value, ok := idx.filterValue(filter); ok {
+			return idx, value
+		}
+	}
+
+	// unreachable: the last index matches every filter
+	return bridgingRequestStateIndexes[len(bridgingRequestStateIndexes)-1], ""
+}
+
+func updateBridgingRequestStateIndexes(
+	tx *bbolt.Tx, oldState *common.BridgingRequestState, newState *common.BridgingRequestState,
+) error {
+	for _, idx := range bridgingRequestStateIndexes {
+		bucket := tx.Bucket(idx.bucket)
+
+		if oldState != nil {
+			for _, key := range idx.keys(oldState) {
+				if err := bucket.Delete(key); err != nil {
+					return fmt.Errorf("BridgingRequestState index %s delete error: %w", string(idx.bucket), err)
+				}
+			}
+		}
+
+		for _, key := range idx.keys(newState) {
+			if err := bucket.Put(key, newState.ToDBKey()); err != nil {
+				return fmt.Errorf("BridgingRequestState index %s write error: %w", string(idx.bucket), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rebuildBridgingRequestStateIndexes indexes all the existing states.
+// It is used when the indexes are created for a database which already contains states
+func rebuildBridgingRequestStateIndexes(tx *bbolt.Tx) error {
+	return tx.Bucket(bridgingRequestStatesBucket).ForEach(func(_, v []byte) error {
+		var state *common.BridgingRequestState
+
+		if err := json.Unmarshal(v, &state); err != nil {
+			return fmt.Errorf("could not unmarshal BridgingRequestState: %w", err)
+		}
+
+		return updateBridgingRequestStateIndexes(tx, nil, state)
+	})
+}
+
+func listBridgingRequestStates(
+	tx *bbolt.Tx, filter core.BridgingRequestStateFilter, cursor []byte, limit int,
+) ([]*common.BridgingRequestState, []byte, error) {
+	if limit <= 0 {
+		return nil, nil, fmt.Errorf("invalid limit: %d", limit)
+	}
+
+	idx, value := selectBridgingRequestStateIndex(filter)
+	prefix := indexPrefix(value)
+	lowerBound := binary.BigEndian.AppendUint64(bytes.Clone(prefix), indexTime(filter.CreatedFrom))
+
+	var upperBound []byte // exclusive
+
+	switch {
+	case len(cursor) > 0:
+		if cursor[0] != idx.id || !bytes.HasPrefix(cursor[1:], prefix) {
+			return nil, nil, errors.New("cursor does not match the filter")
+		}
+
+		upperBound = cursor[1:]
+	case !filter.CreatedTo.IsZero() && indexTime(filter.CreatedTo) < math.MaxUint64:
+		upperBound = binary.BigEndian.AppendUint64(bytes.Clone(prefix), indexTime(filter.CreatedTo)+1)
+	default:
+		upperBound = append([]byte(value), indexValueSeparator+1)
+	}
+
+	var (
+		result      []*common.BridgingRequestState
+		lastKey     []byte
+		statesBkt   = tx.Bucket(bridgingRequestStatesBucket)
+		indexCursor = tx.Bucket(idx.bucket).Cursor()
+	)
+
+	k, v := indexCursor.Seek(upperBound)
+	if k == nil {
+		k, v = indexCursor.Last()
+	} else {
+		k, v = indexCursor.Prev()
+	}
+
+	for ; k != nil && bytes.Compare(k, lowerBound) >= 0; k, v = indexCursor.Prev() {
+		if len(result) == limit {
+			return result, append([]byte{idx.id}, lastKey...), nil
+		}
+
+		data := statesBkt.Get(v)
+		if len(data) == 0 {
+			continue
+		}
+
+		var state *common.BridgingRequestState
+
+		if err := json.Unmarshal(data, &state); err != nil {
+			return nil, nil, fmt.Errorf("could not unmarshal BridgingRequestState: %w", err)
+		}
+
+		if filter.IsMatch(state) {
+			result = append(result, state)
+			lastKey = bytes.Clone(k)
+		}
+	}
+
+	return result, nil, nil
+}
@@ -4,8 +4,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
 	"github.com/stretchr/testify/require"
 )
 
@@ -124,4 +126,169 @@ func TestBoltDatabase(t *testing.T) {
 		require.NotNil(t, state)
 		require.Equal(t, common.BridgingRequestStatusInvalidRequest, state.Status)
 	})
+
+	t.Run("GetBridgingRequestStateHistory", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		db := &BBoltDatabase{}
+		err := db.Init(filePath)
+		require.NoError(t, err)
+
+		history, err := db.GetBridgingRequestStateHistory(primeChainID, testTxHash)
+		require.NoError(t, err)
+		require.Empty(t, history)
+
+		state := common.NewBridgingRequestState(primeChainID, testTxHash, false)
+		state.UpdatedAt = time.Unix(100, 0).UTC()
+
+		require.NoError(t, db.AddBridgingRequestState(state))
+
+		// updates which do not change the status are not transitions
+		state.RequiredConfirmations = 20
+		state.UpdatedAt = time.Unix(150, 0).UTC()
+
+		require.NoError(t, db.UpdateBridgingRequestState(state))
+
+		state.DestinationChainID = common.ChainIDStrVector
+		state.ToIncludedInBatch()
+		state.BatchID = 4
+		state.UpdatedAt = time.Unix(200, 0).UTC()
+
+		require.NoError(t, db.UpdateBridgingRequestState(state))
+
+		state.ToFailedToExecuteOnDestination()
+		state.FailureReason = "failed"
+		state.UpdatedAt = time.Unix(300, 0).UTC()
+
+		require.NoError(t, db.UpdateBridgingRequestState(state))
+
+		history, err = db.GetBridgingRequestStateHistory(primeChainID, testTxHash)
+		require.NoError(t, err)
+		require.Len(t, history, 3)
+
+		require.Equal(t, common.BridgingRequestStatusDiscoveredOnSource, history[0].Status)
+		require.Equal(t, time.Unix(100, 0).UTC(), history[0].Timestamp)
+		require.Equal(t, common.BridgingRequestStatusIncludedInBatch, history[1].Status)
+		require.Equal(t, uint64(4), history[1].BatchID)
+		require.Equal(t, common.ChainIDStrVector, history[1].DestinationChainID)
+		require.Equal(t, common.BridgingRequestStatusFailedToExecuteOnDestination, history[2].Status)
+		require.Equal(t, "failed", history[2].FailureReason)
+		require.Equal(t, time.Unix(300, 0).UTC(), history[2].Timestamp)
+	})
+
+	t.Run("ListBridgingRequestStates", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		db := &BBoltDatabase{}
+		err := db.Init(filePath)
+		require.NoError(t, err)
+
+		for i := 1; i <= 5; i++ {
+			state := common.NewBridgingRequestState(primeChainID, common.Hash{byte(i)}, false)
+			state.SenderAddr = "Sender1"
+			state.DestinationChainID = common.ChainIDStrVector
+			state.ReceiverAddrs = []string{"receiver1", "receiver2"}
+			state.CreatedAt = time.Unix(int64(i*100), 0).UTC()
+
+			if i%2 == 0 {
+				state.SenderAddr = "sender2"
+				state.DestinationChainID = common.ChainIDStrNexus
+				state.ReceiverAddrs = []string{"receiver3"}
+			}
+
+			require.NoError(t, db.AddBridgingRequestState(state))
+		}
+
+		state, err := db.GetBridgingRequestState(primeChainID, common.Hash{5})
+		require.NoError(t, err)
+
+		state.ToSubmittedToBridge()
+		require.NoError(t, db.UpdateBridgingRequestState(state))
+
+		listHashes := func(filter core.BridgingRequestStateFilter, cursor []byte, limit int) ([]byte, []common.Hash) {
+			states, nextCursor, err := db.ListBridgingRequestStates(filter, cursor, limit)
+			require.NoError(t, err)
+
+			hashes := make([]common.Hash, len(states))
+			for i, x := range states {
+				hashes[i] = x.SourceTxHash
+			}
+
+			return nextCursor, hashes
+		}
+
+		_, hashes := listHashes(core.BridgingRequestStateFilter{}, nil, 10)
+		require.Equal(t, []common.Hash{{5}, {4}, {3}, {2}, {1}}, hashes)
+
+		_, hashes = listHashes(core.BridgingRequestStateFilter{SenderAddr: "SENDER1"}, nil, 10)
+		require.Equal(t, []common.Hash{{5}, {3}, {1}}, hashes)
+
+		_, hashes = listHashes(core.BridgingRequestStateFilter{ReceiverAddr: "receiver2"}, nil, 10)
+		require.Equal(t, []common.Hash{{5}, {3}, {1}}, hashes)
+
+		_, hashes = listHashes(core.BridgingRequestStateFilter{DestinationChainID: common.ChainIDStrNexus}, nil, 10)
+		require.Equal(t, []common.Hash{{4}, {2}}, hashes)
+
+		_, hashes = listHashes(core.BridgingRequestStateFilter{
+			Status: common.BridgingRequestStatusDiscoveredOnSource,
+		}, nil, 10)
+		require.Equal(t, []common.Hash{{4}, {3}, {2}, {1}}, hashes)
+
+		_, hashes = listHashes(core.BridgingRequestStateFilter{
+			Status: common.BridgingRequestStatusSubmittedToBridge,
+		}, nil, 10)
+		require.Equal(t, []common.Hash{{5}}, hashes)
+
+		_, hashes = listHashes(core.BridgingRequestStateFilter{
+			SenderAddr:  "sender1",
+			CreatedFrom: time.Unix(200, 0),
+			CreatedTo:   time.Unix(400, 0),
+		}, nil, 10)
+		require.Equal(t, []common.Hash{{3}}, hashes)
+
+		_, hashes = listHashes(core.BridgingRequestStateFilter{SourceChainID: "other"}, nil, 10)
+		require.Empty(t, hashes)
+
+		cursor, hashes := listHashes(core.BridgingRequestStateFilter{}, nil, 2)
+		require.Equal(t, []common.Hash{{5}, {4}}, hashes)
+		require.NotNil(t, cursor)
+
+		cursor, hashes = listHashes(core.BridgingRequestStateFilter{}, cursor, 2)
+		require.Equal(t, []common.Hash{{3}, {2}}, hashes)
+		require.NotNil(t, cursor)
+
+		cursor, hashes = listHashes(core.BridgingRequestStateFilter{}, cursor, 2)
+		require.Equal(t, []common.Hash{{1}}, hashes)
+		require.Nil(t, cursor)
+
+		_, _, err = db.ListBridgingRequestStates(core.BridgingRequestStateFilter{SenderAddr: "sender2"}, []byte{5, 0}, 2)
+		require.ErrorContains(t, err, "cursor does not match the filter")
+	})
+
+	t.Run("AdminAuditEntries", func(t *testing.T) {
+		t.Cleanup(dbCleanup)
+
+		db := &BBoltDatabase{}
+		require.NoError(t, db.Init(filePath))
+
+		defer db.Close()
+
+		entries, err := db.GetAdminAuditEntries(10)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		for _, path := range []string{"Requeue", "Invalidate", "ForceRefund"} {
+			require.NoError(t, db.AddAdminAuditEntry(&core.AdminAuditEntry{
+				Operator: "alice", Role: core.AdminRoleAdmin, Path: path, StatusCode: 200,
+			}))
+		}
+
+		entries, err = db.GetAdminAuditEntries(2)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, uint64(3), entries[0].ID)
+		require.Equal(t, "ForceRefund", entries[0].Path)
+		require.Equal(t, uint64(2), entries[1].ID)
+		require.Equal(t, "alice", entries[1].Operator)
+	})
 }
@@ -0,0 +1,180 @@
+package databaseaccess
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"go.etcd.io/bbolt"
+)
+
+var (
+	webhookDeliveriesBucket = []byte("WebhookDeliveries")
+	// key: next attempt time (unix nano, big endian) | delivery id
+	webhookPendingDeliveriesBucket = []byte("WebhookPendingDeliveries")
+	// key: bridging request state db key | delivery id
+	webhookDeliveriesByRequestBucket = []byte("WebhookDeliveriesByRequest")
+)
+
+func webhookDeliveryKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, id)
+}
+
+func webhookPendingDeliveryKey(delivery *core.WebhookDelivery) []byte {
+	key := binary.BigEndian.AppendUint64(nil, indexTime(delivery.NextAttemptAt))
+
+	return binary.BigEndian.AppendUint64(key, delivery.ID)
+}
+
+func webhookDeliveryByRequestKey(delivery *core.WebhookDelivery) []byte {
+	key := common.ToBridgingRequestStateDBKey(delivery.SourceChainID, delivery.SourceTxHash)
+
+	return binary.BigEndian.AppendUint64(key, delivery.ID)
+}
+
+// AddWebhookDelivery implements core.Database.
+func (bd *BBoltDatabase) AddWebhookDelivery(delivery *core.WebhookDelivery) error {
+	return bd.db.Update(func(tx *bbolt.Tx) error {
+		id, err := tx.Bucket(webhookDeliveriesBucket).NextSequence()
+		if err != nil {
+			return fmt.Errorf("could not get next WebhookDelivery id: %w", err)
+		}
+
+		delivery.ID = id
+
+		if err = putWebhookDelivery(tx, delivery); err != nil {
+			return err
+		}
+
+		err = tx.Bucket(webhookDeliveriesByRequestBucket).Put(
+			webhookDeliveryByRequestKey(delivery), webhookDeliveryKey(delivery.ID))
+		if err != nil {
+			return fmt.Errorf("WebhookDelivery index write error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// UpdateWebhookDelivery implements core.Database.
+func (bd *BBoltDatabase) UpdateWebhookDelivery(delivery *core.WebhookDelivery) error {
+	return bd.db.Update(func(tx *bbolt.Tx) error {
+		oldData := tx.Bucket(webhookDeliveriesBucket).Get(webhookDeliveryKey(delivery.ID))
+		if len(oldData) == 0 {
+			return fmt.Errorf("trying to update a WebhookDelivery that does not exist")
+		}
+
+		var oldDelivery *core.WebhookDelivery
+
+		if err := json.Unmarshal(oldData, &oldDelivery); err != nil {
+			return fmt.Errorf("could not unmarshal WebhookDelivery: %w", err)
+		}
+
+		if oldDelivery.Status == core.WebhookDeliveryStatusPending {
+			err := tx.Bucket(webhookPendingDeliveriesBucket).Delete(webhookPendingDeliveryKey(oldDelivery))
+			if err != nil {
+				return fmt.Errorf("WebhookDelivery pending delete error: %w", err)
+			}
+		}
+
+		return putWebhookDelivery(tx, delivery)
+	})
+}
+
+// GetWebhookDelivery implements core.Database.
+func (bd *BBoltDatabase) GetWebhookDelivery(id uint64) (result *core.WebhookDelivery, err error) {
+	err = bd.db.View(func(tx *bbolt.Tx) error {
+		if data := tx.Bucket(webhookDeliveriesBucket).Get(webhookDeliveryKey(id)); len(data) > 0 {
+			return json.Unmarshal(data, &result)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// GetWebhookDeliveries implements core.Database.
+func (bd *BBoltDatabase) GetWebhookDeliveries(
+	sourceChainID string, sourceTxHash common.Hash,
+) (
+	result []*core.WebhookDelivery, err error,
+) {
+	prefix := common.ToBridgingRequestStateDBKey(sourceChainID, sourceTxHash)
+
+	err = bd.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(webhookDeliveriesByRequestBucket).Cursor()
+		deliveriesBucket := tx.Bucket(webhookDeliveriesBucket)
+
+		for k, v := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			var delivery *core.WebhookDelivery
+
+			if err := json.Unmarshal(deliveriesBucket.Get(v), &delivery); err != nil {
+				return fmt.Errorf("could not unmarshal WebhookDelivery: %w", err)
+			}
+
+			result = append(result, delivery)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// GetPendingWebhookDeliveries implements core.Database.
+func (bd *BBoltDatabase) GetPendingWebhookDeliveries(
+	dueTime time.Time, limit int,
+) (
+	result []*core.WebhookDelivery, err error,
+) {
+	dueTimeIdx := indexTime(dueTime)
+
+	err = bd.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(webhookPendingDeliveriesBucket).Cursor()
+		deliveriesBucket := tx.Bucket(webhookDeliveriesBucket)
+
+		for k, v := cursor.First(); k != nil && len(result) < limit; k, v = cursor.Next() {
+			if binary.BigEndian.Uint64(k) > dueTimeIdx {
+				break
+			}
+
+			var delivery *core.WebhookDelivery
+
+			if err := json.Unmarshal(deliveriesBucket.Get(v), &delivery); err != nil {
+				return fmt.Errorf("could not unmarshal WebhookDelivery: %w", err)
+			}
+
+			result = append(result, delivery)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func putWebhookDelivery(tx *bbolt.Tx, delivery *core.WebhookDelivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		return fmt.Errorf("could not marshal WebhookDelivery: %w", err)
+	}
+
+	if err = tx.Bucket(webhookDeliveriesBucket).Put(webhookDeliveryKey(delivery.ID), data); err != nil {
+		return fmt.Errorf("WebhookDelivery write error: %w", err)
+	}
+
+	if delivery.Status == core.WebhookDeliveryStatusPending {
+		err = tx.Bucket(webhookPendingDeliveriesBucket).Put(
+			webhookPendingDeliveryKey(delivery), webhookDeliveryKey(delivery.ID))
+		if err != nil {
+			return fmt.Errorf("WebhookDelivery pending write error: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -38,4 +38,29 @@ func (m *BridgingRequestStateDBMock) UpdateBridgingRequestState(state *common.Br
 	return args.Error(0)
 }
 
+// GetBridgingRequestStateHistory implements core.BridgingRequestStateDb.
+func (m *BridgingRequestStateDBMock) GetBridgingRequestStateHistory(
+	sourceChainID string, sourceTxHash common.Hash,
+) ([]*common.BridgingRequestStateTransition, error) {
+	args := m.Called(sourceChainID, sourceTxHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	arg0, _ := args.Get(0).([]*common.BridgingRequestStateTransition)
+
+	return arg0, args.Error(1)
+}
+
+// ListBridgingRequestStates implements core.BridgingRequestStateDb.
+func (m *BridgingRequestStateDBMock) ListBridgingRequestStates(
+	filter core.BridgingRequestStateFilter, cursor []byte, limit int,
+) ([]*common.BridgingRequestState, []byte, error) {
+	args := m.Called(filter, cursor, limit)
+	arg0, _ := args.Get(0).([]*common.BridgingRequestState)
+	arg1, _ := args.Get(1).([]byte)
+
+	return arg0, arg1, args.Error(2)
+}
+
 var _ core.BridgingRequestStateDB = (*BridgingRequestStateDBMock)(nil)
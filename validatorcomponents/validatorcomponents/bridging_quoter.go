@@ -0,0 +1,171 @@
+package validatorcomponents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
+	"github.com/Ethernal-Tech/apex-bridge/oracle_cardano/utils"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	oracleCommonUtils "github.com/Ethernal-Tech/apex-bridge/oracle_common/utils"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const gatewayCallTimeout = 5 * time.Second
+
+type gatewayMinAmountsFn = func(
+	ctx context.Context, config *oracleCommonCore.EthChainConfig) (minFee *big.Int, minBridging *big.Int, err error)
+
+// BridgingQuoterImpl calculates the same minimums the oracles and the gateway contract enforce,
+// so the frontends do not have to hardcode them
+type BridgingQuoterImpl struct {
+	oracleConfig      *oracleCommonCore.AppConfig
+	gatewayMinAmounts gatewayMinAmountsFn
+}
+
+var _ core.BridgingQuoter = (*BridgingQuoterImpl)(nil)
+
+func NewBridgingQuoter(oracleConfig *oracleCommonCore.AppConfig) *BridgingQuoterImpl {
+	return &BridgingQuoterImpl{
+		oracleConfig:      oracleConfig,
+		gatewayMinAmounts: getGatewayMinAmounts,
+	}
+}
+
+// Quote implements core.BridgingQuoter.
+func (q *BridgingQuoterImpl) Quote(
+	ctx context.Context, request *core.BridgingQuoteRequest,
+) (*core.BridgingQuote, error) {
+	cardanoSrcConfig, ethSrcConfig := oracleCommonUtils.GetChainConfig(q.oracleConfig, request.SourceChainID)
+	if cardanoSrcConfig == nil && ethSrcConfig == nil {
+		return nil, fmt.Errorf("origin chain not registered: %v", request.SourceChainID)
+	}
+
+	cardanoDestConfig, ethDestConfig := oracleCommonUtils.GetChainConfig(q.oracleConfig, request.DestinationChainID)
+	if cardanoDestConfig == nil && ethDestConfig == nil {
+		return nil, fmt.Errorf("destination chain not registered: %v", request.DestinationChainID)
+	}
+
+	if ethSrcConfig != nil && cardanoDestConfig == nil {
+		return nil, errors.New("evm source chains can only bridge to cardano chains")
+	}
+
+	// amounts from the configuration are in dfm
+	toSource := func(dfm uint64) *big.Int {
+		if ethSrcConfig != nil {
+			return common.DfmToWei(new(big.Int).SetUint64(dfm))
+		}
+
+		return new(big.Int).SetUint64(dfm)
+	}
+
+	toDestination := func(amount *big.Int) *big.Int {
+		switch {
+		case ethSrcConfig != nil:
+			return common.WeiToDfm(amount)
+		case ethDestConfig != nil:
+			return common.DfmToWei(amount)
+		default:
+			return new(big.Int).Set(amount)
+		}
+	}
+
+	quote := &core.BridgingQuote{
+		IsDirectionAllowed: utils.IsTxDirectionAllowed(
+			q.oracleConfig, request.SourceChainID, request.DestinationChainID) == nil,
+		MinReceiverAmount: big.NewInt(0),
+		TotalAmount:       big.NewInt(0),
+		Receivers:         make([]*core.BridgingQuoteReceiver, len(request.Receivers)),
+	}
+
+	if cardanoDestConfig != nil {
+		quote.MinBridgingFee = toSource(cardanoDestConfig.MinFeeForBridging)
+		quote.MinReceiverAmount = toSource(cardanoDestConfig.UtxoMinAmount)
+		quote.FeeAddrBridgingAmount = new(big.Int).SetUint64(cardanoDestConfig.FeeAddrBridgingAmount)
+	} else {
+		quote.MinBridgingFee = toSource(ethDestConfig.MinFeeForBridging)
+		quote.FeeAddrBridgingAmount = common.DfmToWei(new(big.Int).SetUint64(ethDestConfig.FeeAddrBridgingAmount))
+	}
+
+	if ethSrcConfig != nil {
+		ctx, cancel := context.WithTimeout(ctx, gatewayCallTimeout)
+		defer cancel()
+
+		minFee, minBridging, err := q.gatewayMinAmounts(ctx, ethSrcConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gateway min amounts: %w", err)
+		}
+
+		if minFee.Cmp(quote.MinBridgingFee) > 0 {
+			quote.MinBridgingFee = minFee
+		}
+
+		if minBridging.Cmp(quote.MinReceiverAmount) > 0 {
+			quote.MinReceiverAmount = minBridging
+		}
+	}
+
+	if maxAmount := q.oracleConfig.BridgingSettings.MaxAmountAllowedToBridge; maxAmount != nil && maxAmount.Sign() > 0 {
+		quote.MaxAmountAllowedToBridge = maxAmount
+		if ethSrcConfig != nil {
+			quote.MaxAmountAllowedToBridge = common.DfmToWei(maxAmount)
+		}
+	}
+
+	quote.TotalAmount.Add(quote.TotalAmount, quote.MinBridgingFee)
+
+	for i, x := range request.Receivers {
+		if x.Amount == nil || x.Amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid amount for receiver %s", x.Address)
+		}
+
+		quote.Receivers[i] = &core.BridgingQuoteReceiver{
+			Address:           x.Address,
+			Amount:            x.Amount,
+			DestinationAmount: toDestination(x.Amount),
+			IsAboveMinimum:    x.Amount.Cmp(quote.MinReceiverAmount) >= 0,
+		}
+
+		quote.TotalAmount.Add(quote.TotalAmount, x.Amount)
+	}
+
+	return quote, nil
+}
+
+func getGatewayMinAmounts(
+	ctx context.Context, config *oracleCommonCore.EthChainConfig,
+) (*big.Int, *big.Int, error) {
+	client, err := ethclient.DialContext(ctx, config.NodeURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer client.Close()
+
+	gateway, err := contractbinding.NewGatewayCaller(
+		ethcommon.HexToAddress(config.BridgingAddresses.BridgingAddress), client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+
+	minFee, err := gateway.MinFeeAmount(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	minBridging, err := gateway.MinBridgingAmount(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return minFee, minBridging, nil
+}
@@ -0,0 +1,162 @@
+package validatorcomponents
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgingQuoter(t *testing.T) {
+	oracleConfig := &oracleCommonCore.AppConfig{
+		CardanoChains: map[string]*oracleCommonCore.CardanoChainConfig{
+			common.ChainIDStrPrime: {
+				CardanoChainConfig: cardanotx.CardanoChainConfig{
+					UtxoMinAmount: 1_000_000,
+				},
+				MinFeeForBridging:     1_000_010,
+				FeeAddrBridgingAmount: 1_000_005,
+			},
+			common.ChainIDStrVector: {
+				CardanoChainConfig: cardanotx.CardanoChainConfig{
+					UtxoMinAmount: 900_000,
+				},
+				MinFeeForBridging:     2_000_000,
+				FeeAddrBridgingAmount: 1_000_000,
+			},
+		},
+		EthChains: map[string]*oracleCommonCore.EthChainConfig{
+			common.ChainIDStrNexus: {
+				MinFeeForBridging:     1_500_000,
+				FeeAddrBridgingAmount: 1_000_000,
+			},
+		},
+		BridgingSettings: oracleCommonCore.BridgingSettings{
+			MaxAmountAllowedToBridge: big.NewInt(100_000_000),
+			AllowedDirections: map[string][]string{
+				common.ChainIDStrPrime: {common.ChainIDStrNexus},
+				common.ChainIDStrNexus: {common.ChainIDStrPrime},
+			},
+		},
+	}
+	oracleConfig.FillOut()
+
+	wei := func(dfm int64) *big.Int {
+		return common.DfmToWei(big.NewInt(dfm))
+	}
+
+	t.Run("cardano to cardano", func(t *testing.T) {
+		quoter := NewBridgingQuoter(oracleConfig)
+
+		quote, err := quoter.Quote(context.Background(), &core.BridgingQuoteRequest{
+			SourceChainID:      common.ChainIDStrPrime,
+			DestinationChainID: common.ChainIDStrVector,
+			Receivers: []core.BridgingTxReceiver{
+				{Address: "addr1", Amount: big.NewInt(5_000_000)},
+				{Address: "addr2", Amount: big.NewInt(800_000)},
+			},
+		})
+		require.NoError(t, err)
+		require.False(t, quote.IsDirectionAllowed)
+		require.Equal(t, big.NewInt(2_000_000), quote.MinBridgingFee)
+		require.Equal(t, big.NewInt(900_000), quote.MinReceiverAmount)
+		require.Equal(t, big.NewInt(1_000_000), quote.FeeAddrBridgingAmount)
+		require.Equal(t, big.NewInt(100_000_000), quote.MaxAmountAllowedToBridge)
+		require.Equal(t, big.NewInt(7_800_000), quote.TotalAmount)
+		require.Len(t, quote.Receivers, 2)
+		require.True(t, quote.Receivers[0].IsAboveMinimum)
+		require.Equal(t, big.NewInt(5_000_000), quote.Receivers[0].DestinationAmount)
+		require.False(t, quote.Receivers[1].IsAboveMinimum)
+	})
+
+	t.Run("cardano to evm", func(t *testing.T) {
+		quoter := NewBridgingQuoter(oracleConfig)
+
+		quote, err := quoter.Quote(context.Background(), &core.BridgingQuoteRequest{
+			SourceChainID:      common.ChainIDStrPrime,
+			DestinationChainID: common.ChainIDStrNexus,
+			Receivers: []core.BridgingTxReceiver{
+				{Address: "0x1", Amount: big.NewInt(3_000_000)},
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, quote.IsDirectionAllowed)
+		require.Equal(t, big.NewInt(1_500_000), quote.MinBridgingFee)
+		require.Equal(t, big.NewInt(0), quote.MinReceiverAmount)
+		require.Equal(t, wei(1_000_000), quote.FeeAddrBridgingAmount)
+		require.Equal(t, wei(3_000_000), quote.Receivers[0].DestinationAmount)
+		require.Equal(t, big.NewInt(4_500_000), quote.TotalAmount)
+	})
+
+	t.Run("evm to cardano", func(t *testing.T) {
+		quoter := NewBridgingQuoter(oracleConfig)
+		quoter.gatewayMinAmounts = func(
+			_ context.Context, _ *oracleCommonCore.EthChainConfig,
+		) (*big.Int, *big.Int, error) {
+			return wei(2_000_000), wei(500_000), nil
+		}
+
+		amount := new(big.Int).Add(wei(1_000_000), big.NewInt(1))
+
+		quote, err := quoter.Quote(context.Background(), &core.BridgingQuoteRequest{
+			SourceChainID:      common.ChainIDStrNexus,
+			DestinationChainID: common.ChainIDStrPrime,
+			Receivers: []core.BridgingTxReceiver{
+				{Address: "addr1", Amount: amount},
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, quote.IsDirectionAllowed)
+		// gateway min fee is greater than the one from the config
+		require.Equal(t, wei(2_000_000), quote.MinBridgingFee)
+		// config min utxo is greater than the gateway min bridging amount
+		require.Equal(t, wei(1_000_000), quote.MinReceiverAmount)
+		require.Equal(t, big.NewInt(1_000_005), quote.FeeAddrBridgingAmount)
+		require.Equal(t, wei(100_000_000), quote.MaxAmountAllowedToBridge)
+		require.Equal(t, big.NewInt(1_000_000), quote.Receivers[0].DestinationAmount)
+		require.True(t, quote.Receivers[0].IsAboveMinimum)
+	})
+
+	t.Run("gateway error", func(t *testing.T) {
+		quoter := NewBridgingQuoter(oracleConfig)
+		quoter.gatewayMinAmounts = func(
+			_ context.Context, _ *oracleCommonCore.EthChainConfig,
+		) (*big.Int, *big.Int, error) {
+			return nil, nil, errors.New("test err")
+		}
+
+		_, err := quoter.Quote(context.Background(), &core.BridgingQuoteRequest{
+			SourceChainID:      common.ChainIDStrNexus,
+			DestinationChainID: common.ChainIDStrPrime,
+		})
+		require.ErrorContains(t, err, "test err")
+	})
+
+	t.Run("invalid chains", func(t *testing.T) {
+		quoter := NewBridgingQuoter(oracleConfig)
+
+		_, err := quoter.Quote(context.Background(), &core.BridgingQuoteRequest{
+			SourceChainID:      "unknown",
+			DestinationChainID: common.ChainIDStrPrime,
+		})
+		require.ErrorContains(t, err, "origin chain not registered")
+
+		_, err = quoter.Quote(context.Background(), &core.BridgingQuoteRequest{
+			SourceChainID:      common.ChainIDStrPrime,
+			DestinationChainID: "unknown",
+		})
+		require.ErrorContains(t, err, "destination chain not registered")
+
+		_, err = quoter.Quote(context.Background(), &core.BridgingQuoteRequest{
+			SourceChainID:      common.ChainIDStrNexus,
+			DestinationChainID: common.ChainIDStrNexus,
+		})
+		require.ErrorContains(t, err, "evm source chains can only bridge to cardano chains")
+	})
+}
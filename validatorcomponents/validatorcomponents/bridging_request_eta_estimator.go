@@ -0,0 +1,236 @@
+package validatorcomponents
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/telemetry"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	etaStatsWindowSize   = 100
+	etaStatsWarmupStates = 500
+)
+
+// bridgingRequestStages are the statuses of a successful bridging request in the order they are reached
+var bridgingRequestStages = []common.BridgingRequestStatus{
+	common.BridgingRequestStatusDiscoveredOnSource,
+	common.BridgingRequestStatusSubmittedToBridge,
+	common.BridgingRequestStatusIncludedInBatch,
+	common.BridgingRequestStatusSubmittedToDestination,
+	common.BridgingRequestStatusExecutedOnDestination,
+}
+
+// stageSamples holds the last etaStatsWindowSize durations of a stage
+type stageSamples struct {
+	values []time.Duration
+	next   int
+}
+
+func (s *stageSamples) add(duration time.Duration) {
+	if len(s.values) < etaStatsWindowSize {
+		s.values = append(s.values, duration)
+
+		return
+	}
+
+	s.values[s.next] = duration
+	s.next = (s.next + 1) % etaStatsWindowSize
+}
+
+func (s *stageSamples) median() time.Duration {
+	sorted := slices.Clone(s.values)
+	slices.Sort(sorted)
+
+	return sorted[len(sorted)/2]
+}
+
+// BridgingRequestETAEstimatorImpl keeps rolling per direction statistics of how long each stage of
+// a bridging request takes and uses them to estimate when a pending request will be completed.
+// Stages without statistics are estimated from the configured pull intervals
+type BridgingRequestETAEstimatorImpl struct {
+	stateManager      core.BridgingRequestStateManager
+	fallbackDurations map[common.BridgingRequestStatus]time.Duration
+	samples           map[string]map[common.BridgingRequestStatus]*stageSamples
+	lock              sync.RWMutex
+	logger            hclog.Logger
+}
+
+var _ core.BridgingRequestETAEstimator = (*BridgingRequestETAEstimatorImpl)(nil)
+
+func NewBridgingRequestETAEstimator(
+	appConfig *core.AppConfig,
+	stateManager core.BridgingRequestStateManager,
+	logger hclog.Logger,
+) *BridgingRequestETAEstimatorImpl {
+	var (
+		submitTime  = time.Duration(appConfig.Bridge.SubmitConfig.ConfirmedBlocksSubmitTime) * time.Millisecond
+		batcherTime = time.Duration(appConfig.BatcherPullTimeMilis) * time.Millisecond
+		relayerTime = time.Duration(appConfig.RelayerImitatorPullTimeMilis) * time.Millisecond
+	)
+
+	return &BridgingRequestETAEstimatorImpl{
+		stateManager: stateManager,
+		fallbackDurations: map[common.BridgingRequestStatus]time.Duration{
+			common.BridgingRequestStatusSubmittedToBridge:      submitTime,
+			common.BridgingRequestStatusIncludedInBatch:        batcherTime,
+			common.BridgingRequestStatusSubmittedToDestination: relayerTime,
+			common.BridgingRequestStatusExecutedOnDestination:  submitTime,
+		},
+		samples: map[string]map[common.BridgingRequestStatus]*stageSamples{},
+		logger:  logger,
+	}
+}
+
+// LoadStatistics fills the statistics from the history of the latest bridging requests
+func (e *BridgingRequestETAEstimatorImpl) LoadStatistics() error {
+	states, _, err := e.stateManager.List(core.BridgingRequestStateFilter{}, "", etaStatsWarmupStates)
+	if err != nil {
+		return fmt.Errorf("failed to list bridging request states: %w", err)
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for _, state := range states {
+		history, err := e.stateManager.GetHistory(state.SourceChainID, state.SourceTxHash)
+		if err != nil {
+			return fmt.Errorf("failed to get bridging request state history (%s, %s): %w",
+				state.SourceChainID, state.SourceTxHash, err)
+		}
+
+		for i := 1; i < len(history); i++ {
+			prev, curr := history[i-1], history[i]
+
+			if isNextBridgingRequestStage(prev.Status, curr.Status) {
+				e.addSample(state.SourceChainID, state.DestinationChainID, curr.Status, curr.Timestamp.Sub(prev.Timestamp))
+			}
+		}
+	}
+
+	return nil
+}
+
+// OnBridgingRequestStateChanged implements core.BridgingRequestStateListener.
+func (e *BridgingRequestETAEstimatorImpl) OnBridgingRequestStateChanged(event *core.BridgingRequestStateEvent) {
+	state := &event.State
+
+	if event.PreviousUpdatedAt.IsZero() || !isNextBridgingRequestStage(event.PreviousStatus, state.Status) {
+		return
+	}
+
+	duration := state.UpdatedAt.Sub(event.PreviousUpdatedAt)
+
+	e.lock.Lock()
+	e.addSample(state.SourceChainID, state.DestinationChainID, state.Status, duration)
+	e.lock.Unlock()
+
+	telemetry.UpdateBridgingRequestStageDuration(
+		state.SourceChainID, state.DestinationChainID, string(state.Status), duration)
+
+	if state.Status == common.BridgingRequestStatusExecutedOnDestination && !state.CreatedAt.IsZero() {
+		telemetry.UpdateBridgingRequestTotalDuration(
+			state.SourceChainID, state.DestinationChainID, state.UpdatedAt.Sub(state.CreatedAt))
+	}
+}
+
+// GetETA implements core.BridgingRequestETAEstimator.
+func (e *BridgingRequestETAEstimatorImpl) GetETA(
+	sourceChainID string, sourceTxHash common.Hash,
+) (*core.BridgingRequestETA, error) {
+	state, err := e.stateManager.Get(sourceChainID, sourceTxHash)
+	if err != nil || state == nil {
+		return nil, err
+	}
+
+	result := &core.BridgingRequestETA{
+		State: state,
+	}
+
+	var position int
+
+	switch state.Status {
+	case common.BridgingRequestStatusInvalidRequest, common.BridgingRequestStatusExecutedOnDestination:
+		result.IsFinal = true
+
+		return result, nil
+	case common.BridgingRequestStatusFailedToExecuteOnDestination:
+		// failed batches are created again, so the request waits for a new batch
+		position = slices.Index(bridgingRequestStages, common.BridgingRequestStatusSubmittedToBridge)
+	case common.BridgingRequestStatusAwaitingApproval:
+		// the time needed for the review of the operators is not estimated
+		position = slices.Index(bridgingRequestStages, common.BridgingRequestStatusDiscoveredOnSource)
+	default:
+		position = slices.Index(bridgingRequestStages, state.Status)
+	}
+
+	now := time.Now().UTC()
+
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	directionSamples := e.samples[directionKey(state.SourceChainID, state.DestinationChainID)]
+
+	for _, status := range bridgingRequestStages[position+1:] {
+		stage := &core.BridgingRequestStageEstimate{
+			Status:   status,
+			Duration: e.fallbackDurations[status],
+		}
+
+		if samples, exists := directionSamples[status]; exists {
+			stage.Duration = samples.median()
+			stage.Samples = len(samples.values)
+		}
+
+		result.Stages = append(result.Stages, stage)
+		result.Remaining += stage.Duration
+	}
+
+	// the time already spent waiting for the next stage is subtracted from it
+	if len(result.Stages) > 0 {
+		result.Remaining -= min(now.Sub(state.UpdatedAt), result.Stages[0].Duration)
+	}
+
+	result.EstimatedCompletionAt = now.Add(result.Remaining)
+
+	return result, nil
+}
+
+func (e *BridgingRequestETAEstimatorImpl) addSample(
+	srcChainID, dstChainID string, status common.BridgingRequestStatus, duration time.Duration,
+) {
+	if duration < 0 {
+		return
+	}
+
+	key := directionKey(srcChainID, dstChainID)
+
+	directionSamples, exists := e.samples[key]
+	if !exists {
+		directionSamples = map[common.BridgingRequestStatus]*stageSamples{}
+		e.samples[key] = directionSamples
+	}
+
+	samples, exists := directionSamples[status]
+	if !exists {
+		samples = &stageSamples{}
+		directionSamples[status] = samples
+	}
+
+	samples.add(duration)
+}
+
+func isNextBridgingRequestStage(prev, curr common.BridgingRequestStatus) bool {
+	prevIdx := slices.Index(bridgingRequestStages, prev)
+
+	return prevIdx >= 0 && prevIdx+1 < len(bridgingRequestStages) && bridgingRequestStages[prevIdx+1] == curr
+}
+
+func directionKey(srcChainID, dstChainID string) string {
+	return srcChainID + "->" + dstChainID
+}
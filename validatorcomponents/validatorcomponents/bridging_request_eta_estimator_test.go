@@ -0,0 +1,152 @@
+package validatorcomponents
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	oracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	databaseaccess "github.com/Ethernal-Tech/apex-bridge/validatorcomponents/database_access"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgingRequestETAEstimator(t *testing.T) {
+	appConfig := &core.AppConfig{
+		Bridge: oracleCore.BridgeConfig{
+			SubmitConfig: oracleCore.SubmitConfig{ConfirmedBlocksSubmitTime: 1000},
+		},
+		BatcherPullTimeMilis:         2000,
+		RelayerImitatorPullTimeMilis: 3000,
+	}
+
+	newStateManager := func(t *testing.T) *BridgingRequestStateManagerImpl {
+		t.Helper()
+
+		db, err := databaseaccess.NewDatabase(filepath.Join(t.TempDir(), "temp_test.db"))
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			db.Close()
+		})
+
+		return NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+	}
+
+	newEvent := func(
+		status, prevStatus common.BridgingRequestStatus, duration time.Duration,
+	) *core.BridgingRequestStateEvent {
+		state := common.NewBridgingRequestState(common.ChainIDStrPrime, common.NewHashFromHexString("0xFF"), false)
+		state.DestinationChainID = common.ChainIDStrVector
+		state.Status = status
+		state.UpdatedAt = time.Now().UTC()
+
+		return &core.BridgingRequestStateEvent{
+			State:             *state,
+			PreviousStatus:    prevStatus,
+			PreviousUpdatedAt: state.UpdatedAt.Add(-duration),
+		}
+	}
+
+	txHash := common.NewHashFromHexString("0x01")
+	key := common.NewBridgingRequestStateKey(common.ChainIDStrPrime, txHash, false)
+
+	t.Run("not found", func(t *testing.T) {
+		estimator := NewBridgingRequestETAEstimator(appConfig, newStateManager(t), hclog.NewNullLogger())
+
+		eta, err := estimator.GetETA(common.ChainIDStrPrime, txHash)
+		require.NoError(t, err)
+		require.Nil(t, eta)
+	})
+
+	t.Run("fallback to pull intervals", func(t *testing.T) {
+		stateManager := newStateManager(t)
+		estimator := NewBridgingRequestETAEstimator(appConfig, stateManager, hclog.NewNullLogger())
+
+		require.NoError(t, stateManager.New(common.ChainIDStrPrime, &common.NewBridgingRequestStateModel{
+			SourceTxHash: txHash, DestinationChainID: common.ChainIDStrVector,
+		}))
+
+		eta, err := estimator.GetETA(common.ChainIDStrPrime, txHash)
+		require.NoError(t, err)
+		require.False(t, eta.IsFinal)
+		require.Len(t, eta.Stages, 4)
+		require.Equal(t, common.BridgingRequestStatusSubmittedToBridge, eta.Stages[0].Status)
+		require.Equal(t, time.Second, eta.Stages[0].Duration)
+		require.Equal(t, 2*time.Second, eta.Stages[1].Duration)
+		require.Equal(t, 3*time.Second, eta.Stages[2].Duration)
+		require.Equal(t, time.Second, eta.Stages[3].Duration)
+		require.Zero(t, eta.Stages[0].Samples)
+		require.LessOrEqual(t, eta.Remaining, 7*time.Second)
+		require.Greater(t, eta.Remaining, 6*time.Second)
+	})
+
+	t.Run("statistics", func(t *testing.T) {
+		stateManager := newStateManager(t)
+		estimator := NewBridgingRequestETAEstimator(appConfig, stateManager, hclog.NewNullLogger())
+		stateManager.AddListener(estimator)
+
+		require.NoError(t, stateManager.New(common.ChainIDStrPrime, &common.NewBridgingRequestStateModel{
+			SourceTxHash: txHash, DestinationChainID: common.ChainIDStrVector,
+		}))
+		require.NoError(t, stateManager.SubmittedToBridge(key, common.ChainIDStrVector))
+
+		for _, duration := range []time.Duration{time.Minute, 3 * time.Minute, 2 * time.Minute} {
+			estimator.OnBridgingRequestStateChanged(newEvent(
+				common.BridgingRequestStatusIncludedInBatch, common.BridgingRequestStatusSubmittedToBridge, duration))
+		}
+
+		// not the next stage so it is ignored
+		estimator.OnBridgingRequestStateChanged(newEvent(
+			common.BridgingRequestStatusSubmittedToDestination, common.BridgingRequestStatusSubmittedToBridge, time.Hour))
+
+		eta, err := estimator.GetETA(common.ChainIDStrPrime, txHash)
+		require.NoError(t, err)
+		require.Len(t, eta.Stages, 3)
+		require.Equal(t, common.BridgingRequestStatusIncludedInBatch, eta.Stages[0].Status)
+		require.Equal(t, 2*time.Minute, eta.Stages[0].Duration)
+		require.Equal(t, 3, eta.Stages[0].Samples)
+		require.Equal(t, 3*time.Second, eta.Stages[1].Duration)
+		require.Zero(t, eta.Stages[1].Samples)
+		require.WithinDuration(t, time.Now().Add(eta.Remaining), eta.EstimatedCompletionAt, time.Second)
+
+		// statistics are loaded from the history of the existing requests
+		loadedEstimator := NewBridgingRequestETAEstimator(appConfig, stateManager, hclog.NewNullLogger())
+		require.NoError(t, loadedEstimator.LoadStatistics())
+
+		eta, err = loadedEstimator.GetETA(common.ChainIDStrPrime, txHash)
+		require.NoError(t, err)
+		require.Zero(t, eta.Stages[0].Samples)
+
+		stats := loadedEstimator.samples[directionKey(common.ChainIDStrPrime, common.ChainIDStrVector)]
+		require.Len(t, stats[common.BridgingRequestStatusSubmittedToBridge].values, 1)
+	})
+
+	t.Run("final status", func(t *testing.T) {
+		stateManager := newStateManager(t)
+		estimator := NewBridgingRequestETAEstimator(appConfig, stateManager, hclog.NewNullLogger())
+
+		require.NoError(t, stateManager.New(common.ChainIDStrPrime, &common.NewBridgingRequestStateModel{
+			SourceTxHash: txHash, DestinationChainID: common.ChainIDStrVector,
+		}))
+		require.NoError(t, stateManager.Invalid(key, "invalid"))
+
+		eta, err := estimator.GetETA(common.ChainIDStrPrime, txHash)
+		require.NoError(t, err)
+		require.True(t, eta.IsFinal)
+		require.Empty(t, eta.Stages)
+	})
+
+	t.Run("rolling window", func(t *testing.T) {
+		samples := &stageSamples{}
+
+		for i := 1; i <= etaStatsWindowSize+10; i++ {
+			samples.add(time.Duration(i) * time.Second)
+		}
+
+		require.Len(t, samples.values, etaStatsWindowSize)
+		require.Equal(t, time.Duration(etaStatsWindowSize/2+11)*time.Second, samples.median())
+	})
+}
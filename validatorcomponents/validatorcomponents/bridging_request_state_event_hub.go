@@ -0,0 +1,103 @@
+package validatorcomponents
+
+import (
+	"sync"
+
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+const defaultEventHubSubscriberBufferSize = 100
+
+type eventHubSubscription struct {
+	filter core.BridgingRequestStateEventFilter
+	ch     chan *core.BridgingRequestStateEvent
+}
+
+// BridgingRequestStateEventHub fans out bridging request state changes to all subscribers.
+// A subscriber which does not read its events fast enough is dropped
+type BridgingRequestStateEventHub struct {
+	lock          sync.RWMutex
+	subscriptions map[uint64]*eventHubSubscription
+	lastID        uint64
+	bufferSize    int
+	logger        hclog.Logger
+}
+
+var (
+	_ core.BridgingRequestStateListener   = (*BridgingRequestStateEventHub)(nil)
+	_ core.BridgingRequestStateSubscriber = (*BridgingRequestStateEventHub)(nil)
+)
+
+func NewBridgingRequestStateEventHub(bufferSize int, logger hclog.Logger) *BridgingRequestStateEventHub {
+	if bufferSize <= 0 {
+		bufferSize = defaultEventHubSubscriberBufferSize
+	}
+
+	return &BridgingRequestStateEventHub{
+		subscriptions: map[uint64]*eventHubSubscription{},
+		bufferSize:    bufferSize,
+		logger:        logger,
+	}
+}
+
+// Subscribe implements core.BridgingRequestStateSubscriber.
+func (h *BridgingRequestStateEventHub) Subscribe(
+	filter core.BridgingRequestStateEventFilter,
+) (<-chan *core.BridgingRequestStateEvent, func()) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.lastID++
+
+	id := h.lastID
+	subscription := &eventHubSubscription{
+		filter: filter,
+		ch:     make(chan *core.BridgingRequestStateEvent, h.bufferSize),
+	}
+
+	h.subscriptions[id] = subscription
+
+	h.logger.Debug("New subscription", "id", id, "chainID", filter.SourceChainID, "txs", len(filter.SourceTxHashes))
+
+	return subscription.ch, func() {
+		h.unsubscribe(id)
+	}
+}
+
+// OnBridgingRequestStateChanged implements core.BridgingRequestStateListener.
+func (h *BridgingRequestStateEventHub) OnBridgingRequestStateChanged(event *core.BridgingRequestStateEvent) {
+	var slowSubscribers []uint64
+
+	h.lock.RLock()
+
+	for id, subscription := range h.subscriptions {
+		if !subscription.filter.IsMatch(event) {
+			continue
+		}
+
+		select {
+		case subscription.ch <- event:
+		default:
+			slowSubscribers = append(slowSubscribers, id)
+		}
+	}
+
+	h.lock.RUnlock()
+
+	for _, id := range slowSubscribers {
+		h.logger.Warn("Subscriber is too slow. Dropping subscription", "id", id)
+
+		h.unsubscribe(id)
+	}
+}
+
+func (h *BridgingRequestStateEventHub) unsubscribe(id uint64) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if subscription, exists := h.subscriptions[id]; exists {
+		delete(h.subscriptions, id)
+		close(subscription.ch)
+	}
+}
@@ -0,0 +1,109 @@
+package validatorcomponents
+
+import (
+	"testing"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	databaseaccess "github.com/Ethernal-Tech/apex-bridge/validatorcomponents/database_access"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgingRequestStateEventHub(t *testing.T) {
+	txHash1 := common.NewHashFromHexString("0x01")
+	txHash2 := common.NewHashFromHexString("0x02")
+
+	newEvent := func(chainID string, txHash common.Hash) *core.BridgingRequestStateEvent {
+		return &core.BridgingRequestStateEvent{
+			State: *common.NewBridgingRequestState(chainID, txHash, false),
+		}
+	}
+
+	t.Run("filters events", func(t *testing.T) {
+		hub := NewBridgingRequestStateEventHub(10, hclog.NewNullLogger())
+
+		chainCh, unsubscribeChain := hub.Subscribe(core.BridgingRequestStateEventFilter{
+			SourceChainID: common.ChainIDStrPrime,
+		})
+		defer unsubscribeChain()
+
+		txCh, unsubscribeTx := hub.Subscribe(core.BridgingRequestStateEventFilter{
+			SourceChainID:  common.ChainIDStrPrime,
+			SourceTxHashes: []common.Hash{txHash2},
+		})
+		defer unsubscribeTx()
+
+		hub.OnBridgingRequestStateChanged(newEvent(common.ChainIDStrPrime, txHash1))
+		hub.OnBridgingRequestStateChanged(newEvent(common.ChainIDStrVector, txHash2))
+		hub.OnBridgingRequestStateChanged(newEvent(common.ChainIDStrPrime, txHash2))
+
+		require.Len(t, chainCh, 2)
+		require.Len(t, txCh, 1)
+
+		event := <-txCh
+		require.Equal(t, txHash2, event.State.SourceTxHash)
+		require.Equal(t, common.ChainIDStrPrime, event.State.SourceChainID)
+	})
+
+	t.Run("unsubscribe closes channel", func(t *testing.T) {
+		hub := NewBridgingRequestStateEventHub(10, hclog.NewNullLogger())
+
+		ch, unsubscribe := hub.Subscribe(core.BridgingRequestStateEventFilter{SourceChainID: common.ChainIDStrPrime})
+
+		unsubscribe()
+		unsubscribe()
+
+		_, ok := <-ch
+		require.False(t, ok)
+
+		hub.OnBridgingRequestStateChanged(newEvent(common.ChainIDStrPrime, txHash1))
+	})
+
+	t.Run("slow subscriber is dropped", func(t *testing.T) {
+		hub := NewBridgingRequestStateEventHub(1, hclog.NewNullLogger())
+
+		ch, unsubscribe := hub.Subscribe(core.BridgingRequestStateEventFilter{SourceChainID: common.ChainIDStrPrime})
+		defer unsubscribe()
+
+		hub.OnBridgingRequestStateChanged(newEvent(common.ChainIDStrPrime, txHash1))
+		hub.OnBridgingRequestStateChanged(newEvent(common.ChainIDStrPrime, txHash2))
+
+		event, ok := <-ch
+		require.True(t, ok)
+		require.Equal(t, txHash1, event.State.SourceTxHash)
+
+		_, ok = <-ch
+		require.False(t, ok)
+	})
+
+	t.Run("state manager notifies hub", func(t *testing.T) {
+		state := common.NewBridgingRequestState(common.ChainIDStrPrime, txHash1, false)
+
+		db := &databaseaccess.BridgingRequestStateDBMock{}
+		db.On("AddBridgingRequestState", mock.Anything).Return(nil)
+		db.On("GetBridgingRequestState", common.ChainIDStrPrime, txHash1).Return(state, nil)
+		db.On("UpdateBridgingRequestState", mock.Anything).Return(nil)
+
+		hub := NewBridgingRequestStateEventHub(10, hclog.NewNullLogger())
+		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+		sm.AddListener(hub)
+
+		ch, unsubscribe := hub.Subscribe(core.BridgingRequestStateEventFilter{SourceChainID: common.ChainIDStrPrime})
+		defer unsubscribe()
+
+		require.NoError(t, sm.New(common.ChainIDStrPrime, &common.NewBridgingRequestStateModel{SourceTxHash: txHash1}))
+		require.NoError(t, sm.SubmittedToBridge(
+			common.NewBridgingRequestStateKey(common.ChainIDStrPrime, txHash1, false), common.ChainIDStrVector))
+
+		event := <-ch
+		require.Equal(t, common.BridgingRequestStatusDiscoveredOnSource, event.State.Status)
+		require.Equal(t, common.BridgingRequestStatus(""), event.PreviousStatus)
+
+		event = <-ch
+		require.Equal(t, common.BridgingRequestStatusSubmittedToBridge, event.State.Status)
+		require.Equal(t, common.BridgingRequestStatusDiscoveredOnSource, event.PreviousStatus)
+		require.Equal(t, common.ChainIDStrVector, event.State.DestinationChainID)
+	})
+}
@@ -1,8 +1,10 @@
 package validatorcomponents
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
@@ -10,8 +12,9 @@ import (
 )
 
 type BridgingRequestStateManagerImpl struct {
-	db     core.BridgingRequestStateDB
-	logger hclog.Logger
+	db        core.BridgingRequestStateDB
+	listeners []core.BridgingRequestStateListener
+	logger    hclog.Logger
 }
 
 var (
@@ -31,6 +34,11 @@ func NewBridgingRequestStateManager(
 // New implements core.BridgingRequestStateManager.
 func (m *BridgingRequestStateManagerImpl) New(sourceChainID string, model *common.NewBridgingRequestStateModel) error {
 	state := common.NewBridgingRequestState(sourceChainID, model.SourceTxHash, model.IsRefund)
+	state.DestinationChainID = model.DestinationChainID
+	state.SenderAddr = model.SenderAddr
+	state.ReceiverAddrs = model.ReceiverAddrs
+	state.CreatedAt = time.Now().UTC()
+	state.UpdatedAt = state.CreatedAt
 
 	err := m.db.AddBridgingRequestState(state)
 	if err != nil {
@@ -40,9 +48,17 @@ func (m *BridgingRequestStateManagerImpl) New(sourceChainID string, model *commo
 	m.logger.Debug("New BridgingRequestState", "srcChainID", state.SourceChainID,
 		"srcTxHash", state.SourceTxHash, "Status", state.StatusStr())
 
+	m.notifyListeners(state, "", time.Time{})
+
 	return nil
 }
 
+// AddListener registers a listener which is notified after every successfully saved state change.
+// Listeners are called synchronously so they must not block
+func (m *BridgingRequestStateManagerImpl) AddListener(listener core.BridgingRequestStateListener) {
+	m.listeners = append(m.listeners, listener)
+}
+
 // NewMultiple implements core.BridgingRequestStateManager.
 func (m *BridgingRequestStateManagerImpl) NewMultiple(
 	srcChainID string, models []*common.NewBridgingRequestStateModel,
@@ -64,7 +80,7 @@ func (m *BridgingRequestStateManagerImpl) NewMultiple(
 }
 
 // Invalid implements core.BridgingRequestStateManager.
-func (m *BridgingRequestStateManagerImpl) Invalid(key common.BridgingRequestStateKey) error {
+func (m *BridgingRequestStateManagerImpl) Invalid(key common.BridgingRequestStateKey, reason string) error {
 	return m.updateStates([]common.BridgingRequestStateKey{key},
 		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
 			if err := state.IsTransitionPossible(common.BridgingRequestStatusInvalidRequest); err != nil {
@@ -72,6 +88,84 @@ func (m *BridgingRequestStateManagerImpl) Invalid(key common.BridgingRequestStat
 			}
 
 			state.ToInvalidRequest()
+			state.FailureReason = reason
+
+			return nil
+		})
+}
+
+// Requeued implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) Requeued(key common.BridgingRequestStateKey, reason string) error {
+	return m.toDiscoveredOnSource(key, reason)
+}
+
+// Reorged implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) Reorged(key common.BridgingRequestStateKey, reason string) error {
+	return m.toDiscoveredOnSource(key, reason)
+}
+
+// AwaitingConfirmations implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) AwaitingConfirmations(
+	key common.BridgingRequestStateKey, requiredConfirmations uint64,
+) error {
+	return m.updateStates([]common.BridgingRequestStateKey{key},
+		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
+			// the oracle reports held requests on every tick, so only the first report is stored
+			if state.Status != common.BridgingRequestStatusDiscoveredOnSource ||
+				state.RequiredConfirmations == requiredConfirmations {
+				return errSkipTransition
+			}
+
+			state.RequiredConfirmations = requiredConfirmations
+
+			return nil
+		})
+}
+
+// AwaitingApproval implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) AwaitingApproval(key common.BridgingRequestStateKey) error {
+	return m.updateStates([]common.BridgingRequestStateKey{key},
+		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
+			// the oracle reports held requests on every tick, so only the first report is stored
+			if state.Status == common.BridgingRequestStatusAwaitingApproval {
+				return errSkipTransition
+			}
+
+			if err := state.IsTransitionPossible(common.BridgingRequestStatusAwaitingApproval); err != nil {
+				return err
+			}
+
+			state.ToAwaitingApproval()
+
+			return nil
+		})
+}
+
+// AddressScreened implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) AddressScreened(key common.BridgingRequestStateKey, decision string) error {
+	return m.updateStates([]common.BridgingRequestStateKey{key},
+		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
+			// held requests are screened on every tick, so only a changed decision is stored
+			if state.ScreeningDecision == decision {
+				return errSkipTransition
+			}
+
+			state.ScreeningDecision = decision
+
+			return nil
+		})
+}
+
+// ForcedInvalid implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) ForcedInvalid(key common.BridgingRequestStateKey, reason string) error {
+	return m.updateStates([]common.BridgingRequestStateKey{key},
+		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
+			if err := isForcedTransitionPossible(state, common.BridgingRequestStatusInvalidRequest); err != nil {
+				return err
+			}
+
+			state.ToInvalidRequest()
+			state.FailureReason = reason
 
 			return nil
 		})
@@ -91,6 +185,7 @@ func (m *BridgingRequestStateManagerImpl) SubmittedToBridge(
 			}
 
 			state.ToSubmittedToBridge()
+			state.FailureReason = ""
 
 			return nil
 		})
@@ -98,7 +193,7 @@ func (m *BridgingRequestStateManagerImpl) SubmittedToBridge(
 
 // IncludedInBatch implements core.BridgingRequestStateManager.
 func (m *BridgingRequestStateManagerImpl) IncludedInBatch(
-	txs []common.BridgingRequestStateKey, dstChainID string,
+	txs []common.BridgingRequestStateKey, dstChainID string, batchID uint64,
 ) error {
 	return m.updateStates(txs,
 		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
@@ -115,6 +210,8 @@ func (m *BridgingRequestStateManagerImpl) IncludedInBatch(
 			}
 
 			state.ToIncludedInBatch()
+			state.BatchID = batchID
+			state.FailureReason = ""
 
 			return nil
 		})
@@ -122,7 +219,7 @@ func (m *BridgingRequestStateManagerImpl) IncludedInBatch(
 
 // SubmittedToDestination implements core.BridgingRequestStateManager.
 func (m *BridgingRequestStateManagerImpl) SubmittedToDestination(
-	txs []common.BridgingRequestStateKey, dstChainID string,
+	txs []common.BridgingRequestStateKey, dstChainID string, batchID uint64,
 ) error {
 	return m.updateStates(txs,
 		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
@@ -134,6 +231,8 @@ func (m *BridgingRequestStateManagerImpl) SubmittedToDestination(
 			}
 
 			state.ToSubmittedToDestination()
+			state.BatchID = batchID
+			state.FailureReason = ""
 
 			return nil
 		})
@@ -141,7 +240,7 @@ func (m *BridgingRequestStateManagerImpl) SubmittedToDestination(
 
 // FailedToExecuteOnDestination implements core.BridgingRequestStateManager.
 func (m *BridgingRequestStateManagerImpl) FailedToExecuteOnDestination(
-	txs []common.BridgingRequestStateKey, dstChainID string,
+	txs []common.BridgingRequestStateKey, dstChainID string, batchID uint64,
 ) error {
 	return m.updateStates(txs,
 		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
@@ -153,6 +252,8 @@ func (m *BridgingRequestStateManagerImpl) FailedToExecuteOnDestination(
 			}
 
 			state.ToFailedToExecuteOnDestination()
+			state.BatchID = batchID
+			state.FailureReason = fmt.Sprintf("batch %d failed to execute on %s", batchID, dstChainID)
 
 			return nil
 		})
@@ -160,7 +261,7 @@ func (m *BridgingRequestStateManagerImpl) FailedToExecuteOnDestination(
 
 // ExecutedOnDestination implements core.BridgingRequestStateManager.
 func (m *BridgingRequestStateManagerImpl) ExecutedOnDestination(
-	txs []common.BridgingRequestStateKey, dstTxHash common.Hash, dstChainID string,
+	txs []common.BridgingRequestStateKey, dstTxHash common.Hash, dstChainID string, batchID uint64,
 ) error {
 	return m.updateStates(txs,
 		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
@@ -172,6 +273,8 @@ func (m *BridgingRequestStateManagerImpl) ExecutedOnDestination(
 			}
 
 			state.ToExecutedOnDestination(dstTxHash)
+			state.BatchID = batchID
+			state.FailureReason = ""
 
 			return nil
 		})
@@ -190,7 +293,37 @@ func (m *BridgingRequestStateManagerImpl) Get(
 	return state, nil
 }
 
-// GetAllForUser implements core.BridgingRequestStateManager.
+// GetHistory implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) GetHistory(
+	srcChainID string, srcTxHash common.Hash,
+) ([]*common.BridgingRequestStateTransition, error) {
+	history, err := m.db.GetBridgingRequestStateHistory(srcChainID, srcTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BridgingRequestState history (%s, %s), err: %w",
+			srcChainID, srcTxHash, err)
+	}
+
+	return history, nil
+}
+
+// List implements core.BridgingRequestStateManager.
+func (m *BridgingRequestStateManagerImpl) List(
+	filter core.BridgingRequestStateFilter, cursor string, limit int,
+) ([]*common.BridgingRequestState, string, error) {
+	cursorBytes, err := hex.DecodeString(cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	states, nextCursor, err := m.db.ListBridgingRequestStates(filter, cursorBytes, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list BridgingRequestStates: %w", err)
+	}
+
+	return states, hex.EncodeToString(nextCursor), nil
+}
+
+// GetMultiple implements core.BridgingRequestStateManager.
 func (m *BridgingRequestStateManagerImpl) GetMultiple(
 	srcChainID string, srcTxHashes []common.Hash,
 ) ([]*common.BridgingRequestState, error) {
@@ -233,6 +366,8 @@ func (m *BridgingRequestStateManagerImpl) updateStates(
 		if state == nil {
 			// insert bridging request state if not exists in db
 			state = common.NewBridgingRequestState(stateKey.SourceChainID, stateKey.SourceTxHash, stateKey.IsRefund)
+			state.CreatedAt = time.Now().UTC()
+			state.UpdatedAt = state.CreatedAt
 
 			err := m.db.AddBridgingRequestState(state)
 			if err != nil {
@@ -244,6 +379,7 @@ func (m *BridgingRequestStateManagerImpl) updateStates(
 		}
 
 		oldStatus := state.Status
+		oldUpdatedAt := state.UpdatedAt
 
 		err = updateState(stateKey, state)
 		if err != nil {
@@ -259,6 +395,8 @@ func (m *BridgingRequestStateManagerImpl) updateStates(
 			continue
 		}
 
+		state.UpdatedAt = time.Now().UTC()
+
 		err = m.db.UpdateBridgingRequestState(state)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to save updated BridgingRequestState (%s, %s) with status %s: %w",
@@ -267,6 +405,8 @@ func (m *BridgingRequestStateManagerImpl) updateStates(
 			m.logger.Debug("Updated BridgingRequestState",
 				"srcChainID", state.SourceChainID, "srcTxHash", state.SourceTxHash,
 				"Old Status", oldStatus, "New Status", state.StatusStr())
+
+			m.notifyListeners(state, oldStatus, oldUpdatedAt)
 		}
 	}
 
@@ -276,3 +416,47 @@ func (m *BridgingRequestStateManagerImpl) updateStates(
 
 	return nil
 }
+
+func (m *BridgingRequestStateManagerImpl) toDiscoveredOnSource(
+	key common.BridgingRequestStateKey, reason string,
+) error {
+	return m.updateStates([]common.BridgingRequestStateKey{key},
+		func(stateKey common.BridgingRequestStateKey, state *common.BridgingRequestState) error {
+			if err := isForcedTransitionPossible(state, common.BridgingRequestStatusDiscoveredOnSource); err != nil {
+				return err
+			}
+
+			state.Status = common.BridgingRequestStatusDiscoveredOnSource
+			state.IsRefund = stateKey.IsRefund
+			state.FailureReason = reason
+
+			return nil
+		})
+}
+
+func (m *BridgingRequestStateManagerImpl) notifyListeners(
+	state *common.BridgingRequestState, previousStatus common.BridgingRequestStatus, previousUpdatedAt time.Time,
+) {
+	if len(m.listeners) == 0 {
+		return
+	}
+
+	event := &core.BridgingRequestStateEvent{
+		State:             *state,
+		PreviousStatus:    previousStatus,
+		PreviousUpdatedAt: previousUpdatedAt,
+	}
+
+	for _, listener := range m.listeners {
+		listener.OnBridgingRequestStateChanged(event)
+	}
+}
+
+func isForcedTransitionPossible(state *common.BridgingRequestState, newStatus common.BridgingRequestStatus) error {
+	if state.Status == common.BridgingRequestStatusExecutedOnDestination {
+		return fmt.Errorf("BridgingRequestState (%s, %s) invalid transition %s -> %s",
+			state.SourceChainID, state.SourceTxHash, state.StatusStr(), newStatus)
+	}
+
+	return nil
+}
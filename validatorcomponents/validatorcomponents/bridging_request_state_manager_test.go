@@ -83,7 +83,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.Invalid(common.BridgingRequestStateKey{
 			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
-		})
+		}, "test reason")
 		require.ErrorContains(t, err, "failed to get BridgingRequestState")
 
 		db.AssertExpectations(t)
@@ -94,13 +94,13 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		db := &databaseaccess.BridgingRequestStateDBMock{}
 		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(nil, nil)
-		db.On("AddBridgingRequestState", state).Return(fmt.Errorf("test err"))
+		db.On("AddBridgingRequestState", matchNewState(state)).Return(fmt.Errorf("test err"))
 
 		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
 
 		err := sm.Invalid(common.BridgingRequestStateKey{
 			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
-		})
+		}, "test reason")
 		require.ErrorContains(t, err, "BridgingRequestState does not exist")
 
 		db.AssertExpectations(t)
@@ -116,7 +116,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.Invalid(common.BridgingRequestStateKey{
 			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
-		})
+		}, "test reason")
 		require.ErrorContains(t, err, "invalid transition")
 
 		db.AssertExpectations(t)
@@ -133,7 +133,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.Invalid(common.BridgingRequestStateKey{
 			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
-		})
+		}, "test reason")
 		require.ErrorContains(t, err, "failed to update some BridgingRequestStates")
 
 		db.AssertExpectations(t)
@@ -150,7 +150,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.Invalid(common.BridgingRequestStateKey{
 			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
-		})
+		}, "test reason")
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -175,7 +175,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		db := &databaseaccess.BridgingRequestStateDBMock{}
 		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(nil, nil)
-		db.On("AddBridgingRequestState", state).Return(fmt.Errorf("test err"))
+		db.On("AddBridgingRequestState", matchNewState(state)).Return(fmt.Errorf("test err"))
 
 		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
 
@@ -245,7 +245,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.IncludedInBatch([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, common.ChainIDStrVector)
+		}, common.ChainIDStrVector, 1)
 
 		require.ErrorContains(t, err, "failed to get")
 
@@ -257,7 +257,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
 
-		err := sm.IncludedInBatch([]common.BridgingRequestStateKey{}, common.ChainIDStrVector)
+		err := sm.IncludedInBatch([]common.BridgingRequestStateKey{}, common.ChainIDStrVector, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -274,7 +274,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.IncludedInBatch([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, common.ChainIDStrVector)
+		}, common.ChainIDStrVector, 1)
 
 		require.ErrorContains(t, err, "failed to save updated")
 
@@ -292,7 +292,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.IncludedInBatch([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, common.ChainIDStrVector)
+		}, common.ChainIDStrVector, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -309,7 +309,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.IncludedInBatch([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, common.ChainIDStrVector)
+		}, common.ChainIDStrVector, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -323,7 +323,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.SubmittedToDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to get BridgingRequestState")
 
 		db.AssertExpectations(t)
@@ -333,7 +333,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 		db := &databaseaccess.BridgingRequestStateDBMock{}
 		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
 
-		err := sm.SubmittedToDestination(nil, dstChainID)
+		err := sm.SubmittedToDestination(nil, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -350,7 +350,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.SubmittedToDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to update")
 
 		db.AssertExpectations(t)
@@ -367,7 +367,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.SubmittedToDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to save updated")
 
 		db.AssertExpectations(t)
@@ -384,7 +384,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.SubmittedToDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -394,7 +394,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 		state := common.NewBridgingRequestState(srcChainID, srcTxHash, false)
 
 		db := &databaseaccess.BridgingRequestStateDBMock{}
-		db.On("AddBridgingRequestState", state).Return(nil)
+		db.On("AddBridgingRequestState", matchNewState(state)).Return(nil)
 		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(nil, nil)
 		db.On("UpdateBridgingRequestState", mock.Anything).Return(nil)
 
@@ -402,7 +402,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.SubmittedToDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -416,7 +416,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.FailedToExecuteOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to get BridgingRequestState from db")
 
 		db.AssertExpectations(t)
@@ -426,7 +426,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 		db := &databaseaccess.BridgingRequestStateDBMock{}
 		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
 
-		err := sm.FailedToExecuteOnDestination(nil, dstChainID)
+		err := sm.FailedToExecuteOnDestination(nil, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -443,7 +443,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.FailedToExecuteOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to update")
 
 		db.AssertExpectations(t)
@@ -460,7 +460,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.FailedToExecuteOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to save updated")
 
 		db.AssertExpectations(t)
@@ -477,7 +477,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.FailedToExecuteOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -487,7 +487,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 		state := common.NewBridgingRequestState(srcChainID, srcTxHash, false)
 
 		db := &databaseaccess.BridgingRequestStateDBMock{}
-		db.On("AddBridgingRequestState", state).Return(nil)
+		db.On("AddBridgingRequestState", matchNewState(state)).Return(nil)
 		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(nil, nil)
 		db.On("UpdateBridgingRequestState", mock.Anything).Return(nil)
 
@@ -495,7 +495,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.FailedToExecuteOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, dstChainID)
+		}, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -509,7 +509,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.ExecutedOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, txHash, dstChainID)
+		}, txHash, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to get BridgingRequestState from db")
 
 		db.AssertExpectations(t)
@@ -519,7 +519,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 		db := &databaseaccess.BridgingRequestStateDBMock{}
 		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
 
-		err := sm.ExecutedOnDestination([]common.BridgingRequestStateKey{}, common.Hash{}, dstChainID)
+		err := sm.ExecutedOnDestination([]common.BridgingRequestStateKey{}, common.Hash{}, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -536,7 +536,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.ExecutedOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, txHash, dstChainID)
+		}, txHash, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to update some BridgingRequestStates: failed to update")
 
 		db.AssertExpectations(t)
@@ -553,7 +553,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.ExecutedOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, txHash, dstChainID)
+		}, txHash, dstChainID, 1)
 		require.ErrorContains(t, err, "failed to update some BridgingRequestStates: failed to save updated")
 
 		db.AssertExpectations(t)
@@ -570,7 +570,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.ExecutedOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, txHash, dstChainID)
+		}, txHash, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -580,7 +580,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 		state := common.NewBridgingRequestState(srcChainID, srcTxHash, false)
 
 		db := &databaseaccess.BridgingRequestStateDBMock{}
-		db.On("AddBridgingRequestState", state).Return(nil)
+		db.On("AddBridgingRequestState", matchNewState(state)).Return(nil)
 		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(nil, nil)
 		db.On("UpdateBridgingRequestState", mock.Anything).Return(nil)
 
@@ -588,7 +588,7 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		err := sm.ExecutedOnDestination([]common.BridgingRequestStateKey{
 			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
-		}, txHash, dstChainID)
+		}, txHash, dstChainID, 1)
 		require.NoError(t, err)
 
 		db.AssertExpectations(t)
@@ -679,4 +679,176 @@ func TestBridgingRequestStateManager(t *testing.T) {
 
 		db.AssertExpectations(t)
 	})
+
+	t.Run("FailedToExecuteOnDestination sets batch and reason", func(t *testing.T) {
+		state := common.NewBridgingRequestState(srcChainID, srcTxHash, false)
+		state.ToSubmittedToDestination()
+
+		db := &databaseaccess.BridgingRequestStateDBMock{}
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(state, nil)
+		db.On("UpdateBridgingRequestState", mock.MatchedBy(func(s *common.BridgingRequestState) bool {
+			return s.Status == common.BridgingRequestStatusFailedToExecuteOnDestination &&
+				s.BatchID == 7 && s.FailureReason != "" && !s.UpdatedAt.IsZero()
+		})).Return(nil)
+
+		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+
+		err := sm.FailedToExecuteOnDestination([]common.BridgingRequestStateKey{
+			common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false),
+		}, dstChainID, 7)
+		require.NoError(t, err)
+
+		db.AssertExpectations(t)
+	})
+
+	t.Run("Requeued and ForcedInvalid", func(t *testing.T) {
+		key := common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false)
+		submittedState := &common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusSubmittedToBridge,
+		}
+		executedState := &common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusExecutedOnDestination,
+		}
+
+		db := &databaseaccess.BridgingRequestStateDBMock{}
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(submittedState, nil).Once()
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(executedState, nil).Once()
+		db.On("UpdateBridgingRequestState", mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+			return state.Status == common.BridgingRequestStatusDiscoveredOnSource &&
+				state.FailureReason == "requeue reason"
+		})).Return(nil).Once()
+
+		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+
+		require.NoError(t, sm.Requeued(key, "requeue reason"))
+		require.ErrorContains(t, sm.ForcedInvalid(key, "invalid reason"), "invalid transition")
+
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusSubmittedToBridge,
+		}, nil).Once()
+		db.On("UpdateBridgingRequestState", mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+			return state.Status == common.BridgingRequestStatusInvalidRequest &&
+				state.FailureReason == "invalid reason"
+		})).Return(nil).Once()
+
+		require.NoError(t, sm.ForcedInvalid(key, "invalid reason"))
+
+		db.AssertExpectations(t)
+	})
+
+	t.Run("AwaitingConfirmations", func(t *testing.T) {
+		key := common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false)
+
+		db := &databaseaccess.BridgingRequestStateDBMock{}
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusDiscoveredOnSource,
+		}, nil).Once()
+		db.On("UpdateBridgingRequestState", mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+			return state.Status == common.BridgingRequestStatusDiscoveredOnSource &&
+				state.RequiredConfirmations == 20
+		})).Return(nil).Once()
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status:                common.BridgingRequestStatusDiscoveredOnSource,
+			RequiredConfirmations: 20,
+		}, nil).Once()
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusSubmittedToBridge,
+		}, nil).Once()
+
+		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+
+		require.NoError(t, sm.AwaitingConfirmations(key, 20))
+		// already stored and not held anymore are skipped
+		require.NoError(t, sm.AwaitingConfirmations(key, 20))
+		require.NoError(t, sm.AwaitingConfirmations(key, 20))
+
+		db.AssertExpectations(t)
+	})
+
+	t.Run("AwaitingApproval", func(t *testing.T) {
+		key := common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false)
+
+		db := &databaseaccess.BridgingRequestStateDBMock{}
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusDiscoveredOnSource,
+		}, nil).Once()
+		db.On("UpdateBridgingRequestState", mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+			return state.Status == common.BridgingRequestStatusAwaitingApproval
+		})).Return(nil).Once()
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusAwaitingApproval,
+		}, nil).Once()
+
+		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+
+		require.NoError(t, sm.AwaitingApproval(key))
+		// already held requests are skipped
+		require.NoError(t, sm.AwaitingApproval(key))
+
+		db.AssertExpectations(t)
+	})
+
+	t.Run("AddressScreened", func(t *testing.T) {
+		key := common.NewBridgingRequestStateKey(srcChainID, srcTxHash, false)
+
+		db := &databaseaccess.BridgingRequestStateDBMock{}
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status: common.BridgingRequestStatusDiscoveredOnSource,
+		}, nil).Once()
+		db.On("UpdateBridgingRequestState", mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+			return state.Status == common.BridgingRequestStatusDiscoveredOnSource &&
+				state.ScreeningDecision == "blocked"
+		})).Return(nil).Once()
+		db.On("GetBridgingRequestState", srcChainID, srcTxHash).Return(&common.BridgingRequestState{
+			SourceChainID: srcChainID, SourceTxHash: srcTxHash,
+			Status:            common.BridgingRequestStatusDiscoveredOnSource,
+			ScreeningDecision: "blocked",
+		}, nil).Once()
+
+		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+
+		require.NoError(t, sm.AddressScreened(key, "blocked"))
+		// the same decision is skipped
+		require.NoError(t, sm.AddressScreened(key, "blocked"))
+
+		db.AssertExpectations(t)
+	})
+
+	t.Run("GetHistory", func(t *testing.T) {
+		history := []*common.BridgingRequestStateTransition{
+			{Status: common.BridgingRequestStatusDiscoveredOnSource},
+			{Status: common.BridgingRequestStatusInvalidRequest, FailureReason: "reason"},
+		}
+
+		db := &databaseaccess.BridgingRequestStateDBMock{}
+		db.On("GetBridgingRequestStateHistory", srcChainID, srcTxHash).Return(history, nil).Once()
+		db.On("GetBridgingRequestStateHistory", srcChainID, srcTxHash).Return(nil, fmt.Errorf("test err")).Once()
+
+		sm := NewBridgingRequestStateManager(db, hclog.NewNullLogger())
+
+		result, err := sm.GetHistory(srcChainID, srcTxHash)
+		require.NoError(t, err)
+		require.Equal(t, history, result)
+
+		_, err = sm.GetHistory(srcChainID, srcTxHash)
+		require.ErrorContains(t, err, "failed to get BridgingRequestState history")
+
+		db.AssertExpectations(t)
+	})
+}
+
+func matchNewState(expected *common.BridgingRequestState) interface{} {
+	return mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+		return state.SourceChainID == expected.SourceChainID && state.SourceTxHash == expected.SourceTxHash &&
+			state.Status == expected.Status && state.IsRefund == expected.IsRefund && !state.UpdatedAt.IsZero()
+	})
 }
@@ -0,0 +1,104 @@
+package validatorcomponents
+
+import (
+	"errors"
+	"fmt"
+
+	cardanoOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
+	cardanoSuccessProcessors "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/processor/tx_processors/success"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	ethSuccessProcessors "github.com/Ethernal-Tech/apex-bridge/oracle_eth/processor/tx_processors/success"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/hashicorp/go-hclog"
+)
+
+// BridgingRequestValidatorImpl runs the oracle bridging request processors validation on a request
+// that is not submitted yet. Nothing is written to the database
+type BridgingRequestValidatorImpl struct {
+	oracleConfig     *oracleCommonCore.AppConfig
+	cardanoProcessor *cardanoSuccessProcessors.BridgingRequestedProcessorImpl
+	ethProcessor     *ethSuccessProcessors.BridgingRequestedProcessorImpl
+}
+
+var _ core.BridgingRequestValidator = (*BridgingRequestValidatorImpl)(nil)
+
+func NewBridgingRequestValidator(
+	oracleConfig *oracleCommonCore.AppConfig, addressScreener oracleCommonCore.AddressScreener, logger hclog.Logger,
+) *BridgingRequestValidatorImpl {
+	// try counts of a request that is not submitted yet are always zero,
+	// so the refund processors pre validation would never fail
+	return &BridgingRequestValidatorImpl{
+		oracleConfig: oracleConfig,
+		cardanoProcessor: cardanoSuccessProcessors.NewBridgingRequestedProcessor(
+			cardanoSuccessProcessors.NewRefundDisabledProcessor(), addressScreener, logger),
+		ethProcessor: ethSuccessProcessors.NewEthBridgingRequestedProcessor(
+			ethSuccessProcessors.NewRefundDisabledProcessor(), addressScreener, logger),
+	}
+}
+
+// Validate implements core.BridgingRequestValidator.
+func (v *BridgingRequestValidatorImpl) Validate(request *core.BridgingRequestValidationRequest) error {
+	if _, exists := v.oracleConfig.CardanoChains[request.SourceChainID]; exists {
+		// the sender in the metadata is set by the user, so the processor screens the input addresses as well
+		if len(request.InputAddrs) == 0 {
+			return errors.New("input addresses are required for cardano source chains")
+		}
+
+		inputs := make([]*indexer.TxInputOutput, len(request.InputAddrs))
+
+		for i, addr := range request.InputAddrs {
+			inputs[i] = &indexer.TxInputOutput{
+				Output: indexer.TxOutput{Address: addr},
+			}
+		}
+
+		outputs := make([]*indexer.TxOutput, len(request.Outputs))
+
+		for i, x := range request.Outputs {
+			outputs[i] = &indexer.TxOutput{
+				Address: x.Address,
+				Amount:  x.Amount,
+				Tokens:  make([]indexer.TokenAmount, len(x.Tokens)),
+			}
+
+			for j, tokenAmount := range x.Tokens {
+				token, err := cardanowallet.NewTokenWithFullName(tokenAmount.Name, true)
+				if err != nil {
+					return fmt.Errorf("invalid token of output %s: %w", x.Address, err)
+				}
+
+				outputs[i].Tokens[j] = indexer.TokenAmount{
+					PolicyID: token.PolicyID,
+					Name:     token.Name,
+					Amount:   tokenAmount.Amount,
+				}
+			}
+		}
+
+		return v.cardanoProcessor.Validate(&cardanoOracleCore.CardanoTx{
+			OriginChainID: request.SourceChainID,
+			Tx: indexer.Tx{
+				Metadata: request.Metadata,
+				Inputs:   inputs,
+				Outputs:  outputs,
+			},
+		}, v.oracleConfig)
+	}
+
+	if _, exists := v.oracleConfig.EthChains[request.SourceChainID]; exists {
+		if request.Value == nil {
+			return errors.New("value is required for evm source chains")
+		}
+
+		return v.ethProcessor.Validate(&ethOracleCore.EthTx{
+			OriginChainID: request.SourceChainID,
+			Metadata:      request.Metadata,
+			Value:         request.Value,
+		}, v.oracleConfig)
+	}
+
+	return fmt.Errorf("origin chain not registered: %v", request.SourceChainID)
+}
@@ -0,0 +1,250 @@
+package validatorcomponents
+
+import (
+	"math/big"
+	"testing"
+
+	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/Ethernal-Tech/cardano-infrastructure/sendtx"
+	"github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgingRequestValidator(t *testing.T) {
+	const (
+		utxoMinValue         = 1000000
+		minFeeForBridging    = 1000010
+		primeBridgingAddr    = "addr_test1vq6xsx99frfepnsjuhzac48vl9s2lc9awkvfknkgs89srqqslj660"
+		primeBridgingFeeAddr = "addr_test1vqqj5apwf5npsmudw0ranypkj9jw98t25wk4h83jy5mwypswekttt"
+		nexusBridgingAddr    = "0xA4d1233A67776575425Ab185f6a9251aa00fEA25"
+		validTestAddress     = "addr_test1vq6zkfat4rlmj2nd2sylpjjg5qhcg9mk92wykaw4m2dp2rqneafvl"
+		validEthAddress      = "0x6b1E2A4Ee7C1Ca3c5F32Be4D1E9A8C44e2a0F7b1"
+		erc20Address         = "0x1111111111111111111111111111111111111111"
+	)
+
+	token := wallet.NewToken("29f8873beb52e126f207a2dfd50f7cff556806b5b4cba9834a7b26a8", "Route3")
+
+	oracleConfig := &oracleCommonCore.AppConfig{
+		CardanoChains: map[string]*oracleCommonCore.CardanoChainConfig{
+			common.ChainIDStrPrime: {
+				BridgingAddresses: oracleCommonCore.BridgingAddresses{
+					BridgingAddress: primeBridgingAddr,
+					FeeAddress:      primeBridgingFeeAddr,
+				},
+				CardanoChainConfig: cardanotx.CardanoChainConfig{
+					NetworkID:     wallet.TestNetNetwork,
+					UtxoMinAmount: utxoMinValue,
+				},
+				MinFeeForBridging: minFeeForBridging,
+			},
+		},
+		EthChains: map[string]*oracleCommonCore.EthChainConfig{
+			common.ChainIDStrNexus: {
+				BridgingAddresses: oracleCommonCore.EthBridgingAddresses{
+					BridgingAddress: nexusBridgingAddr,
+				},
+				MinFeeForBridging: minFeeForBridging,
+			},
+		},
+		BridgingSettings: oracleCommonCore.BridgingSettings{
+			MaxReceiversPerBridgingRequest: 3,
+			MaxAmountAllowedToBridge:       new(big.Int).SetUint64(100000000),
+			AllowedDirections: map[string][]string{
+				common.ChainIDStrPrime: {common.ChainIDStrNexus},
+				common.ChainIDStrNexus: {common.ChainIDStrPrime},
+			},
+			NativeTokens: oracleCommonCore.NativeTokens{
+				{
+					CardanoChainID: common.ChainIDStrPrime,
+					TokenName:      token.String(),
+					EthChainID:     common.ChainIDStrNexus,
+					ERC20Address:   erc20Address,
+				},
+			},
+		},
+	}
+	oracleConfig.FillOut()
+
+	getEthMetadata := func(t *testing.T, destinationChainID string, amounts ...uint64) []byte {
+		t.Helper()
+
+		receivers := []ethOracleCore.BridgingRequestEthMetadataTransaction{
+			{Address: primeBridgingFeeAddr, Amount: common.DfmToWei(new(big.Int).SetUint64(minFeeForBridging))},
+		}
+
+		for _, amount := range amounts {
+			receivers = append(receivers, ethOracleCore.BridgingRequestEthMetadataTransaction{
+				Address: validTestAddress, Amount: common.DfmToWei(new(big.Int).SetUint64(amount)),
+			})
+		}
+
+		metadata, err := ethOracleCore.MarshalEthMetadata(ethOracleCore.BridgingRequestEthMetadata{
+			BridgingTxType:     common.BridgingTxTypeBridgingRequest,
+			DestinationChainID: destinationChainID,
+			SenderAddr:         "addr1",
+			Transactions:       receivers,
+			FeeAmount:          big.NewInt(0),
+		})
+		require.NoError(t, err)
+
+		return metadata
+	}
+
+	validator := NewBridgingRequestValidator(oracleConfig, nil, hclog.NewNullLogger())
+
+	t.Run("unknown source chain", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: "unknown",
+			Metadata:      getEthMetadata(t, common.ChainIDStrPrime, utxoMinValue),
+		})
+		require.ErrorContains(t, err, "origin chain not registered")
+	})
+
+	t.Run("evm value missing", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrNexus,
+			Metadata:      getEthMetadata(t, common.ChainIDStrPrime, utxoMinValue),
+		})
+		require.ErrorContains(t, err, "value is required")
+	})
+
+	t.Run("evm invalid metadata", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrNexus,
+			Metadata:      []byte("{"),
+			Value:         big.NewInt(1),
+		})
+		require.ErrorContains(t, err, "failed to unmarshal metadata")
+	})
+
+	t.Run("evm direction not allowed", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrNexus,
+			Metadata:      getEthMetadata(t, common.ChainIDStrVector, utxoMinValue),
+			Value:         common.DfmToWei(new(big.Int).SetUint64(utxoMinValue + minFeeForBridging)),
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("evm too many receivers", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrNexus,
+			Metadata:      getEthMetadata(t, common.ChainIDStrPrime, utxoMinValue, utxoMinValue, utxoMinValue),
+			Value:         common.DfmToWei(new(big.Int).SetUint64(3*utxoMinValue + minFeeForBridging)),
+		})
+		require.ErrorContains(t, err, "number of receivers in metadata greater than maximum allowed")
+	})
+
+	t.Run("evm value mismatch", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrNexus,
+			Metadata:      getEthMetadata(t, common.ChainIDStrPrime, utxoMinValue),
+			Value:         common.DfmToWei(new(big.Int).SetUint64(utxoMinValue)),
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("evm valid", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrNexus,
+			Metadata:      getEthMetadata(t, common.ChainIDStrPrime, utxoMinValue),
+			Value:         common.DfmToWei(new(big.Int).SetUint64(utxoMinValue + minFeeForBridging)),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("cardano invalid metadata", func(t *testing.T) {
+		err := validator.Validate(&core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrPrime,
+			Metadata:      []byte{0x1},
+			InputAddrs:    []string{validTestAddress},
+			Outputs: []core.BridgingRequestValidationOutput{
+				{Address: primeBridgingAddr, Amount: utxoMinValue},
+			},
+		})
+		require.ErrorContains(t, err, "failed to unmarshal metadata")
+	})
+
+	t.Run("cardano native tokens", func(t *testing.T) {
+		metadata, err := common.SimulateRealMetadata(common.MetadataEncodingTypeCbor, common.BridgingRequestMetadata{
+			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
+			DestinationChainID: common.ChainIDStrNexus,
+			SenderAddr:         sendtx.AddrToMetaDataAddr(validTestAddress),
+			Transactions: []common.BridgingRequestMetadataTransaction{
+				{Address: common.SplitString(common.EthZeroAddr, 40), Amount: minFeeForBridging},
+				{
+					Address:     common.SplitString(validEthAddress, 40),
+					Amount:      utxoMinValue,
+					Token:       common.SplitString(token.String(), 40),
+					TokenAmount: 10,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		request := &core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrPrime,
+			Metadata:      metadata,
+			InputAddrs:    []string{validTestAddress},
+			Outputs: []core.BridgingRequestValidationOutput{
+				{
+					Address: primeBridgingAddr,
+					Amount:  minFeeForBridging + utxoMinValue,
+					Tokens: []core.BridgingRequestValidationToken{
+						{Name: token.String(), Amount: 10},
+					},
+				},
+			},
+		}
+
+		require.NoError(t, validator.Validate(request))
+
+		request.Outputs[0].Tokens = nil
+
+		require.ErrorContains(t, validator.Validate(request), "multisig tokens are not equal to sum of receiver tokens")
+
+		request.Outputs[0].Tokens = []core.BridgingRequestValidationToken{{Name: "Route3", Amount: 10}}
+
+		require.ErrorContains(t, validator.Validate(request), "invalid token of output")
+	})
+
+	t.Run("cardano input addresses", func(t *testing.T) {
+		const blockedAddr = "addr_test1vqeux7xwusdju9dvsj8h7mca9aup2k439kfmwy773xxc2hcu7zy99"
+
+		metadata, err := common.SimulateRealMetadata(common.MetadataEncodingTypeCbor, common.BridgingRequestMetadata{
+			BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
+			DestinationChainID: common.ChainIDStrNexus,
+			SenderAddr:         sendtx.AddrToMetaDataAddr(validTestAddress),
+			Transactions: []common.BridgingRequestMetadataTransaction{
+				{Address: common.SplitString(common.EthZeroAddr, 40), Amount: minFeeForBridging},
+				{Address: common.SplitString(validEthAddress, 40), Amount: utxoMinValue},
+			},
+		})
+		require.NoError(t, err)
+
+		addressScreenerMock := &oracleCommonCore.AddressScreenerMock{}
+		addressScreenerMock.On("GetBlockedAddress", []string{validTestAddress, blockedAddr, validEthAddress}).
+			Return(blockedAddr)
+
+		validator := NewBridgingRequestValidator(oracleConfig, addressScreenerMock, hclog.NewNullLogger())
+		request := &core.BridgingRequestValidationRequest{
+			SourceChainID: common.ChainIDStrPrime,
+			Metadata:      metadata,
+			Outputs: []core.BridgingRequestValidationOutput{
+				{Address: primeBridgingAddr, Amount: minFeeForBridging + utxoMinValue},
+			},
+		}
+
+		require.ErrorContains(t, validator.Validate(request), "input addresses are required")
+
+		request.InputAddrs = []string{validTestAddress, blockedAddr}
+
+		require.ErrorContains(t, validator.Validate(request), blockedAddr)
+		addressScreenerMock.AssertExpectations(t)
+	})
+}
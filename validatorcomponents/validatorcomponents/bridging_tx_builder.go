@@ -0,0 +1,230 @@
+package validatorcomponents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
+	"github.com/Ethernal-Tech/apex-bridge/oracle_cardano/utils"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/Ethernal-Tech/cardano-infrastructure/sendtx"
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+)
+
+// BridgingTxBuilderImpl builds unsigned bridging transactions so the wallets
+// do not have to reimplement the bridging metadata and the gateway call
+type BridgingTxBuilderImpl struct {
+	oracleConfig *oracleCommonCore.AppConfig
+}
+
+var _ core.BridgingTxBuilder = (*BridgingTxBuilderImpl)(nil)
+
+func NewBridgingTxBuilder(oracleConfig *oracleCommonCore.AppConfig) *BridgingTxBuilderImpl {
+	return &BridgingTxBuilderImpl{
+		oracleConfig: oracleConfig,
+	}
+}
+
+// BuildCardanoTx implements core.BridgingTxBuilder.
+func (b *BridgingTxBuilderImpl) BuildCardanoTx(
+	ctx context.Context, request *core.CardanoBridgingTxRequest,
+) (*core.CardanoBridgingTx, error) {
+	srcConfig, exists := b.oracleConfig.CardanoChains[request.SourceChainID]
+	if !exists {
+		return nil, fmt.Errorf("source chain is not a registered cardano chain: %s", request.SourceChainID)
+	}
+
+	dstMinFeeForBridging, err := b.checkDirection(request.SourceChainID, request.DestinationChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.checkReceivers(request.Receivers); err != nil {
+		return nil, err
+	}
+
+	receivers := make([]sendtx.BridgingTxReceiver, len(request.Receivers))
+
+	for i, x := range request.Receivers {
+		if !x.Amount.IsUint64() {
+			return nil, fmt.Errorf("invalid amount for receiver %s: %s", x.Address, x.Amount)
+		}
+
+		receivers[i] = sendtx.BridgingTxReceiver{
+			Addr:   x.Address,
+			Amount: x.Amount.Uint64(),
+		}
+	}
+
+	txProvider, err := srcConfig.CreateTxProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tx provider: %w", err)
+	}
+
+	defer txProvider.Dispose()
+
+	txSender := sendtx.NewTxSender(
+		map[string]sendtx.ChainConfig{
+			request.SourceChainID: {
+				CardanoCliBinary:     cardanowallet.ResolveCardanoCliBinary(srcConfig.NetworkID),
+				TxProvider:           txProvider,
+				MultiSigAddr:         srcConfig.BridgingAddresses.BridgingAddress,
+				TestNetMagic:         uint(srcConfig.NetworkMagic),
+				TTLSlotNumberInc:     srcConfig.TTLSlotNumberInc,
+				MinUtxoValue:         srcConfig.UtxoMinAmount,
+				MinBridgingFeeAmount: srcConfig.MinFeeForBridging,
+			},
+			request.DestinationChainID: {
+				MinBridgingFeeAmount: dstMinFeeForBridging,
+			},
+		},
+		sendtx.WithUtxosTransformer(newSenderUtxosTransformer(request.SenderUtxos)),
+	)
+
+	txInfo, metadata, err := txSender.CreateBridgingTx(ctx, sendtx.BridgingTxDto{
+		SrcChainID:  request.SourceChainID,
+		DstChainID:  request.DestinationChainID,
+		SenderAddr:  request.SenderAddr,
+		Receivers:   receivers,
+		BridgingFee: request.BridgingFee,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bridging tx: %w", err)
+	}
+
+	return &core.CardanoBridgingTx{
+		TxRaw:       txInfo.TxRaw,
+		TxHash:      txInfo.TxHash,
+		BridgingFee: metadata.BridgingFee,
+	}, nil
+}
+
+// BuildEvmTx implements core.BridgingTxBuilder.
+func (b *BridgingTxBuilderImpl) BuildEvmTx(request *core.EvmBridgingTxRequest) (*core.EvmBridgingTx, error) {
+	srcConfig, exists := b.oracleConfig.EthChains[request.SourceChainID]
+	if !exists {
+		return nil, fmt.Errorf("source chain is not a registered evm chain: %s", request.SourceChainID)
+	}
+
+	if _, err := b.checkDirection(request.SourceChainID, request.DestinationChainID); err != nil {
+		return nil, err
+	}
+
+	if err := b.checkReceivers(request.Receivers); err != nil {
+		return nil, err
+	}
+
+	if request.FeeAmount == nil || request.FeeAmount.Sign() <= 0 {
+		return nil, errors.New("fee amount must be greater than zero")
+	}
+
+	destinationChainID := common.ToNumChainID(request.DestinationChainID)
+	if destinationChainID == 0 {
+		return nil, fmt.Errorf("destination chain not registered: %s", request.DestinationChainID)
+	}
+
+	receivers := make([]contractbinding.IGatewayStructsReceiverWithdraw, len(request.Receivers))
+	value := new(big.Int).Set(request.FeeAmount)
+
+	for i, x := range request.Receivers {
+		receivers[i] = contractbinding.IGatewayStructsReceiverWithdraw{
+			Receiver:    x.Address,
+			Amount:      x.Amount,
+			TokenAmount: big.NewInt(0),
+		}
+
+		value.Add(value, x.Amount)
+	}
+
+	abi, err := contractbinding.GetContractsABI(srcConfig.LegacyContractsABI).GatewayMetaData().GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := abi.Pack("withdraw", destinationChainID, receivers, request.FeeAmount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack withdraw call: %w", err)
+	}
+
+	return &core.EvmBridgingTx{
+		To:    srcConfig.BridgingAddresses.BridgingAddress,
+		Data:  data,
+		Value: value,
+	}, nil
+}
+
+// checkDirection returns the minimal bridging fee of the destination chain if the direction is allowed
+func (b *BridgingTxBuilderImpl) checkDirection(srcChainID, dstChainID string) (uint64, error) {
+	if err := utils.IsTxDirectionAllowed(b.oracleConfig, srcChainID, dstChainID); err != nil {
+		return 0, err
+	}
+
+	if config, exists := b.oracleConfig.CardanoChains[dstChainID]; exists {
+		return config.MinFeeForBridging, nil
+	}
+
+	if config, exists := b.oracleConfig.EthChains[dstChainID]; exists {
+		return config.MinFeeForBridging, nil
+	}
+
+	return 0, fmt.Errorf("destination chain not registered: %s", dstChainID)
+}
+
+func (b *BridgingTxBuilderImpl) checkReceivers(receivers []core.BridgingTxReceiver) error {
+	if len(receivers) == 0 {
+		return errors.New("no receivers")
+	}
+
+	if len(receivers) > b.oracleConfig.BridgingSettings.MaxReceiversPerBridgingRequest {
+		return fmt.Errorf("number of receivers greater than maximum allowed - no: %v, max: %v",
+			len(receivers), b.oracleConfig.BridgingSettings.MaxReceiversPerBridgingRequest)
+	}
+
+	for _, x := range receivers {
+		if x.Amount == nil || x.Amount.Sign() <= 0 {
+			return fmt.Errorf("amount for receiver %s must be greater than zero", x.Address)
+		}
+	}
+
+	return nil
+}
+
+// senderUtxosTransformer keeps only the utxos chosen by the sender. If none are chosen, all the utxos are kept
+type senderUtxosTransformer struct {
+	inputs map[core.BridgingTxInput]struct{}
+}
+
+var _ sendtx.IUtxosTransformer = (*senderUtxosTransformer)(nil)
+
+func newSenderUtxosTransformer(inputs []core.BridgingTxInput) *senderUtxosTransformer {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	result := &senderUtxosTransformer{
+		inputs: make(map[core.BridgingTxInput]struct{}, len(inputs)),
+	}
+
+	for _, x := range inputs {
+		result.inputs[x] = struct{}{}
+	}
+
+	return result
+}
+
+// TransformUtxos implements sendtx.IUtxosTransformer.
+func (t *senderUtxosTransformer) TransformUtxos(utxos []cardanowallet.Utxo) []cardanowallet.Utxo {
+	result := make([]cardanowallet.Utxo, 0, len(t.inputs))
+
+	for _, utxo := range utxos {
+		if _, exists := t.inputs[core.BridgingTxInput{Hash: utxo.Hash, Index: utxo.Index}]; exists {
+			result = append(result, utxo)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,179 @@
+package validatorcomponents
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgingTxBuilder(t *testing.T) {
+	const (
+		gatewayAddr  = "0xA4d1233A67776575425Ab185f6a9251aa00fEA25"
+		receiverAddr = "addr_test1vq6zkfat4rlmj2nd2sylpjjg5qhcg9mk92wykaw4m2dp2rqneafvl"
+	)
+
+	oracleConfig := &oracleCommonCore.AppConfig{
+		CardanoChains: map[string]*oracleCommonCore.CardanoChainConfig{
+			common.ChainIDStrPrime: {},
+		},
+		EthChains: map[string]*oracleCommonCore.EthChainConfig{
+			common.ChainIDStrNexus: {
+				BridgingAddresses: oracleCommonCore.EthBridgingAddresses{
+					BridgingAddress: gatewayAddr,
+				},
+			},
+		},
+		BridgingSettings: oracleCommonCore.BridgingSettings{
+			MaxReceiversPerBridgingRequest: 2,
+			AllowedDirections: map[string][]string{
+				common.ChainIDStrPrime: {common.ChainIDStrNexus},
+				common.ChainIDStrNexus: {common.ChainIDStrPrime},
+			},
+		},
+	}
+	oracleConfig.FillOut()
+
+	builder := NewBridgingTxBuilder(oracleConfig)
+
+	t.Run("BuildEvmTx valid", func(t *testing.T) {
+		receivers := []core.BridgingTxReceiver{
+			{Address: receiverAddr, Amount: big.NewInt(2_000_000_000_000_000_000)},
+		}
+		feeAmount := big.NewInt(1_000_000_000_000_000_000)
+
+		tx, err := builder.BuildEvmTx(&core.EvmBridgingTxRequest{
+			SourceChainID:      common.ChainIDStrNexus,
+			DestinationChainID: common.ChainIDStrPrime,
+			Receivers:          receivers,
+			FeeAmount:          feeAmount,
+		})
+		require.NoError(t, err)
+		require.Equal(t, gatewayAddr, tx.To)
+		require.Equal(t, big.NewInt(3_000_000_000_000_000_000), tx.Value)
+
+		abi, err := contractbinding.GatewayMetaData.GetAbi()
+		require.NoError(t, err)
+
+		method := abi.Methods["withdraw"]
+		require.Equal(t, method.ID, tx.Data[:4])
+
+		args, err := method.Inputs.Unpack(tx.Data[4:])
+		require.NoError(t, err)
+		require.Len(t, args, 3)
+		require.Equal(t, common.ToNumChainID(common.ChainIDStrPrime), args[0])
+		require.Equal(t, feeAmount, args[2])
+	})
+
+	t.Run("BuildEvmTx invalid requests", func(t *testing.T) {
+		validReceivers := []core.BridgingTxReceiver{{Address: receiverAddr, Amount: big.NewInt(1)}}
+
+		for _, x := range []struct {
+			name    string
+			request *core.EvmBridgingTxRequest
+			errStr  string
+		}{
+			{
+				name: "cardano source",
+				request: &core.EvmBridgingTxRequest{
+					SourceChainID: common.ChainIDStrPrime, DestinationChainID: common.ChainIDStrNexus,
+					Receivers: validReceivers, FeeAmount: big.NewInt(1),
+				},
+				errStr: "not a registered evm chain",
+			},
+			{
+				name: "direction not allowed",
+				request: &core.EvmBridgingTxRequest{
+					SourceChainID: common.ChainIDStrNexus, DestinationChainID: common.ChainIDStrVector,
+					Receivers: validReceivers, FeeAmount: big.NewInt(1),
+				},
+				errStr: "not allowed",
+			},
+			{
+				name: "no receivers",
+				request: &core.EvmBridgingTxRequest{
+					SourceChainID: common.ChainIDStrNexus, DestinationChainID: common.ChainIDStrPrime,
+					FeeAmount: big.NewInt(1),
+				},
+				errStr: "no receivers",
+			},
+			{
+				name: "too many receivers",
+				request: &core.EvmBridgingTxRequest{
+					SourceChainID: common.ChainIDStrNexus, DestinationChainID: common.ChainIDStrPrime,
+					Receivers: append(append(validReceivers, validReceivers...), validReceivers...), FeeAmount: big.NewInt(1),
+				},
+				errStr: "number of receivers greater than maximum allowed",
+			},
+			{
+				name: "zero amount",
+				request: &core.EvmBridgingTxRequest{
+					SourceChainID: common.ChainIDStrNexus, DestinationChainID: common.ChainIDStrPrime,
+					Receivers: []core.BridgingTxReceiver{{Address: receiverAddr, Amount: big.NewInt(0)}},
+					FeeAmount: big.NewInt(1),
+				},
+				errStr: "must be greater than zero",
+			},
+			{
+				name: "fee missing",
+				request: &core.EvmBridgingTxRequest{
+					SourceChainID: common.ChainIDStrNexus, DestinationChainID: common.ChainIDStrPrime,
+					Receivers: validReceivers,
+				},
+				errStr: "fee amount must be greater than zero",
+			},
+		} {
+			t.Run(x.name, func(t *testing.T) {
+				_, err := builder.BuildEvmTx(x.request)
+				require.ErrorContains(t, err, x.errStr)
+			})
+		}
+	})
+
+	t.Run("BuildCardanoTx invalid requests", func(t *testing.T) {
+		_, err := builder.BuildCardanoTx(context.Background(), &core.CardanoBridgingTxRequest{
+			SourceChainID:      common.ChainIDStrNexus,
+			DestinationChainID: common.ChainIDStrPrime,
+		})
+		require.ErrorContains(t, err, "not a registered cardano chain")
+
+		_, err = builder.BuildCardanoTx(context.Background(), &core.CardanoBridgingTxRequest{
+			SourceChainID:      common.ChainIDStrPrime,
+			DestinationChainID: common.ChainIDStrVector,
+		})
+		require.ErrorContains(t, err, "not allowed")
+
+		_, err = builder.BuildCardanoTx(context.Background(), &core.CardanoBridgingTxRequest{
+			SourceChainID:      common.ChainIDStrPrime,
+			DestinationChainID: common.ChainIDStrNexus,
+			Receivers:          []core.BridgingTxReceiver{{Address: receiverAddr, Amount: new(big.Int).Lsh(big.NewInt(1), 64)}},
+		})
+		require.ErrorContains(t, err, "invalid amount")
+	})
+
+	t.Run("sender utxos transformer", func(t *testing.T) {
+		require.Nil(t, newSenderUtxosTransformer(nil))
+
+		transformer := newSenderUtxosTransformer([]core.BridgingTxInput{
+			{Hash: "aa", Index: 1},
+			{Hash: "bb", Index: 0},
+		})
+
+		utxos := transformer.TransformUtxos([]cardanowallet.Utxo{
+			{Hash: "aa", Index: 0, Amount: 1},
+			{Hash: "aa", Index: 1, Amount: 2},
+			{Hash: "bb", Index: 0, Amount: 3},
+			{Hash: "cc", Index: 0, Amount: 4},
+		})
+		require.Equal(t, []cardanowallet.Utxo{
+			{Hash: "aa", Index: 1, Amount: 2},
+			{Hash: "bb", Index: 0, Amount: 3},
+		}, utxos)
+	})
+}
@@ -0,0 +1,266 @@
+package validatorcomponents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/health"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	eventTrackerStore "github.com/Ethernal-Tech/blockchain-event-tracker/store"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/hashicorp/go-hclog"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	defaultHealthMaxTickAge      = 5 * time.Minute
+	defaultHealthChainTipTimeout = 5 * time.Second
+	defaultMaxCardanoIndexerLag  = 600 // slots
+	defaultMaxEthIndexerLag      = 100 // blocks
+)
+
+type chainTipFn = func(ctx context.Context, chainID string) (uint64, error)
+
+type HealthCheckerImpl struct {
+	appConfig         *core.AppConfig
+	config            core.HealthConfig
+	db                core.Database
+	oracleDB          *bbolt.DB
+	cardanoIndexerDbs map[string]indexer.Database
+	ethIndexerDbs     map[string]eventTrackerStore.EventTrackerStore
+	cardanoChainTip   chainTipFn
+	ethChainTip       chainTipFn
+	logger            hclog.Logger
+}
+
+var _ core.HealthChecker = (*HealthCheckerImpl)(nil)
+
+func NewHealthChecker(
+	appConfig *core.AppConfig,
+	db core.Database,
+	oracleDB *bbolt.DB,
+	cardanoIndexerDbs map[string]indexer.Database,
+	ethIndexerDbs map[string]eventTrackerStore.EventTrackerStore,
+	logger hclog.Logger,
+) *HealthCheckerImpl {
+	config := appConfig.Health
+
+	if config.MaxTickAge == 0 {
+		config.MaxTickAge = defaultHealthMaxTickAge
+	}
+
+	if config.ChainTipTimeout == 0 {
+		config.ChainTipTimeout = defaultHealthChainTipTimeout
+	}
+
+	hc := &HealthCheckerImpl{
+		appConfig:         appConfig,
+		config:            config,
+		db:                db,
+		oracleDB:          oracleDB,
+		cardanoIndexerDbs: cardanoIndexerDbs,
+		ethIndexerDbs:     ethIndexerDbs,
+		logger:            logger,
+	}
+
+	hc.cardanoChainTip = hc.getCardanoChainTip
+	hc.ethChainTip = hc.getEthChainTip
+
+	return hc
+}
+
+// GetHealth implements core.HealthChecker.
+// A component is healthy if it had a successful iteration within MaxTickAge. Components are registered
+// when they are created, so the ones which have not finished their first iteration yet are unhealthy
+func (hc *HealthCheckerImpl) GetHealth() *core.HealthReport {
+	var (
+		components = health.GetComponents()
+		report     = &core.HealthReport{
+			IsHealthy:  true,
+			Components: make([]*core.ComponentHealth, len(components)),
+		}
+		now = time.Now().UTC()
+	)
+
+	for i, x := range components {
+		isHealthy := !x.LastTick.IsZero() && now.Sub(x.LastTick) <= hc.config.MaxTickAge
+
+		report.Components[i] = &core.ComponentHealth{
+			Name:        x.Name,
+			IsHealthy:   isHealthy,
+			LastTick:    x.LastTick,
+			LastError:   x.LastError,
+			LastErrorAt: x.LastErrorAt,
+		}
+
+		report.IsHealthy = report.IsHealthy && isHealthy
+	}
+
+	return report
+}
+
+// GetReadiness implements core.HealthChecker.
+func (hc *HealthCheckerImpl) GetReadiness(ctx context.Context) *core.ReadinessReport {
+	report := &core.ReadinessReport{
+		IsReady: true,
+		Databases: []*core.DatabaseReadiness{
+			newDatabaseReadiness(MainComponentName, hc.db.Ping()),
+			newDatabaseReadiness("oracle", hc.oracleDB.View(func(*bbolt.Tx) error { return nil })),
+		},
+	}
+
+	for _, db := range report.Databases {
+		report.IsReady = report.IsReady && db.IsReady
+	}
+
+	// chains are queried in parallel and all of them share the same deadline
+	ctx, cancel := context.WithTimeout(ctx, hc.config.ChainTipTimeout)
+	defer cancel()
+
+	var (
+		wg     sync.WaitGroup
+		chains = make(chan *core.ChainReadiness, len(hc.cardanoIndexerDbs)+len(hc.ethIndexerDbs))
+	)
+
+	for chainID, db := range hc.cardanoIndexerDbs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			chains <- hc.getChainReadiness(ctx, chainID, hc.cardanoChainTip, defaultMaxCardanoIndexerLag,
+				func() (uint64, error) {
+					bp, err := db.GetLatestBlockPoint()
+					if err != nil || bp == nil {
+						return 0, errors.Join(errors.New("failed to get latest block point"), err)
+					}
+
+					return bp.BlockSlot, nil
+				})
+		}()
+	}
+
+	for chainID, db := range hc.ethIndexerDbs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			chains <- hc.getChainReadiness(ctx, chainID, hc.ethChainTip, defaultMaxEthIndexerLag, db.GetLastProcessedBlock)
+		}()
+	}
+
+	wg.Wait()
+	close(chains)
+
+	for chain := range chains {
+		report.Chains = append(report.Chains, chain)
+	}
+
+	sort.Slice(report.Chains, func(i, j int) bool {
+		return report.Chains[i].ChainID < report.Chains[j].ChainID
+	})
+
+	for _, chain := range report.Chains {
+		report.IsReady = report.IsReady && chain.IsReady
+	}
+
+	return report
+}
+
+func (hc *HealthCheckerImpl) getChainReadiness(
+	ctx context.Context, chainID string, getChainTip chainTipFn, defaultMaxLag uint64,
+	getIndexerPosition func() (uint64, error),
+) *core.ChainReadiness {
+	syncStatus, syncKnown := health.GetChainSync(chainID)
+	result := &core.ChainReadiness{
+		ChainID:         chainID,
+		IsBridgeSynced:  syncStatus.IsSynced,
+		BridgeSyncKnown: syncKnown,
+	}
+
+	position, err := getIndexerPosition()
+	if err != nil {
+		result.Error = err.Error()
+
+		return result
+	}
+
+	tip, err := getChainTip(ctx, chainID)
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to get chain tip: %s", err)
+
+		return result
+	}
+
+	maxLag, exists := hc.config.MaxIndexerLag[chainID]
+	if !exists {
+		maxLag = defaultMaxLag
+	}
+
+	result.IndexerPosition = position
+	result.ChainTip = tip
+
+	if tip > position {
+		result.IndexerLag = tip - position
+	}
+
+	result.IsReady = result.IndexerLag <= maxLag && syncKnown && syncStatus.IsSynced
+
+	return result
+}
+
+func (hc *HealthCheckerImpl) getCardanoChainTip(ctx context.Context, chainID string) (uint64, error) {
+	chainConfig, exists := hc.appConfig.CardanoChains[chainID]
+	if !exists {
+		return 0, fmt.Errorf("no configuration for chain: %s", chainID)
+	}
+
+	txProvider, err := chainConfig.CardanoChainConfig.CreateTxProvider()
+	if err != nil {
+		return 0, err
+	}
+
+	defer txProvider.Dispose()
+
+	tip, err := txProvider.GetTip(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return tip.Slot, nil
+}
+
+func (hc *HealthCheckerImpl) getEthChainTip(ctx context.Context, chainID string) (uint64, error) {
+	chainConfig, exists := hc.appConfig.EthChains[chainID]
+	if !exists {
+		return 0, fmt.Errorf("no configuration for chain: %s", chainID)
+	}
+
+	client, err := ethclient.DialContext(ctx, chainConfig.NodeURL)
+	if err != nil {
+		return 0, err
+	}
+
+	defer client.Close()
+
+	return client.BlockNumber(ctx)
+}
+
+func newDatabaseReadiness(name string, err error) *core.DatabaseReadiness {
+	result := &core.DatabaseReadiness{
+		Name:    name,
+		IsReady: err == nil,
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
@@ -0,0 +1,154 @@
+package validatorcomponents
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/health"
+	ethcore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	databaseaccess "github.com/Ethernal-Tech/apex-bridge/validatorcomponents/database_access"
+	eventTrackerStore "github.com/Ethernal-Tech/blockchain-event-tracker/store"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestHealthChecker(t *testing.T) {
+	t.Cleanup(health.Reset)
+
+	dir := t.TempDir()
+
+	db, err := databaseaccess.NewDatabase(filepath.Join(dir, "main.db"))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	oracleDB, err := bbolt.Open(filepath.Join(dir, "oracle.db"), 0660, nil)
+	require.NoError(t, err)
+
+	defer oracleDB.Close()
+
+	t.Run("GetHealth", func(t *testing.T) {
+		health.Reset()
+
+		hc := NewHealthChecker(&core.AppConfig{
+			Health: core.HealthConfig{MaxTickAge: time.Hour},
+		}, db, oracleDB, nil, nil, hclog.NewNullLogger())
+
+		health.RegisterComponent("batcher.prime")
+		health.RegisterComponent("relayer_imitator")
+
+		// registered components are unhealthy until the first tick
+		report := hc.GetHealth()
+		require.False(t, report.IsHealthy)
+		require.Len(t, report.Components, 2)
+		require.Equal(t, "batcher.prime", report.Components[0].Name)
+		require.Equal(t, "relayer_imitator", report.Components[1].Name)
+		require.False(t, report.Components[0].IsHealthy)
+		require.False(t, report.Components[1].IsHealthy)
+
+		health.ReportTick("batcher.prime")
+		health.ReportError("relayer_imitator", errors.New("test err"))
+
+		report = hc.GetHealth()
+		require.False(t, report.IsHealthy)
+		require.True(t, report.Components[0].IsHealthy)
+		require.False(t, report.Components[1].IsHealthy)
+		require.Equal(t, "test err", report.Components[1].LastError)
+
+		health.ReportTick("relayer_imitator")
+
+		report = hc.GetHealth()
+		require.True(t, report.IsHealthy)
+
+		hc.config.MaxTickAge = time.Nanosecond
+
+		time.Sleep(time.Millisecond)
+
+		report = hc.GetHealth()
+		require.False(t, report.IsHealthy)
+	})
+
+	t.Run("GetReadiness", func(t *testing.T) {
+		health.Reset()
+
+		cardanoDB := &indexer.DatabaseMock{}
+		cardanoDB.On("GetLatestBlockPoint").Return(&indexer.BlockPoint{BlockSlot: 1000}, nil)
+
+		ethDB := &ethcore.EventStoreMock{}
+		ethDB.On("GetLastProcessedBlock").Return(uint64(50), nil)
+
+		hc := NewHealthChecker(&core.AppConfig{
+			Health: core.HealthConfig{
+				MaxIndexerLag: map[string]uint64{common.ChainIDStrNexus: 10},
+			},
+		}, db, oracleDB,
+			map[string]indexer.Database{common.ChainIDStrPrime: cardanoDB},
+			map[string]eventTrackerStore.EventTrackerStore{common.ChainIDStrNexus: ethDB},
+			hclog.NewNullLogger())
+
+		hc.cardanoChainTip = func(context.Context, string) (uint64, error) {
+			return 1100, nil
+		}
+		hc.ethChainTip = func(context.Context, string) (uint64, error) {
+			return 55, nil
+		}
+
+		// bridge sync status still unknown
+		report := hc.GetReadiness(context.Background())
+		require.False(t, report.IsReady)
+		require.Len(t, report.Databases, 2)
+		require.True(t, report.Databases[0].IsReady)
+		require.True(t, report.Databases[1].IsReady)
+		require.Len(t, report.Chains, 2)
+		require.Equal(t, common.ChainIDStrNexus, report.Chains[0].ChainID)
+		require.Equal(t, uint64(5), report.Chains[0].IndexerLag)
+		require.Equal(t, uint64(100), report.Chains[1].IndexerLag)
+
+		health.ReportChainSync(common.ChainIDStrPrime, true)
+		health.ReportChainSync(common.ChainIDStrNexus, true)
+
+		report = hc.GetReadiness(context.Background())
+		require.True(t, report.IsReady)
+
+		hc.ethChainTip = func(context.Context, string) (uint64, error) {
+			return 61, nil
+		}
+
+		report = hc.GetReadiness(context.Background())
+		require.False(t, report.IsReady)
+		require.False(t, report.Chains[0].IsReady)
+		require.True(t, report.Chains[1].IsReady)
+
+		hc.ethChainTip = func(context.Context, string) (uint64, error) {
+			return 0, errors.New("node down")
+		}
+
+		report = hc.GetReadiness(context.Background())
+		require.False(t, report.IsReady)
+		require.Contains(t, report.Chains[0].Error, "node down")
+
+		// chains are queried in parallel under one deadline
+		hc.config.ChainTipTimeout = time.Millisecond * 200
+		hc.cardanoChainTip = func(ctx context.Context, _ string) (uint64, error) {
+			<-ctx.Done()
+
+			return 0, ctx.Err()
+		}
+		hc.ethChainTip = hc.cardanoChainTip
+
+		start := time.Now()
+		report = hc.GetReadiness(context.Background())
+
+		require.Less(t, time.Since(start), time.Millisecond*400)
+		require.False(t, report.IsReady)
+		require.Contains(t, report.Chains[0].Error, context.DeadlineExceeded.Error())
+		require.Contains(t, report.Chains[1].Error, context.DeadlineExceeded.Error())
+	})
+}
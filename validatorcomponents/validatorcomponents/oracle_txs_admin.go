@@ -0,0 +1,357 @@
+package validatorcomponents
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	cardanoOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	oracleCommonUtils "github.com/Ethernal-Tech/apex-bridge/oracle_common/utils"
+	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	"github.com/Ethernal-Tech/apex-bridge/telemetry"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	oracleTxAdminActionRequeue     = "requeue"
+	oracleTxAdminActionInvalidate  = "invalidate"
+	oracleTxAdminActionForceRefund = "force refund"
+	oracleTxAdminActionReplay      = "dead-letter replay"
+	oracleTxAdminActionApprove     = "approve"
+)
+
+// OracleTxsAdminImpl lets operators move a stuck tx out of the unprocessed or pending txs and replay dead-letter txs.
+// Every action is logged and the bridging request state of the tx is updated to match
+type OracleTxsAdminImpl struct {
+	oracleConfig                *oracleCommonCore.AppConfig
+	cardanoDB                   cardanoOracleCore.CardanoTxsProcessorDB
+	ethDB                       ethOracleCore.EthTxsProcessorDB
+	bridgingRequestStateManager core.BridgingRequestStateManager
+	logger                      hclog.Logger
+}
+
+var _ core.OracleTxsAdmin = (*OracleTxsAdminImpl)(nil)
+
+func NewOracleTxsAdmin(
+	oracleConfig *oracleCommonCore.AppConfig,
+	cardanoDB cardanoOracleCore.CardanoTxsProcessorDB,
+	ethDB ethOracleCore.EthTxsProcessorDB,
+	bridgingRequestStateManager core.BridgingRequestStateManager,
+	logger hclog.Logger,
+) *OracleTxsAdminImpl {
+	return &OracleTxsAdminImpl{
+		oracleConfig:                oracleConfig,
+		cardanoDB:                   cardanoDB,
+		ethDB:                       ethDB,
+		bridgingRequestStateManager: bridgingRequestStateManager,
+		logger:                      logger,
+	}
+}
+
+func (a *OracleTxsAdminImpl) Requeue(action *core.OracleTxAdminAction) (*core.OracleTx, error) {
+	if err := validateOracleTxAdminAction(action, false); err != nil {
+		return nil, err
+	}
+
+	tx, err := a.requeueTx(action,
+		func(tx *cardanoOracleCore.CardanoTx) error {
+			tx.ResetTryCounts()
+			tx.ForceRefund = false
+
+			return nil
+		},
+		func(tx *ethOracleCore.EthTx) error {
+			tx.ResetTryCounts()
+			tx.ForceRefund = false
+
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue tx: %w", err)
+	}
+
+	a.logAction(oracleTxAdminActionRequeue, action)
+	a.updateState(tx, action, oracleTxAdminActionRequeue, a.bridgingRequestStateManager.Requeued)
+
+	return tx, nil
+}
+
+func (a *OracleTxsAdminImpl) Invalidate(action *core.OracleTxAdminAction) (*core.OracleTx, error) {
+	if err := validateOracleTxAdminAction(action, true); err != nil {
+		return nil, err
+	}
+
+	var (
+		tx  *core.OracleTx
+		err error
+	)
+
+	cardanoConfig, ethConfig := oracleCommonUtils.GetChainConfig(a.oracleConfig, action.ChainID)
+
+	switch {
+	case cardanoConfig != nil:
+		var cardanoTx *cardanoOracleCore.CardanoTx
+
+		cardanoTx, err = a.cardanoDB.InvalidateTx(action.ChainID, action.TxHash[:])
+		if err == nil {
+			tx = newCardanoOracleTx(cardanoTx)
+		}
+	case ethConfig != nil:
+		var ethTx *ethOracleCore.EthTx
+
+		ethTx, err = a.ethDB.InvalidateTx(action.ChainID, action.TxHash[:])
+		if err == nil {
+			tx = newEthOracleTx(ethTx)
+		}
+	default:
+		err = fmt.Errorf("unsupported chain: %s", action.ChainID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to invalidate tx: %w", err)
+	}
+
+	tx.IsInvalid = true
+	tx.IsProcessed = true
+
+	a.logAction(oracleTxAdminActionInvalidate, action)
+	a.updateState(tx, action, oracleTxAdminActionInvalidate, a.bridgingRequestStateManager.ForcedInvalid)
+
+	return tx, nil
+}
+
+func (a *OracleTxsAdminImpl) ForceRefund(action *core.OracleTxAdminAction) (*core.OracleTx, error) {
+	if err := validateOracleTxAdminAction(action, false); err != nil {
+		return nil, err
+	}
+
+	if !a.oracleConfig.RefundEnabled {
+		return nil, errors.New("failed to force refund: refund is not enabled")
+	}
+
+	tx, err := a.requeueTx(action,
+		func(tx *cardanoOracleCore.CardanoTx) error {
+			if txType := cardanoMetadataTxType(tx.Metadata); txType != common.BridgingTxTypeBridgingRequest {
+				return fmt.Errorf("only bridging requests can be refunded, tx type: %s", txType)
+			}
+
+			tx.SubmitTryCount = 0
+			tx.LastTimeTried = time.Time{}
+			tx.RefundTryCountOffset = tx.RefundTryCount
+			tx.ForceRefund = true
+
+			return nil
+		},
+		func(tx *ethOracleCore.EthTx) error {
+			if txType := ethMetadataTxType(tx.Metadata); txType != common.BridgingTxTypeBridgingRequest {
+				return fmt.Errorf("only bridging requests can be refunded, tx type: %s", txType)
+			}
+
+			tx.SubmitTryCount = 0
+			tx.LastTimeTried = time.Time{}
+			tx.RefundTryCountOffset = tx.RefundTryCount
+			tx.ForceRefund = true
+
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to force refund: %w", err)
+	}
+
+	a.logAction(oracleTxAdminActionForceRefund, action)
+	a.updateState(tx, action, oracleTxAdminActionForceRefund, a.bridgingRequestStateManager.Requeued)
+
+	return tx, nil
+}
+
+func (a *OracleTxsAdminImpl) ReplayDeadLetter(action *core.OracleTxAdminAction) (*core.OracleTx, error) {
+	if err := validateOracleTxAdminAction(action, false); err != nil {
+		return nil, err
+	}
+
+	var (
+		tx                 *core.OracleTx
+		deadLetterTxsCount int
+		err, countErr      error
+	)
+
+	cardanoConfig, ethConfig := oracleCommonUtils.GetChainConfig(a.oracleConfig, action.ChainID)
+
+	switch {
+	case cardanoConfig != nil:
+		var cardanoTx *cardanoOracleCore.CardanoTx
+
+		cardanoTx, err = a.cardanoDB.ReplayDeadLetterTx(action.ChainID, action.TxHash[:],
+			func(tx *cardanoOracleCore.CardanoTx) error {
+				tx.ResetTryCounts()
+				tx.ForceRefund = false
+
+				return nil
+			})
+		if err == nil {
+			tx = newCardanoOracleTx(cardanoTx)
+			deadLetterTxsCount, countErr = a.cardanoDB.GetDeadLetterTxsCount(action.ChainID)
+		}
+	case ethConfig != nil:
+		var ethTx *ethOracleCore.EthTx
+
+		ethTx, err = a.ethDB.ReplayDeadLetterTx(action.ChainID, action.TxHash[:],
+			func(tx *ethOracleCore.EthTx) error {
+				tx.ResetTryCounts()
+				tx.ForceRefund = false
+
+				return nil
+			})
+		if err == nil {
+			tx = newEthOracleTx(ethTx)
+			deadLetterTxsCount, countErr = a.ethDB.GetDeadLetterTxsCount(action.ChainID)
+		}
+	default:
+		err = fmt.Errorf("unsupported chain: %s", action.ChainID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay dead-letter tx: %w", err)
+	}
+
+	if countErr != nil {
+		a.logger.Error("Failed to get dead-letter txs count", "chainID", action.ChainID, "err", countErr)
+	} else {
+		telemetry.UpdateOracleDeadLetterTxsCount(action.ChainID, deadLetterTxsCount)
+	}
+
+	a.logAction(oracleTxAdminActionReplay, action)
+	a.updateState(tx, action, oracleTxAdminActionReplay, a.bridgingRequestStateManager.Requeued)
+
+	return tx, nil
+}
+
+func (a *OracleTxsAdminImpl) Approve(action *core.OracleTxAdminAction) (*oracleCommonCore.ManualApproval, error) {
+	if err := validateOracleTxAdminAction(action, false); err != nil {
+		return nil, err
+	}
+
+	if !a.oracleConfig.BridgingSettings.ManualApproval.IsEnabled() {
+		return nil, errors.New("failed to approve tx: manual approval is not enabled")
+	}
+
+	if cardanoConfig, ethConfig := oracleCommonUtils.GetChainConfig(
+		a.oracleConfig, action.ChainID); cardanoConfig == nil && ethConfig == nil {
+		return nil, fmt.Errorf("failed to approve tx: unsupported chain: %s", action.ChainID)
+	}
+
+	// approvals are shared by all the chains, so either of the databases can be used
+	approval, err := a.cardanoDB.ApproveManualApproval(action.ChainID, action.TxHash, action.Operator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve tx: %w", err)
+	}
+
+	a.logAction(oracleTxAdminActionApprove, action)
+
+	return approval, nil
+}
+
+func (a *OracleTxsAdminImpl) requeueTx(
+	action *core.OracleTxAdminAction,
+	updateCardanoTx func(tx *cardanoOracleCore.CardanoTx) error,
+	updateEthTx func(tx *ethOracleCore.EthTx) error,
+) (*core.OracleTx, error) {
+	cardanoConfig, ethConfig := oracleCommonUtils.GetChainConfig(a.oracleConfig, action.ChainID)
+
+	switch {
+	case cardanoConfig != nil:
+		tx, err := a.cardanoDB.RequeueTx(action.ChainID, action.TxHash[:], updateCardanoTx)
+		if err != nil {
+			return nil, err
+		}
+
+		return newCardanoOracleTx(tx), nil
+	case ethConfig != nil:
+		tx, err := a.ethDB.RequeueTx(action.ChainID, action.TxHash[:], updateEthTx)
+		if err != nil {
+			return nil, err
+		}
+
+		return newEthOracleTx(tx), nil
+	default:
+		return nil, fmt.Errorf("unsupported chain: %s", action.ChainID)
+	}
+}
+
+func (a *OracleTxsAdminImpl) logAction(actionName string, action *core.OracleTxAdminAction) {
+	a.logger.Info("Oracle tx admin action executed", "action", actionName,
+		"chainID", action.ChainID, "txHash", action.TxHash, "operator", action.Operator,
+		"reason", action.Reason, "remoteAddr", action.RemoteAddr)
+}
+
+// updateState updates the bridging request state only for txs that have one
+func (a *OracleTxsAdminImpl) updateState(
+	tx *core.OracleTx, action *core.OracleTxAdminAction, actionName string,
+	update func(key common.BridgingRequestStateKey, reason string) error,
+) {
+	if !oracleTxHasBridgingRequestState(tx) {
+		return
+	}
+
+	reason := fmt.Sprintf("%s by operator %s", actionName, action.Operator)
+	if action.Reason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, action.Reason)
+	}
+
+	err := update(common.NewBridgingRequestStateKey(action.ChainID, action.TxHash, false), reason)
+	if err != nil {
+		a.logger.Error("error while updating a bridging request state after an oracle tx admin action",
+			"action", actionName, "chainID", action.ChainID, "txHash", action.TxHash, "err", err)
+	}
+}
+
+func validateOracleTxAdminAction(action *core.OracleTxAdminAction, isReasonRequired bool) error {
+	if action.ChainID == "" {
+		return errors.New("chain id is missing")
+	}
+
+	if action.TxHash == (common.Hash{}) {
+		return errors.New("tx hash is missing")
+	}
+
+	if action.Operator == "" {
+		return errors.New("operator is missing")
+	}
+
+	if isReasonRequired && action.Reason == "" {
+		return errors.New("reason is missing")
+	}
+
+	return nil
+}
+
+func oracleTxHasBridgingRequestState(tx *core.OracleTx) bool {
+	switch tx.Metadata.(type) {
+	case *common.BridgingRequestMetadata, *common.RefundBridgingRequestMetadata,
+		*ethOracleCore.BridgingRequestEthMetadata, *ethOracleCore.RefundBridgingRequestEthMetadata:
+		return true
+	default:
+		return false
+	}
+}
+
+func cardanoMetadataTxType(data []byte) common.BridgingTxType {
+	metadata, err := common.UnmarshalMetadata[common.BaseMetadata](common.MetadataEncodingTypeCbor, data)
+	if err != nil {
+		return ""
+	}
+
+	return metadata.BridgingTxType
+}
+
+func ethMetadataTxType(data []byte) common.BridgingTxType {
+	metadata, err := ethOracleCore.UnmarshalEthMetadata[ethOracleCore.BaseEthMetadata](data)
+	if err != nil {
+		return ""
+	}
+
+	return metadata.BridgingTxType
+}
@@ -0,0 +1,311 @@
+package validatorcomponents
+
+import (
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	cardanoOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
+	cardanoOracleDA "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/database_access"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	oracleCommonDA "github.com/Ethernal-Tech/apex-bridge/oracle_common/database_access"
+	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	ethOracleDA "github.com/Ethernal-Tech/apex-bridge/oracle_eth/database_access"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	databaseaccess "github.com/Ethernal-Tech/apex-bridge/validatorcomponents/database_access"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	"github.com/Ethernal-Tech/cardano-infrastructure/sendtx"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOracleTxsAdmin(t *testing.T) {
+	oracleConfig := &oracleCommonCore.AppConfig{
+		CardanoChains: map[string]*oracleCommonCore.CardanoChainConfig{
+			common.ChainIDStrPrime: {},
+		},
+		EthChains: map[string]*oracleCommonCore.EthChainConfig{
+			common.ChainIDStrNexus: {},
+		},
+	}
+
+	oracleConfig.FillOut()
+
+	boltDB, err := oracleCommonDA.NewDatabase(filepath.Join(t.TempDir(), "oracle.db"), oracleConfig)
+	require.NoError(t, err)
+
+	defer boltDB.Close()
+
+	typeRegister := oracleCommonCore.NewTypeRegisterWithChains(
+		oracleConfig, reflect.TypeOf(cardanoOracleCore.CardanoTx{}), reflect.TypeOf(ethOracleCore.EthTx{}))
+
+	cardanoDB := &cardanoOracleDA.BBoltDatabase{}
+	cardanoDB.Init(boltDB, oracleConfig, typeRegister)
+
+	ethDB := &ethOracleDA.BBoltDatabase{}
+	ethDB.Init(boltDB, oracleConfig, typeRegister)
+
+	stateDB := &databaseaccess.BridgingRequestStateDBMock{}
+	admin := NewOracleTxsAdmin(
+		oracleConfig, cardanoDB, ethDB, NewBridgingRequestStateManager(stateDB, hclog.NewNullLogger()),
+		hclog.NewNullLogger())
+
+	bridgingMetadata, err := common.MarshalMetadataMap(common.MetadataEncodingTypeCbor, common.BridgingRequestMetadata{
+		BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
+		DestinationChainID: common.ChainIDStrVector,
+	})
+	require.NoError(t, err)
+
+	batchMetadata, err := ethOracleCore.MarshalEthMetadata(ethOracleCore.BatchExecutedEthMetadata{
+		BridgingTxType: common.BridgingTxTypeBatchExecution,
+		BatchNonceID:   7,
+	})
+	require.NoError(t, err)
+
+	stuckTx := &cardanoOracleCore.CardanoTx{
+		OriginChainID: common.ChainIDStrPrime, Priority: 1, SubmitTryCount: 5, BatchTryCount: 3, RefundTryCount: 1,
+		LastTimeTried: time.Now().UTC(),
+		Tx:            indexer.Tx{Hash: indexer.Hash{1}, BlockSlot: 1, Metadata: bridgingMetadata},
+	}
+	pendingTx := &ethOracleCore.EthTx{
+		OriginChainID: common.ChainIDStrNexus, BlockNumber: 10, Hash: [32]byte{2}, Metadata: batchMetadata,
+	}
+
+	require.NoError(t, cardanoDB.AddTxs(nil, []*cardanoOracleCore.CardanoTx{stuckTx}))
+	require.NoError(t, ethDB.AddTxs(nil, []*ethOracleCore.EthTx{pendingTx}))
+	require.NoError(t, ethDB.UpdateTxs(&ethOracleCore.EthUpdateTxsData{
+		MoveUnprocessedToPending: []*ethOracleCore.EthTx{pendingTx},
+	}))
+
+	cardanoAction := &core.OracleTxAdminAction{
+		ChainID: common.ChainIDStrPrime, TxHash: common.Hash{1}, Operator: "alice", Reason: "stuck",
+	}
+
+	t.Run("invalid actions", func(t *testing.T) {
+		_, err := admin.Requeue(&core.OracleTxAdminAction{ChainID: common.ChainIDStrPrime, TxHash: common.Hash{1}})
+		require.ErrorContains(t, err, "operator is missing")
+
+		_, err = admin.Invalidate(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrPrime, TxHash: common.Hash{1}, Operator: "alice",
+		})
+		require.ErrorContains(t, err, "reason is missing")
+
+		_, err = admin.Requeue(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrVector, TxHash: common.Hash{1}, Operator: "alice",
+		})
+		require.ErrorContains(t, err, "unsupported chain")
+
+		_, err = admin.ForceRefund(cardanoAction)
+		require.ErrorContains(t, err, "refund is not enabled")
+	})
+
+	t.Run("requeue", func(t *testing.T) {
+		stateDB.On("GetBridgingRequestState", common.ChainIDStrPrime, common.Hash{1}).Return(
+			&common.BridgingRequestState{
+				SourceChainID: common.ChainIDStrPrime, SourceTxHash: common.Hash{1},
+				Status: common.BridgingRequestStatusSubmittedToBridge,
+			}, nil).Once()
+		stateDB.On("UpdateBridgingRequestState", mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+			return state.Status == common.BridgingRequestStatusDiscoveredOnSource &&
+				state.FailureReason == "requeue by operator alice: stuck"
+		})).Return(nil).Once()
+
+		tx, err := admin.Requeue(cardanoAction)
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), tx.SubmitTryCount)
+		require.Equal(t, uint32(3), tx.BatchTryCount)
+
+		txs, err := cardanoDB.GetAllUnprocessedTxs(common.ChainIDStrPrime, 0)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, uint32(3), txs[0].BatchTryCountOffset)
+		require.Equal(t, uint32(1), txs[0].RefundTryCountOffset)
+		require.True(t, txs[0].LastTimeTried.IsZero())
+
+		stateDB.AssertExpectations(t)
+	})
+
+	t.Run("force refund", func(t *testing.T) {
+		oracleConfig.RefundEnabled = true
+
+		defer func() {
+			oracleConfig.RefundEnabled = false
+		}()
+
+		_, err := admin.ForceRefund(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrNexus, TxHash: common.Hash{2}, Operator: "alice",
+		})
+		require.ErrorContains(t, err, "only bridging requests can be refunded")
+
+		stateDB.On("GetBridgingRequestState", common.ChainIDStrPrime, common.Hash{1}).Return(
+			&common.BridgingRequestState{
+				SourceChainID: common.ChainIDStrPrime, SourceTxHash: common.Hash{1},
+				Status: common.BridgingRequestStatusDiscoveredOnSource,
+			}, nil).Once()
+		stateDB.On("UpdateBridgingRequestState", mock.Anything).Return(nil).Once()
+
+		_, err = admin.ForceRefund(cardanoAction)
+		require.NoError(t, err)
+
+		txs, err := cardanoDB.GetAllUnprocessedTxs(common.ChainIDStrPrime, 0)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.True(t, txs[0].ForceRefund)
+
+		stateDB.AssertExpectations(t)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		// batch executed txs do not have a bridging request state
+		tx, err := admin.Invalidate(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrNexus, TxHash: common.Hash{2}, Operator: "alice", Reason: "stuck",
+		})
+		require.NoError(t, err)
+		require.True(t, tx.IsInvalid)
+
+		pendingTxs, err := ethDB.GetAllPendingTxs(common.ChainIDStrNexus, 0)
+		require.NoError(t, err)
+		require.Empty(t, pendingTxs)
+
+		processedTx, err := ethDB.GetProcessedTx(oracleCommonCore.DBTxID{
+			ChainID: common.ChainIDStrNexus, DBKey: pendingTx.Hash[:],
+		})
+		require.NoError(t, err)
+		require.NotNil(t, processedTx)
+		require.True(t, processedTx.IsInvalid)
+
+		_, err = admin.Requeue(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrNexus, TxHash: common.Hash{2}, Operator: "alice",
+		})
+		require.ErrorContains(t, err, "already processed")
+
+		stateDB.AssertExpectations(t)
+	})
+
+	t.Run("replay dead-letter", func(t *testing.T) {
+		deadLetterTx := &cardanoOracleCore.CardanoTx{
+			OriginChainID: common.ChainIDStrPrime, Priority: 1, RefundTryCount: 6,
+			Tx: indexer.Tx{Hash: indexer.Hash{3}, BlockSlot: 3, Metadata: bridgingMetadata},
+		}
+
+		require.NoError(t, cardanoDB.AddTxs(nil, []*cardanoOracleCore.CardanoTx{deadLetterTx}))
+		require.NoError(t, cardanoDB.UpdateTxs(&cardanoOracleCore.CardanoUpdateTxsData{
+			MoveUnprocessedToProcessed: []*cardanoOracleCore.ProcessedCardanoTx{
+				deadLetterTx.ToProcessedCardanoTx(true),
+			},
+			AddDeadLetterTxs: []*cardanoOracleCore.CardanoDeadLetterTx{
+				{Tx: deadLetterTx, LastError: "try count exceeded"},
+			},
+		}))
+
+		txs, err := NewOracleTxsInspector(oracleConfig, cardanoDB, ethDB).GetTxs(
+			common.ChainIDStrPrime, core.OracleTxsBucketDeadLetter, 0)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, "try count exceeded", txs[0].LastError)
+		require.True(t, txs[0].IsInvalid)
+
+		stateDB.On("GetBridgingRequestState", common.ChainIDStrPrime, common.Hash{3}).Return(
+			&common.BridgingRequestState{
+				SourceChainID: common.ChainIDStrPrime, SourceTxHash: common.Hash{3},
+				Status: common.BridgingRequestStatusInvalidRequest,
+			}, nil).Once()
+		stateDB.On("UpdateBridgingRequestState", mock.MatchedBy(func(state *common.BridgingRequestState) bool {
+			return state.Status == common.BridgingRequestStatusDiscoveredOnSource
+		})).Return(nil).Once()
+
+		tx, err := admin.ReplayDeadLetter(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrPrime, TxHash: common.Hash{3}, Operator: "alice", Reason: "hot wallet funded",
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(6), tx.RefundTryCount)
+
+		_, err = admin.ReplayDeadLetter(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrPrime, TxHash: common.Hash{3}, Operator: "alice",
+		})
+		require.ErrorContains(t, err, "not found")
+
+		stateDB.AssertExpectations(t)
+	})
+	t.Run("approve", func(t *testing.T) {
+		approveAction := &core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrNexus, TxHash: common.Hash{4}, Operator: "alice",
+		}
+
+		_, err := admin.Approve(approveAction)
+		require.ErrorContains(t, err, "manual approval is not enabled")
+
+		oracleConfig.BridgingSettings.ManualApproval = oracleCommonCore.ManualApprovalSettings{
+			MinAmount: big.NewInt(1000), RequiredApprovals: 2,
+			ReviewTimeoutBlocks: map[string]uint64{common.ChainIDStrNexus: 50},
+		}
+
+		defer func() {
+			oracleConfig.BridgingSettings.ManualApproval = oracleCommonCore.ManualApprovalSettings{}
+		}()
+
+		require.NoError(t, ethDB.UpdateTxs(&ethOracleCore.EthUpdateTxsData{
+			AddManualApprovals: []*oracleCommonCore.ManualApproval{
+				{
+					SourceChainID: common.ChainIDStrNexus, TxHash: common.Hash{4},
+					DestinationChainID: common.ChainIDStrPrime, Amount: big.NewInt(2000),
+					HeldAtBlock: 100, DeadlineBlock: 150,
+				},
+				{
+					SourceChainID: common.ChainIDStrNexus, TxHash: common.Hash{5},
+					DestinationChainID: common.ChainIDStrPrime, Amount: big.NewInt(3000),
+					HeldAtBlock: 50, DeadlineBlock: 100,
+				},
+			},
+		}))
+
+		// the processor expires the approval when it refunds the request
+		require.NoError(t, ethDB.UpdateTxs(&ethOracleCore.EthUpdateTxsData{
+			ExpireManualApprovals: []*oracleCommonCore.ManualApproval{
+				{SourceChainID: common.ChainIDStrNexus, TxHash: common.Hash{5}},
+			},
+		}))
+
+		inspector := NewOracleTxsInspector(oracleConfig, cardanoDB, ethDB)
+
+		approvals, err := inspector.GetManualApprovals()
+		require.NoError(t, err)
+		require.Len(t, approvals, 1)
+		require.Equal(t, common.Hash{4}, approvals[0].TxHash)
+
+		approval, err := admin.Approve(approveAction)
+		require.NoError(t, err)
+		require.Len(t, approval.Approvals, 1)
+		require.Equal(t, "alice", approval.Approvals[0].Operator)
+
+		_, err = admin.Approve(approveAction)
+		require.ErrorContains(t, err, "already approved by operator alice")
+
+		_, err = admin.Approve(&core.OracleTxAdminAction{ChainID: common.ChainIDStrNexus, TxHash: common.Hash{4}})
+		require.ErrorContains(t, err, "operator is missing")
+
+		_, err = admin.Approve(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrNexus, TxHash: common.Hash{5}, Operator: "alice",
+		})
+		require.ErrorContains(t, err, "review deadline")
+
+		_, err = admin.Approve(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrNexus, TxHash: common.Hash{6}, Operator: "alice",
+		})
+		require.ErrorContains(t, err, "not held for approval")
+
+		approval, err = admin.Approve(&core.OracleTxAdminAction{
+			ChainID: common.ChainIDStrNexus, TxHash: common.Hash{4}, Operator: "bob",
+		})
+		require.NoError(t, err)
+		require.True(t, approval.IsApproved(2))
+
+		approvals, err = inspector.GetManualApprovals()
+		require.NoError(t, err)
+		require.Empty(t, approvals)
+	})
+}
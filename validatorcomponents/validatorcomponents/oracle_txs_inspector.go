@@ -0,0 +1,359 @@
+package validatorcomponents
+
+import (
+	"fmt"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	cardanoOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	oracleCommonUtils "github.com/Ethernal-Tech/apex-bridge/oracle_common/utils"
+	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+)
+
+// OracleTxsInspectorImpl reads the oracle database buckets for operators without modifying them
+type OracleTxsInspectorImpl struct {
+	oracleConfig *oracleCommonCore.AppConfig
+	cardanoDB    cardanoOracleCore.CardanoTxsProcessorDB
+	ethDB        ethOracleCore.EthTxsProcessorDB
+}
+
+var _ core.OracleTxsInspector = (*OracleTxsInspectorImpl)(nil)
+
+func NewOracleTxsInspector(
+	oracleConfig *oracleCommonCore.AppConfig,
+	cardanoDB cardanoOracleCore.CardanoTxsProcessorDB,
+	ethDB ethOracleCore.EthTxsProcessorDB,
+) *OracleTxsInspectorImpl {
+	return &OracleTxsInspectorImpl{
+		oracleConfig: oracleConfig,
+		cardanoDB:    cardanoDB,
+		ethDB:        ethDB,
+	}
+}
+
+func (i *OracleTxsInspectorImpl) GetTxs(
+	chainID string, bucket core.OracleTxsBucket, limit int,
+) ([]*core.OracleTx, error) {
+	cardanoConfig, ethConfig := oracleCommonUtils.GetChainConfig(i.oracleConfig, chainID)
+
+	switch {
+	case cardanoConfig != nil:
+		return i.getCardanoTxs(chainID, bucket, limit)
+	case ethConfig != nil:
+		return i.getEthTxs(chainID, bucket, limit)
+	default:
+		return nil, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+}
+
+func (i *OracleTxsInspectorImpl) GetUnprocessedBatchEvents(
+	chainID string,
+) ([]*oracleCommonCore.DBBatchInfoEvent, error) {
+	cardanoConfig, ethConfig := oracleCommonUtils.GetChainConfig(i.oracleConfig, chainID)
+
+	switch {
+	case cardanoConfig != nil:
+		return i.cardanoDB.GetUnprocessedBatchEvents(chainID)
+	case ethConfig != nil:
+		return i.ethDB.GetUnprocessedBatchEvents(chainID)
+	default:
+		return nil, fmt.Errorf("unsupported chain: %s", chainID)
+	}
+}
+
+func (i *OracleTxsInspectorImpl) GetOutflowUsage() (*core.OutflowUsage, error) {
+	// records are shared by all the chains, so either of the databases can be used.
+	// The txs processors remove the records out of the window
+	records, err := i.cardanoDB.GetOutflowRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.OutflowUsage{
+		OutflowUsage: oracleCommonCore.NewOutflowUsage(records),
+		Limits:       i.oracleConfig.BridgingSettings.OutflowLimits,
+	}, nil
+}
+
+func (i *OracleTxsInspectorImpl) GetManualApprovals() ([]*oracleCommonCore.ManualApproval, error) {
+	// approvals are shared by all the chains, so either of the databases can be used
+	approvals, err := i.cardanoDB.GetManualApprovals()
+	if err != nil {
+		return nil, err
+	}
+
+	requiredApprovals := i.oracleConfig.BridgingSettings.ManualApproval.RequiredApprovals
+	result := make([]*oracleCommonCore.ManualApproval, 0, len(approvals))
+
+	for _, approval := range approvals {
+		if !approval.IsApproved(requiredApprovals) && !approval.IsExpired {
+			result = append(result, approval)
+		}
+	}
+
+	return result, nil
+}
+
+func (i *OracleTxsInspectorImpl) getCardanoTxs(
+	chainID string, bucket core.OracleTxsBucket, limit int,
+) ([]*core.OracleTx, error) {
+	switch bucket {
+	case core.OracleTxsBucketUnprocessed, core.OracleTxsBucketPending:
+		getTxs := i.cardanoDB.GetAllUnprocessedTxs
+		if bucket == core.OracleTxsBucketPending {
+			getTxs = i.cardanoDB.GetAllPendingTxs
+		}
+
+		txs, err := getTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = newCardanoOracleTx(tx)
+		}
+
+		return result, nil
+	case core.OracleTxsBucketProcessed:
+		txs, err := i.cardanoDB.GetAllProcessedTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = &core.OracleTx{
+				ChainID:     tx.OriginChainID,
+				Hash:        common.Hash(tx.Hash).String(),
+				Priority:    tx.Priority,
+				BlockNumber: tx.BlockSlot,
+				IsInvalid:   tx.IsInvalid,
+				IsProcessed: true,
+			}
+		}
+
+		return result, nil
+	case core.OracleTxsBucketExpected:
+		txs, err := i.cardanoDB.GetAllExpectedTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = &core.OracleTx{
+				ChainID:     tx.ChainID,
+				Hash:        common.Hash(tx.Hash).String(),
+				Priority:    tx.Priority,
+				TTL:         tx.TTL,
+				IsInvalid:   tx.IsInvalid,
+				IsProcessed: tx.IsProcessed,
+			}
+
+			setOracleTxMetadata(result[j], decodeCardanoMetadata, tx.Metadata)
+		}
+
+		return result, nil
+	case core.OracleTxsBucketDeadLetter:
+		txs, err := i.cardanoDB.GetDeadLetterTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = newCardanoOracleTx(tx.Tx)
+			result[j].IsInvalid = true
+			result[j].IsProcessed = true
+			result[j].LastError = tx.LastError
+			result[j].DeadLetterReason = tx.Reason
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported oracle txs bucket: %s", bucket)
+	}
+}
+
+func (i *OracleTxsInspectorImpl) getEthTxs(
+	chainID string, bucket core.OracleTxsBucket, limit int,
+) ([]*core.OracleTx, error) {
+	switch bucket {
+	case core.OracleTxsBucketUnprocessed, core.OracleTxsBucketPending:
+		getTxs := i.ethDB.GetAllUnprocessedTxs
+		if bucket == core.OracleTxsBucketPending {
+			getTxs = i.ethDB.GetAllPendingTxs
+		}
+
+		txs, err := getTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = newEthOracleTx(tx)
+		}
+
+		return result, nil
+	case core.OracleTxsBucketProcessed:
+		txs, err := i.ethDB.GetAllProcessedTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = &core.OracleTx{
+				ChainID:     tx.OriginChainID,
+				Hash:        common.Hash(tx.Hash).String(),
+				Priority:    tx.Priority,
+				BlockNumber: tx.BlockNumber,
+				IsInvalid:   tx.IsInvalid,
+				IsProcessed: true,
+			}
+		}
+
+		return result, nil
+	case core.OracleTxsBucketExpected:
+		txs, err := i.ethDB.GetAllExpectedTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = &core.OracleTx{
+				ChainID:     tx.ChainID,
+				Hash:        common.Hash(tx.Hash).String(),
+				Priority:    tx.Priority,
+				TTL:         tx.TTL,
+				IsInvalid:   tx.IsInvalid,
+				IsProcessed: tx.IsProcessed,
+			}
+
+			setOracleTxMetadata(result[j], decodeEthMetadata, tx.Metadata)
+		}
+
+		return result, nil
+	case core.OracleTxsBucketDeadLetter:
+		txs, err := i.ethDB.GetDeadLetterTxs(chainID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*core.OracleTx, len(txs))
+		for j, tx := range txs {
+			result[j] = newEthOracleTx(tx.Tx)
+			result[j].IsInvalid = true
+			result[j].IsProcessed = true
+			result[j].LastError = tx.LastError
+			result[j].DeadLetterReason = tx.Reason
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported oracle txs bucket: %s", bucket)
+	}
+}
+
+func newCardanoOracleTx(tx *cardanoOracleCore.CardanoTx) *core.OracleTx {
+	result := &core.OracleTx{
+		ChainID:        tx.OriginChainID,
+		Hash:           common.Hash(tx.Hash).String(),
+		Priority:       tx.Priority,
+		BlockNumber:    tx.BlockSlot,
+		SubmitTryCount: tx.SubmitTryCount,
+		BatchTryCount:  tx.BatchTryCount,
+		RefundTryCount: tx.RefundTryCount,
+		LastTimeTried:  tx.LastTimeTried,
+		TryTimes:       tx.TryTimes,
+		LastError:      tx.LastError,
+	}
+
+	setOracleTxMetadata(result, decodeCardanoMetadata, tx.Metadata)
+
+	return result
+}
+
+func newEthOracleTx(tx *ethOracleCore.EthTx) *core.OracleTx {
+	result := &core.OracleTx{
+		ChainID:        tx.OriginChainID,
+		Hash:           common.Hash(tx.Hash).String(),
+		Priority:       tx.Priority,
+		BlockNumber:    tx.BlockNumber,
+		SubmitTryCount: tx.SubmitTryCount,
+		BatchTryCount:  tx.BatchTryCount,
+		RefundTryCount: tx.RefundTryCount,
+		LastTimeTried:  tx.LastTimeTried,
+		TryTimes:       tx.TryTimes,
+		LastError:      tx.LastError,
+	}
+
+	setOracleTxMetadata(result, decodeEthMetadata, tx.Metadata)
+
+	return result
+}
+
+func setOracleTxMetadata(tx *core.OracleTx, decode func([]byte) (any, error), data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	metadata, err := decode(data)
+	if err != nil {
+		tx.MetadataError = err.Error()
+	} else {
+		tx.Metadata = metadata
+	}
+}
+
+func decodeCardanoMetadata(data []byte) (any, error) {
+	baseMetadata, err := common.UnmarshalMetadata[common.BaseMetadata](common.MetadataEncodingTypeCbor, data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch baseMetadata.BridgingTxType {
+	case common.BridgingTxTypeBridgingRequest:
+		return metadataOrError(
+			common.UnmarshalMetadata[common.BridgingRequestMetadata](common.MetadataEncodingTypeCbor, data))
+	case common.BridgingTxTypeBatchExecution:
+		return metadataOrError(
+			common.UnmarshalMetadata[common.BatchExecutedMetadata](common.MetadataEncodingTypeCbor, data))
+	case common.TxTypeRefundRequest:
+		return metadataOrError(
+			common.UnmarshalMetadata[common.RefundBridgingRequestMetadata](common.MetadataEncodingTypeCbor, data))
+	default:
+		return baseMetadata, nil
+	}
+}
+
+func decodeEthMetadata(data []byte) (any, error) {
+	baseMetadata, err := ethOracleCore.UnmarshalEthMetadata[ethOracleCore.BaseEthMetadata](data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch baseMetadata.BridgingTxType {
+	case common.BridgingTxTypeBridgingRequest:
+		return metadataOrError(ethOracleCore.UnmarshalEthMetadata[ethOracleCore.BridgingRequestEthMetadata](data))
+	case common.BridgingTxTypeBatchExecution:
+		return metadataOrError(ethOracleCore.UnmarshalEthMetadata[ethOracleCore.BatchExecutedEthMetadata](data))
+	case common.TxTypeRefundRequest:
+		return metadataOrError(ethOracleCore.UnmarshalEthMetadata[ethOracleCore.RefundBridgingRequestEthMetadata](data))
+	default:
+		return baseMetadata, nil
+	}
+}
+
+// metadataOrError prevents a nil metadata pointer from being returned as a non nil interface
+func metadataOrError[T any](metadata *T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,205 @@
+package validatorcomponents
+
+import (
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	cardanoOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
+	cardanoOracleDA "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/database_access"
+	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
+	oracleCommonDA "github.com/Ethernal-Tech/apex-bridge/oracle_common/database_access"
+	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	ethOracleDA "github.com/Ethernal-Tech/apex-bridge/oracle_eth/database_access"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/Ethernal-Tech/cardano-infrastructure/indexer"
+	"github.com/Ethernal-Tech/cardano-infrastructure/sendtx"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOracleTxsInspector(t *testing.T) {
+	oracleConfig := &oracleCommonCore.AppConfig{
+		CardanoChains: map[string]*oracleCommonCore.CardanoChainConfig{
+			common.ChainIDStrPrime: {},
+		},
+		EthChains: map[string]*oracleCommonCore.EthChainConfig{
+			common.ChainIDStrNexus: {},
+		},
+	}
+
+	oracleConfig.FillOut()
+
+	boltDB, err := oracleCommonDA.NewDatabase(filepath.Join(t.TempDir(), "oracle.db"), oracleConfig)
+	require.NoError(t, err)
+
+	defer boltDB.Close()
+
+	typeRegister := oracleCommonCore.NewTypeRegisterWithChains(
+		oracleConfig, reflect.TypeOf(cardanoOracleCore.CardanoTx{}), reflect.TypeOf(ethOracleCore.EthTx{}))
+
+	cardanoDB := &cardanoOracleDA.BBoltDatabase{}
+	cardanoDB.Init(boltDB, oracleConfig, typeRegister)
+
+	ethDB := &ethOracleDA.BBoltDatabase{}
+	ethDB.Init(boltDB, oracleConfig, typeRegister)
+
+	inspector := NewOracleTxsInspector(oracleConfig, cardanoDB, ethDB)
+
+	cardanoMetadata, err := common.MarshalMetadataMap(common.MetadataEncodingTypeCbor, common.BridgingRequestMetadata{
+		BridgingTxType:     sendtx.BridgingRequestType(common.BridgingTxTypeBridgingRequest),
+		DestinationChainID: common.ChainIDStrVector,
+		BridgingFee:        100,
+	})
+	require.NoError(t, err)
+
+	ethMetadata, err := ethOracleCore.MarshalEthMetadata(ethOracleCore.BatchExecutedEthMetadata{
+		BridgingTxType: common.BridgingTxTypeBatchExecution,
+		BatchNonceID:   7,
+	})
+	require.NoError(t, err)
+
+	lastTimeTried := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, cardanoDB.AddTxs(
+		[]*cardanoOracleCore.ProcessedCardanoTx{
+			{OriginChainID: common.ChainIDStrPrime, Hash: indexer.Hash{3}, Priority: 1, BlockSlot: 3, IsInvalid: true},
+		},
+		[]*cardanoOracleCore.CardanoTx{
+			{
+				OriginChainID: common.ChainIDStrPrime, Priority: 1, SubmitTryCount: 2, BatchTryCount: 1,
+				LastTimeTried: lastTimeTried,
+				Tx:            indexer.Tx{Hash: indexer.Hash{1}, BlockSlot: 1, Metadata: cardanoMetadata},
+			},
+			{
+				OriginChainID: common.ChainIDStrPrime, Priority: 1,
+				Tx: indexer.Tx{Hash: indexer.Hash{2}, BlockSlot: 2, Metadata: []byte{1, 2}},
+			},
+		}))
+
+	pendingTx := &ethOracleCore.EthTx{
+		OriginChainID: common.ChainIDStrNexus, Priority: 0, RefundTryCount: 1, BlockNumber: 10,
+		Hash: ethgo.Hash{4}, Value: big.NewInt(1), Metadata: ethMetadata,
+	}
+
+	require.NoError(t, ethDB.AddTxs(nil, []*ethOracleCore.EthTx{pendingTx}))
+	require.NoError(t, ethDB.UpdateTxs(&ethOracleCore.EthUpdateTxsData{
+		MoveUnprocessedToPending: []*ethOracleCore.EthTx{pendingTx},
+	}))
+	require.NoError(t, ethDB.AddExpectedTxs([]*ethOracleCore.BridgeExpectedEthTx{
+		{ChainID: common.ChainIDStrNexus, Hash: ethgo.Hash{5}, TTL: 100, Metadata: ethMetadata},
+	}))
+
+	t.Run("unsupported chain and bucket", func(t *testing.T) {
+		_, err := inspector.GetTxs(common.ChainIDStrVector, core.OracleTxsBucketUnprocessed, 0)
+		require.ErrorContains(t, err, "unsupported chain")
+
+		_, err = inspector.GetTxs(common.ChainIDStrPrime, core.OracleTxsBucket("dummy"), 0)
+		require.ErrorContains(t, err, "unsupported oracle txs bucket")
+
+		_, err = inspector.GetUnprocessedBatchEvents(common.ChainIDStrVector)
+		require.ErrorContains(t, err, "unsupported chain")
+	})
+
+	t.Run("cardano unprocessed txs", func(t *testing.T) {
+		txs, err := inspector.GetTxs(common.ChainIDStrPrime, core.OracleTxsBucketUnprocessed, 0)
+		require.NoError(t, err)
+		require.Len(t, txs, 2)
+
+		require.Equal(t, common.Hash{1}.String(), txs[0].Hash)
+		require.Equal(t, uint32(2), txs[0].SubmitTryCount)
+		require.Equal(t, uint32(1), txs[0].BatchTryCount)
+		require.True(t, lastTimeTried.Equal(txs[0].LastTimeTried))
+		require.Empty(t, txs[0].MetadataError)
+
+		metadata, ok := txs[0].Metadata.(*common.BridgingRequestMetadata)
+		require.True(t, ok)
+		require.Equal(t, common.ChainIDStrVector, metadata.DestinationChainID)
+		require.Equal(t, uint64(100), metadata.BridgingFee)
+
+		require.Nil(t, txs[1].Metadata)
+		require.NotEmpty(t, txs[1].MetadataError)
+
+		txs, err = inspector.GetTxs(common.ChainIDStrPrime, core.OracleTxsBucketUnprocessed, 1)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+	})
+
+	t.Run("cardano processed txs", func(t *testing.T) {
+		txs, err := inspector.GetTxs(common.ChainIDStrPrime, core.OracleTxsBucketProcessed, 0)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, common.Hash{3}.String(), txs[0].Hash)
+		require.True(t, txs[0].IsInvalid)
+		require.True(t, txs[0].IsProcessed)
+	})
+
+	t.Run("eth pending and expected txs", func(t *testing.T) {
+		txs, err := inspector.GetTxs(common.ChainIDStrNexus, core.OracleTxsBucketUnprocessed, 0)
+		require.NoError(t, err)
+		require.Empty(t, txs)
+
+		txs, err = inspector.GetTxs(common.ChainIDStrNexus, core.OracleTxsBucketPending, 0)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, uint32(1), txs[0].RefundTryCount)
+		require.Equal(t, uint64(10), txs[0].BlockNumber)
+
+		metadata, ok := txs[0].Metadata.(*ethOracleCore.BatchExecutedEthMetadata)
+		require.True(t, ok)
+		require.Equal(t, uint64(7), metadata.BatchNonceID)
+
+		txs, err = inspector.GetTxs(common.ChainIDStrNexus, core.OracleTxsBucketExpected, 0)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, uint64(100), txs[0].TTL)
+		require.IsType(t, &ethOracleCore.BatchExecutedEthMetadata{}, txs[0].Metadata)
+	})
+
+	t.Run("outflow usage", func(t *testing.T) {
+		oracleConfig.BridgingSettings.OutflowLimits = oracleCommonCore.OutflowLimitsSettings{
+			WindowMilis: 3_600_000,
+			Policy:      oracleCommonCore.OutflowLimitPolicyDefer,
+			PerSender:   big.NewInt(1_000),
+		}
+
+		require.NoError(t, ethDB.UpdateTxs(&ethOracleCore.EthUpdateTxsData{
+			AddOutflowRecords: []*oracleCommonCore.OutflowRecord{
+				{
+					SourceChainID: common.ChainIDStrNexus, DestinationChainID: common.ChainIDStrPrime,
+					SenderAddr: "0x1", TxHash: common.Hash{6}, Amount: big.NewInt(10), BlockSlot: 10,
+				},
+				{
+					SourceChainID: common.ChainIDStrNexus, DestinationChainID: common.ChainIDStrPrime,
+					SenderAddr: "0x1", TxHash: common.Hash{7}, Amount: big.NewInt(20), BlockSlot: 20,
+				},
+			},
+		}))
+
+		usage, err := inspector.GetOutflowUsage()
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(30), usage.PerSourceChain[common.ChainIDStrNexus])
+		require.Equal(t, big.NewInt(30), usage.PerDestinationChain[common.ChainIDStrPrime])
+		require.Equal(t, map[string]*big.Int{"0x1": big.NewInt(30)}, usage.PerSender)
+		require.Equal(t, big.NewInt(1_000), usage.Limits.PerSender)
+
+		records, err := cardanoDB.GetOutflowRecords()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+
+		require.NoError(t, ethDB.RemoveOutflowRecords(records[:1]))
+
+		records, err = cardanoDB.GetOutflowRecords()
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+
+	t.Run("unprocessed batch events", func(t *testing.T) {
+		events, err := inspector.GetUnprocessedBatchEvents(common.ChainIDStrNexus)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
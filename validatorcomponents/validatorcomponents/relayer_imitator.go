@@ -7,12 +7,15 @@ import (
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	"github.com/Ethernal-Tech/apex-bridge/health"
 	relayerCore "github.com/Ethernal-Tech/apex-bridge/relayer/core"
 	"github.com/Ethernal-Tech/apex-bridge/relayer/relayer"
 	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
 	"github.com/hashicorp/go-hclog"
 )
 
+const relayerImitatorHealthName = "relayer_imitator"
+
 type RelayerImitatorImpl struct {
 	config                      *core.AppConfig
 	bridgingRequestStateUpdater common.BridgingRequestStateUpdater
@@ -30,6 +33,8 @@ func NewRelayerImitator(
 	db relayerCore.Database,
 	logger hclog.Logger,
 ) (*RelayerImitatorImpl, error) {
+	health.RegisterComponent(relayerImitatorHealthName)
+
 	return &RelayerImitatorImpl{
 		config:                      config,
 		bridgingRequestStateUpdater: bridgingRequestStateUpdater,
@@ -52,17 +57,29 @@ func (ri *RelayerImitatorImpl) Start(ctx context.Context) {
 		case <-time.After(waitTime):
 		}
 
+		hasFailed := false
+
 		for chainID := range ri.config.CardanoChains {
 			if err := ri.execute(ctx, chainID); err != nil {
 				ri.logger.Error("execute failed", "err", err)
+				health.ReportError(relayerImitatorHealthName, err)
+
+				hasFailed = true
 			}
 		}
 
 		for chainID := range ri.config.EthChains {
 			if err := ri.execute(ctx, chainID); err != nil {
 				ri.logger.Error("execute failed", "err", err)
+				health.ReportError(relayerImitatorHealthName, err)
+
+				hasFailed = true
 			}
 		}
+
+		if !hasFailed {
+			health.ReportTick(relayerImitatorHealthName)
+		}
 	}
 }
 
@@ -86,7 +103,7 @@ func (ri *RelayerImitatorImpl) execute(ctx context.Context, chainID string) erro
 					tx.TransactionType == uint8(common.RefundConfirmedTxType))
 			}
 
-			err = ri.bridgingRequestStateUpdater.SubmittedToDestination(txsKeys, chainID)
+			err = ri.bridgingRequestStateUpdater.SubmittedToDestination(txsKeys, chainID, confirmedBatch.ID)
 			if err != nil {
 				return fmt.Errorf("failed to update bridging request states (%s, %d) to SubmittedToDestination: %w",
 					chainID, confirmedBatch.ID, err)
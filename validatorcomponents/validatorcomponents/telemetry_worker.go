@@ -8,6 +8,7 @@ import (
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	"github.com/Ethernal-Tech/apex-bridge/health"
 	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	"github.com/Ethernal-Tech/apex-bridge/telemetry"
 	eventTrackerStore "github.com/Ethernal-Tech/blockchain-event-tracker/store"
@@ -19,6 +20,8 @@ import (
 const (
 	feeMetricName      = "fee"
 	multisigMetricName = "multisig"
+
+	telemetryWorkerHealthName = "telemetry_worker"
 )
 
 var apexBridgeAdminScAddress = common.HexToAddress("0xABEF000000000000000000000000000000000006")
@@ -44,6 +47,8 @@ func NewTelemetryWorker(
 	waitTime time.Duration,
 	logger hclog.Logger,
 ) *TelemetryWorker {
+	health.RegisterComponent(telemetryWorkerHealthName)
+
 	return &TelemetryWorker{
 		etxHelperWrapper:       txHelper,
 		cardanoDBs:             cardanoDBs,
@@ -65,6 +70,8 @@ func (ti *TelemetryWorker) Start(ctx context.Context) {
 			return
 		case <-time.After(ti.waitTime):
 			ti.execute()
+
+			health.ReportTick(telemetryWorkerHealthName)
 		}
 	}
 }
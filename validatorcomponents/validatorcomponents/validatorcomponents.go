@@ -4,22 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"time"
 
 	batchermanager "github.com/Ethernal-Tech/apex-bridge/batcher/batcher_manager"
 	batcherCore "github.com/Ethernal-Tech/apex-bridge/batcher/core"
 	cardanotx "github.com/Ethernal-Tech/apex-bridge/cardano"
 	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/contractbinding"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
 	ethtxhelper "github.com/Ethernal-Tech/apex-bridge/eth/txhelper"
 	cardanoOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/core"
+	cardanoOracleDA "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/database_access"
 	cardanoOracle "github.com/Ethernal-Tech/apex-bridge/oracle_cardano/oracle"
 	oracleCommonBridge "github.com/Ethernal-Tech/apex-bridge/oracle_common/bridge"
 	oracleCommonCore "github.com/Ethernal-Tech/apex-bridge/oracle_common/core"
 	oracleCommonDA "github.com/Ethernal-Tech/apex-bridge/oracle_common/database_access"
+	oracleCommonScreening "github.com/Ethernal-Tech/apex-bridge/oracle_common/screening"
 	ethOracleCore "github.com/Ethernal-Tech/apex-bridge/oracle_eth/core"
+	ethOracleDA "github.com/Ethernal-Tech/apex-bridge/oracle_eth/database_access"
 	ethOracle "github.com/Ethernal-Tech/apex-bridge/oracle_eth/oracle"
 	"github.com/Ethernal-Tech/apex-bridge/telemetry"
 	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api"
@@ -54,6 +60,7 @@ type ValidatorComponentsImpl struct {
 	ethOracle            ethOracleCore.Oracle
 	batcherManager       batcherCore.BatcherManager
 	relayerImitator      core.RelayerImitator
+	webhookDispatcher    core.WebhookDispatcher
 	api                  core.API
 	telemetry            *telemetry.Telemetry
 	telemetryWorker      *TelemetryWorker
@@ -92,6 +99,23 @@ func NewValidatorComponents(
 	}
 
 	bridgingRequestStateManager := NewBridgingRequestStateManager(db, logger.Named("bridging_request_state_manager"))
+	bridgingRequestStateEventHub := NewBridgingRequestStateEventHub(
+		defaultEventHubSubscriberBufferSize, logger.Named("bridging_request_state_event_hub"))
+
+	webhookDispatcher, err := NewWebhookDispatcher(appConfig.Webhooks, db, logger.Named("webhook_dispatcher"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook dispatcher: %w", err)
+	}
+
+	etaEstimator := NewBridgingRequestETAEstimator(
+		appConfig, bridgingRequestStateManager, logger.Named("bridging_request_eta_estimator"))
+	if err := etaEstimator.LoadStatistics(); err != nil {
+		logger.Warn("failed to load bridging request statistics", "err", err)
+	}
+
+	bridgingRequestStateManager.AddListener(bridgingRequestStateEventHub)
+	bridgingRequestStateManager.AddListener(webhookDispatcher)
+	bridgingRequestStateManager.AddListener(etaEstimator)
 
 	ethHelper := eth.NewEthHelperWrapperWithWallet(
 		wallet, logger.Named("tx_helper_wrapper"),
@@ -101,15 +125,21 @@ func NewValidatorComponents(
 		ethtxhelper.WithLogger(logger.Named("tx_helper")),
 	)
 
+	bridgeContractsABI := contractbinding.GetContractsABI(appConfig.Bridge.LegacyContractsABI)
+
 	oracleBridgeSmartContract := eth.NewOracleBridgeSmartContract(
-		appConfig.Bridge.BridgeSmartContractAddress, ethHelper)
+		appConfig.Bridge.BridgeSmartContractAddress, ethHelper, bridgeContractsABI)
 
 	bridgeSmartContract := eth.NewBridgeSmartContract(
-		appConfig.Bridge.BridgeSmartContractAddress, ethHelper)
+		appConfig.Bridge.BridgeSmartContractAddress, ethHelper, bridgeContractsABI)
 
 	adminSmartContract := eth.NewOracleAdminSmartContract(
 		appConfig.Bridge.AdminSmartContractAddress, ethHelper)
 
+	if err := appConfig.RegisterChains(); err != nil {
+		return nil, fmt.Errorf("failed to register configured chains. err: %w", err)
+	}
+
 	err = fixChainsAndAddresses(ctx, appConfig, bridgeSmartContract, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to populate utxos and addresses. err: %w", err)
@@ -123,6 +153,41 @@ func NewValidatorComponents(
 
 	oracleConfig, batcherConfig := appConfig.SeparateConfigs()
 
+	if err := oracleConfig.BridgingSettings.OutflowLimits.Validate(oracleConfig.RefundEnabled); err != nil {
+		return nil, fmt.Errorf("invalid outflow limits: %w", err)
+	}
+
+	sourceChainIDs := append(
+		slices.Collect(maps.Keys(oracleConfig.CardanoChains)), slices.Collect(maps.Keys(oracleConfig.EthChains))...)
+
+	err = oracleConfig.BridgingSettings.ManualApproval.Validate(oracleConfig.RefundEnabled, sourceChainIDs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manual approval settings: %w", err)
+	}
+
+	if err := oracleConfig.BridgingSettings.NativeTokens.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid native tokens: %w", err)
+	}
+
+	err = oracleConfig.BridgingSettings.NativeTokens.ValidateContracts(oracleConfig.Bridge, oracleConfig.EthChains)
+	if err != nil {
+		return nil, fmt.Errorf("invalid native tokens: %w", err)
+	}
+
+	var addressScreener oracleCommonCore.AddressScreener
+
+	if screeningSettings := oracleConfig.BridgingSettings.AddressScreening; screeningSettings.IsEnabled() {
+		if err := screeningSettings.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid address screening: %w", err)
+		}
+
+		addressScreener, err = oracleCommonScreening.NewFileAddressScreener(
+			screeningSettings.BlocklistPath, screeningSettings.BlocklistSHA256, logger.Named("address_screener"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create address screener: %w", err)
+		}
+	}
+
 	cardanoIndexerDbs := make(map[string]indexer.Database, len(oracleConfig.CardanoChains))
 
 	for _, cardanoChainConfig := range oracleConfig.CardanoChains {
@@ -161,7 +226,7 @@ func NewValidatorComponents(
 
 	cardanoOracle, err := cardanoOracle.NewCardanoOracle(
 		ctx, oracleDB, typeRegister, oracleConfig, oracleBridgeSmartContract, cardanoBridgeSubmitter, cardanoIndexerDbs,
-		bridgingRequestStateManager, validatorSetObserver, logger.Named("oracle_cardano"))
+		bridgingRequestStateManager, validatorSetObserver, addressScreener, logger.Named("oracle_cardano"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create oracle_cardano. err %w", err)
 	}
@@ -171,7 +236,7 @@ func NewValidatorComponents(
 
 	ethOracle, err := ethOracle.NewEthOracle(
 		ctx, oracleDB, typeRegister, oracleConfig, oracleBridgeSmartContract, ethBridgeSubmitter, ethIndexerDbs,
-		bridgingRequestStateManager, validatorSetObserver, logger.Named("oracle_eth"))
+		bridgingRequestStateManager, validatorSetObserver, addressScreener, logger.Named("oracle_eth"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create oracle_eth. err %w", err)
 	}
@@ -201,16 +266,38 @@ func NewValidatorComponents(
 			return nil, err
 		}
 
+		cardanoOracleDB := &cardanoOracleDA.BBoltDatabase{}
+		cardanoOracleDB.Init(oracleDB, oracleConfig, typeRegister)
+
+		ethOracleDB := &ethOracleDA.BBoltDatabase{}
+		ethOracleDB.Init(oracleDB, oracleConfig, typeRegister)
+
 		apiControllers := []core.APIController{
 			controllers.NewBridgingRequestStateController(
-				bridgingRequestStateManager, apiLogger.Named("bridging_request_state_controller")),
+				bridgingRequestStateManager, bridgingRequestStateEventHub, etaEstimator,
+				apiLogger.Named("bridging_request_state_controller")),
 			controllers.NewOracleStateController(
 				appConfig, bridgingRequestStateManager, cardanoIndexerDbs, ethIndexerDbs,
 				getAddressesMap(oracleConfig.CardanoChains), apiLogger.Named("oracle_state")),
 			controllers.NewSettingsController(appConfig, adminSmartContract, apiLogger.Named("settings_controller")),
+			controllers.NewWebhookController(webhookDispatcher, apiLogger.Named("webhook_controller")),
+			controllers.NewBridgingRequestController(
+				NewBridgingRequestValidator(
+					oracleConfig, addressScreener, logger.Named("bridging_request_validator")),
+				NewBridgingTxBuilder(oracleConfig),
+				NewBridgingQuoter(oracleConfig),
+				apiLogger.Named("bridging_request_controller")),
+			controllers.NewOracleAdminController(
+				NewOracleTxsInspector(oracleConfig, cardanoOracleDB, ethOracleDB),
+				NewOracleTxsAdmin(oracleConfig, cardanoOracleDB, ethOracleDB,
+					bridgingRequestStateManager, logger.Named("oracle_txs_admin")),
+				db, apiLogger.Named("oracle_admin_controller")),
+			controllers.NewHealthController(
+				NewHealthChecker(appConfig, db, oracleDB, cardanoIndexerDbs, ethIndexerDbs, logger.Named("health_checker")),
+				apiLogger.Named("health_controller")),
 		}
 
-		apiObj, err = api.NewAPI(ctx, appConfig.APIConfig, apiControllers, apiLogger.Named("api"))
+		apiObj, err = api.NewAPI(ctx, appConfig.APIConfig, apiControllers, db, apiLogger.Named("api"))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create api: %w", err)
 		}
@@ -226,6 +313,7 @@ func NewValidatorComponents(
 		ethOracle:         ethOracle,
 		batcherManager:    batcherManager,
 		relayerImitator:   relayerImitator,
+		webhookDispatcher: webhookDispatcher,
 		api:               apiObj,
 		telemetry:         telemetry.NewTelemetry(appConfig.Telemetry, logger.Named("telemetry")),
 		telemetryWorker: NewTelemetryWorker(
@@ -256,6 +344,7 @@ func (v *ValidatorComponentsImpl) Start() error {
 	}
 
 	go v.relayerImitator.Start(v.ctx)
+	go v.webhookDispatcher.Start(v.ctx)
 
 	if v.telemetry.IsEnabled() {
 		if err := v.telemetry.Start(); err != nil {
@@ -360,6 +449,15 @@ func fixChainsAndAddresses(
 	// handle config for oracles
 	for _, regChain := range allRegisteredChains {
 		chainID := common.ToStrChainID(regChain.Id)
+		if chainID == "" {
+			return fmt.Errorf("no chain id configured for registered chain: %d. "+
+				"every chain registered on the bridge contract must be configured with its chainIdNum", regChain.Id)
+		}
+
+		// chain type from the bridge contract must match the one already known for the chain
+		if err := common.RegisterChain(chainID, regChain.Id, regChain.ChainType); err != nil {
+			return fmt.Errorf("failed to register chain %s: %w", chainID, err)
+		}
 
 		logger.Debug("Registered chain received", "chainID", chainID, "type", regChain.ChainType,
 			"addr", regChain.AddressMultisig, "fee", regChain.AddressFeePayer)
@@ -0,0 +1,357 @@
+package validatorcomponents
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/health"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/api/model/response"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	WebhookSignatureHeader  = "X-Webhook-Signature"
+	WebhookTimestampHeader  = "X-Webhook-Timestamp"
+	WebhookDeliveryIDHeader = "X-Webhook-Delivery-Id"
+	WebhookEventName        = "bridgingRequestStateChanged"
+
+	defaultWebhookPullTimeMilis  = 1000
+	defaultWebhookRequestTimeout = 10 * time.Second
+	defaultWebhookMaxAttempts    = 10
+	defaultWebhookBaseRetryDelay = 5 * time.Second
+	defaultWebhookMaxRetryDelay  = time.Hour
+	webhookDeliveriesBatchSize   = 100
+
+	webhookDispatcherHealthName = "webhook_dispatcher"
+)
+
+type WebhookPayload struct {
+	Event string                                      `json:"event"`
+	Data  *response.BridgingRequestStateEventResponse `json:"data"`
+}
+
+// WebhookDispatcherImpl queues the status changes of bridging requests, stores a delivery for every
+// matching webhook endpoint and sends them in the background. Failed deliveries are retried with
+// exponential backoff. Deliveries are kept in the database so the pending ones survive restarts
+type WebhookDispatcherImpl struct {
+	config    core.WebhooksConfig
+	endpoints map[string]*core.WebhookEndpointConfig
+	db        core.WebhookDeliveryDB
+	client    *http.Client
+	wakeCh    chan struct{}
+	logger    hclog.Logger
+
+	eventsLock sync.Mutex
+	events     []*core.BridgingRequestStateEvent
+}
+
+var _ core.WebhookDispatcher = (*WebhookDispatcherImpl)(nil)
+
+func NewWebhookDispatcher(
+	config core.WebhooksConfig,
+	db core.WebhookDeliveryDB,
+	logger hclog.Logger,
+) (*WebhookDispatcherImpl, error) {
+	endpoints := make(map[string]*core.WebhookEndpointConfig, len(config.Endpoints))
+
+	for _, endpoint := range config.Endpoints {
+		if endpoint.Name == "" {
+			return nil, errors.New("webhook endpoint name is empty")
+		}
+
+		if _, exists := endpoints[endpoint.Name]; exists {
+			return nil, fmt.Errorf("duplicate webhook endpoint name: %s", endpoint.Name)
+		}
+
+		if u, err := url.ParseRequestURI(endpoint.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, fmt.Errorf("invalid url for webhook endpoint %s: %s", endpoint.Name, endpoint.URL)
+		}
+
+		if endpoint.Secret == "" {
+			return nil, fmt.Errorf("secret is empty for webhook endpoint %s", endpoint.Name)
+		}
+
+		endpoints[endpoint.Name] = endpoint
+	}
+
+	if config.PullTimeMilis == 0 {
+		config.PullTimeMilis = defaultWebhookPullTimeMilis
+	}
+
+	if config.RequestTimeout == 0 {
+		config.RequestTimeout = defaultWebhookRequestTimeout
+	}
+
+	if config.MaxAttempts == 0 {
+		config.MaxAttempts = defaultWebhookMaxAttempts
+	}
+
+	if config.BaseRetryDelay == 0 {
+		config.BaseRetryDelay = defaultWebhookBaseRetryDelay
+	}
+
+	if config.MaxRetryDelay == 0 {
+		config.MaxRetryDelay = defaultWebhookMaxRetryDelay
+	}
+
+	return &WebhookDispatcherImpl{
+		config:    config,
+		endpoints: endpoints,
+		db:        db,
+		client:    &http.Client{Timeout: config.RequestTimeout},
+		wakeCh:    make(chan struct{}, 1),
+		logger:    logger,
+	}, nil
+}
+
+// SignWebhookPayload returns hex encoded HMAC-SHA256 of "timestamp.payload".
+// Receivers should compute the same value and compare it with the signature header
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// OnBridgingRequestStateChanged implements core.BridgingRequestStateListener.
+// It is called inside the state update so the event is only queued, the deliveries are stored by the dispatcher loop
+func (d *WebhookDispatcherImpl) OnBridgingRequestStateChanged(event *core.BridgingRequestStateEvent) {
+	if len(d.endpoints) == 0 || event.PreviousStatus == event.State.Status {
+		return
+	}
+
+	d.eventsLock.Lock()
+	d.events = append(d.events, event)
+	d.eventsLock.Unlock()
+
+	select {
+	case d.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start implements core.WebhookDispatcher.
+func (d *WebhookDispatcherImpl) Start(ctx context.Context) {
+	health.RegisterComponent(webhookDispatcherHealthName)
+
+	d.logger.Debug("Webhook dispatcher started", "endpoints", len(d.endpoints))
+
+	waitTime := time.Millisecond * time.Duration(d.config.PullTimeMilis)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wakeCh:
+		case <-time.After(waitTime):
+		}
+
+		if err := d.execute(ctx); err != nil {
+			d.logger.Error("webhook dispatcher execute failed", "err", err)
+			health.ReportError(webhookDispatcherHealthName, err)
+		} else {
+			health.ReportTick(webhookDispatcherHealthName)
+		}
+	}
+}
+
+// GetDelivery implements core.WebhookDispatcher.
+func (d *WebhookDispatcherImpl) GetDelivery(id uint64) (*core.WebhookDelivery, error) {
+	return d.db.GetWebhookDelivery(id)
+}
+
+// GetDeliveries implements core.WebhookDispatcher.
+func (d *WebhookDispatcherImpl) GetDeliveries(
+	sourceChainID string, sourceTxHash common.Hash,
+) ([]*core.WebhookDelivery, error) {
+	return d.db.GetWebhookDeliveries(sourceChainID, sourceTxHash)
+}
+
+func (d *WebhookDispatcherImpl) execute(ctx context.Context) error {
+	if err := d.storeQueuedDeliveries(); err != nil {
+		return err
+	}
+
+	deliveries, err := d.db.GetPendingWebhookDeliveries(time.Now().UTC(), webhookDeliveriesBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get pending webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		d.attemptDelivery(ctx, delivery)
+
+		if err := d.db.UpdateWebhookDelivery(delivery); err != nil {
+			return fmt.Errorf("failed to update webhook delivery %d: %w", delivery.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (d *WebhookDispatcherImpl) storeQueuedDeliveries() error {
+	d.eventsLock.Lock()
+	events := d.events
+	d.events = nil
+	d.eventsLock.Unlock()
+
+	for i, event := range events {
+		if err := d.storeDeliveries(event); err != nil {
+			// put back the events which are not stored so they are retried on the next tick
+			d.eventsLock.Lock()
+			d.events = append(events[i:], d.events...)
+			d.eventsLock.Unlock()
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *WebhookDispatcherImpl) storeDeliveries(event *core.BridgingRequestStateEvent) error {
+	var payload []byte
+
+	for _, endpoint := range d.config.Endpoints {
+		if !endpoint.IsMatch(&event.State) {
+			continue
+		}
+
+		if payload == nil {
+			var err error
+
+			payload, err = json.Marshal(&WebhookPayload{
+				Event: WebhookEventName,
+				Data:  response.NewBridgingRequestStateEventResponse(&event.State, event.PreviousStatus),
+			})
+			if err != nil {
+				d.logger.Error("failed to marshal webhook payload", "err", err)
+
+				return nil
+			}
+		}
+
+		now := time.Now().UTC()
+		delivery := &core.WebhookDelivery{
+			WebhookName:   endpoint.Name,
+			SourceChainID: event.State.SourceChainID,
+			SourceTxHash:  event.State.SourceTxHash,
+			Payload:       payload,
+			Status:        core.WebhookDeliveryStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+
+		if err := d.db.AddWebhookDelivery(delivery); err != nil {
+			return fmt.Errorf("failed to add webhook delivery for %s (%s, %s): %w",
+				endpoint.Name, event.State.SourceChainID, event.State.SourceTxHash, err)
+		}
+	}
+
+	return nil
+}
+
+func (d *WebhookDispatcherImpl) attemptDelivery(ctx context.Context, delivery *core.WebhookDelivery) {
+	now := time.Now().UTC()
+
+	delivery.UpdatedAt = now
+
+	endpoint, exists := d.endpoints[delivery.WebhookName]
+	if !exists {
+		delivery.Status = core.WebhookDeliveryStatusFailed
+		delivery.LastError = "webhook endpoint is no longer configured"
+
+		return
+	}
+
+	delivery.Attempts++
+
+	responseCode, err := d.send(ctx, endpoint, delivery, now)
+	delivery.LastResponseCode = responseCode
+
+	if err == nil {
+		delivery.Status = core.WebhookDeliveryStatusDelivered
+		delivery.LastError = ""
+
+		d.logger.Debug("Webhook delivered", "webhook", endpoint.Name, "id", delivery.ID)
+
+		return
+	}
+
+	delivery.LastError = err.Error()
+
+	if delivery.Attempts >= d.config.MaxAttempts {
+		delivery.Status = core.WebhookDeliveryStatusFailed
+
+		d.logger.Warn("Webhook delivery failed", "webhook", endpoint.Name, "id", delivery.ID,
+			"attempts", delivery.Attempts, "err", err)
+
+		return
+	}
+
+	delivery.NextAttemptAt = now.Add(d.retryDelay(delivery.Attempts))
+
+	d.logger.Debug("Webhook delivery attempt failed", "webhook", endpoint.Name, "id", delivery.ID,
+		"attempts", delivery.Attempts, "next", delivery.NextAttemptAt, "err", err)
+}
+
+func (d *WebhookDispatcherImpl) send(
+	ctx context.Context, endpoint *core.WebhookEndpointConfig, delivery *core.WebhookDelivery, now time.Time,
+) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := now.Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookDeliveryIDHeader, strconv.FormatUint(delivery.ID, 10))
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(endpoint.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("unexpected response status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (d *WebhookDispatcherImpl) retryDelay(attempts uint32) time.Duration {
+	delay := d.config.BaseRetryDelay
+
+	for i := uint32(1); i < attempts && delay < d.config.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, d.config.MaxRetryDelay)
+}
@@ -0,0 +1,193 @@
+package validatorcomponents
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Ethernal-Tech/apex-bridge/common"
+	"github.com/Ethernal-Tech/apex-bridge/validatorcomponents/core"
+	databaseaccess "github.com/Ethernal-Tech/apex-bridge/validatorcomponents/database_access"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDispatcher(t *testing.T) {
+	const secret = "secret"
+
+	txHash := common.NewHashFromHexString("0x01")
+
+	newEvent := func(
+		chainID string, status common.BridgingRequestStatus, prevStatus common.BridgingRequestStatus,
+	) *core.BridgingRequestStateEvent {
+		state := common.NewBridgingRequestState(chainID, txHash, false)
+		state.Status = status
+
+		return &core.BridgingRequestStateEvent{State: *state, PreviousStatus: prevStatus}
+	}
+
+	newDB := func(t *testing.T) core.Database {
+		t.Helper()
+
+		db, err := databaseaccess.NewDatabase(filepath.Join(t.TempDir(), "temp_test.db"))
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			db.Close()
+		})
+
+		return db
+	}
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewWebhookDispatcher(core.WebhooksConfig{
+			Endpoints: []*core.WebhookEndpointConfig{{Name: "a", URL: "ftp://x", Secret: secret}},
+		}, nil, hclog.NewNullLogger())
+		require.ErrorContains(t, err, "invalid url")
+
+		_, err = NewWebhookDispatcher(core.WebhooksConfig{
+			Endpoints: []*core.WebhookEndpointConfig{{Name: "a", URL: "http://localhost"}},
+		}, nil, hclog.NewNullLogger())
+		require.ErrorContains(t, err, "secret is empty")
+
+		_, err = NewWebhookDispatcher(core.WebhooksConfig{
+			Endpoints: []*core.WebhookEndpointConfig{
+				{Name: "a", URL: "http://localhost", Secret: secret},
+				{Name: "a", URL: "http://localhost", Secret: secret},
+			},
+		}, nil, hclog.NewNullLogger())
+		require.ErrorContains(t, err, "duplicate webhook endpoint name")
+	})
+
+	t.Run("delivers signed payload to matching endpoints", func(t *testing.T) {
+		var (
+			received atomic.Int32
+			body     []byte
+			header   http.Header
+		)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			header = r.Header.Clone()
+
+			received.Add(1)
+		}))
+		defer server.Close()
+
+		db := newDB(t)
+
+		dispatcher, err := NewWebhookDispatcher(core.WebhooksConfig{
+			Endpoints: []*core.WebhookEndpointConfig{
+				{Name: "all", URL: server.URL, Secret: secret},
+				{Name: "vector", URL: server.URL, Secret: secret, SourceChainIDs: []string{common.ChainIDStrVector}},
+				{
+					Name: "executed", URL: server.URL, Secret: secret,
+					Statuses: []common.BridgingRequestStatus{common.BridgingRequestStatusExecutedOnDestination},
+				},
+			},
+		}, db, hclog.NewNullLogger())
+		require.NoError(t, err)
+
+		dispatcher.OnBridgingRequestStateChanged(newEvent(
+			common.ChainIDStrPrime, common.BridgingRequestStatusSubmittedToBridge,
+			common.BridgingRequestStatusDiscoveredOnSource))
+		// status did not change
+		dispatcher.OnBridgingRequestStateChanged(newEvent(
+			common.ChainIDStrPrime, common.BridgingRequestStatusSubmittedToBridge,
+			common.BridgingRequestStatusSubmittedToBridge))
+
+		// deliveries are stored by the dispatcher loop, not in the state update
+		deliveries, err := dispatcher.GetDeliveries(common.ChainIDStrPrime, txHash)
+		require.NoError(t, err)
+		require.Empty(t, deliveries)
+
+		require.NoError(t, dispatcher.execute(context.Background()))
+		require.Equal(t, int32(1), received.Load())
+
+		timestamp, err := strconv.ParseInt(header.Get(WebhookTimestampHeader), 10, 64)
+		require.NoError(t, err)
+		require.Equal(t, "sha256="+SignWebhookPayload(secret, timestamp, body), header.Get(WebhookSignatureHeader))
+		require.Equal(t, "1", header.Get(WebhookDeliveryIDHeader))
+
+		var payload map[string]any
+
+		require.NoError(t, json.Unmarshal(body, &payload))
+		require.Equal(t, WebhookEventName, payload["event"])
+
+		deliveries, err = dispatcher.GetDeliveries(common.ChainIDStrPrime, txHash)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		require.Equal(t, "all", deliveries[0].WebhookName)
+		require.Equal(t, core.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+		require.Equal(t, uint32(1), deliveries[0].Attempts)
+		require.Equal(t, http.StatusOK, deliveries[0].LastResponseCode)
+	})
+
+	t.Run("retries with backoff and fails after max attempts", func(t *testing.T) {
+		var received atomic.Int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		db := newDB(t)
+
+		dispatcher, err := NewWebhookDispatcher(core.WebhooksConfig{
+			Endpoints:      []*core.WebhookEndpointConfig{{Name: "all", URL: server.URL, Secret: secret}},
+			MaxAttempts:    2,
+			BaseRetryDelay: time.Hour,
+		}, db, hclog.NewNullLogger())
+		require.NoError(t, err)
+
+		dispatcher.OnBridgingRequestStateChanged(newEvent(
+			common.ChainIDStrPrime, common.BridgingRequestStatusDiscoveredOnSource, ""))
+
+		require.NoError(t, dispatcher.execute(context.Background()))
+		// next attempt is not due yet
+		require.NoError(t, dispatcher.execute(context.Background()))
+		require.Equal(t, int32(1), received.Load())
+
+		delivery, err := dispatcher.GetDelivery(1)
+		require.NoError(t, err)
+		require.Equal(t, core.WebhookDeliveryStatusPending, delivery.Status)
+		require.Equal(t, http.StatusInternalServerError, delivery.LastResponseCode)
+		require.True(t, delivery.NextAttemptAt.After(time.Now().Add(time.Minute*59)))
+
+		delivery.NextAttemptAt = time.Now().UTC()
+		require.NoError(t, db.UpdateWebhookDelivery(delivery))
+
+		require.NoError(t, dispatcher.execute(context.Background()))
+		require.Equal(t, int32(2), received.Load())
+
+		delivery, err = dispatcher.GetDelivery(1)
+		require.NoError(t, err)
+		require.Equal(t, core.WebhookDeliveryStatusFailed, delivery.Status)
+		require.Equal(t, uint32(2), delivery.Attempts)
+
+		pending, err := db.GetPendingWebhookDeliveries(time.Now().Add(time.Hour*24), 10)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+
+	t.Run("retry delay", func(t *testing.T) {
+		dispatcher, err := NewWebhookDispatcher(core.WebhooksConfig{
+			BaseRetryDelay: time.Second,
+			MaxRetryDelay:  time.Second * 10,
+		}, nil, hclog.NewNullLogger())
+		require.NoError(t, err)
+
+		require.Equal(t, time.Second, dispatcher.retryDelay(1))
+		require.Equal(t, time.Second*4, dispatcher.retryDelay(3))
+		require.Equal(t, time.Second*10, dispatcher.retryDelay(5))
+		require.Equal(t, time.Second*10, dispatcher.retryDelay(100))
+	})
+}
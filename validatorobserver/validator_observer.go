@@ -8,6 +8,7 @@ import (
 
 	"github.com/Ethernal-Tech/apex-bridge/common"
 	"github.com/Ethernal-Tech/apex-bridge/eth"
+	"github.com/Ethernal-Tech/apex-bridge/health"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-hclog"
 )
@@ -15,6 +16,8 @@ import (
 const (
 	// Special flag for Blade chain
 	BladeFlag = uint8(0xFF)
+
+	healthComponentName = "validator_set_observer"
 )
 
 type ValidatorSetObserverImpl struct {
@@ -43,6 +46,8 @@ func NewValidatorSetObserver(
 	timeout time.Duration,
 	logger hclog.Logger,
 ) (*ValidatorSetObserverImpl, error) {
+	health.RegisterComponent(healthComponentName)
+
 	newValidatorSet := &ValidatorSetObserverImpl{
 		context:             ctx,
 		bridgeSmartContract: bridgeSmartContract,
@@ -72,6 +77,9 @@ func (vs *ValidatorSetObserverImpl) Start() {
 			case <-time.After(vs.timeout):
 				if err := vs.execute(); err != nil {
 					vs.logger.Error("error while executing", "err", err)
+					health.ReportError(healthComponentName, err)
+				} else {
+					health.ReportTick(healthComponentName)
 				}
 			}
 		}